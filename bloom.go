@@ -0,0 +1,299 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/cespare/xxhash/v2"
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// bloomFPP is the false positive probability that the bloom
+// filter of a column chunk is sized for once the number of
+// distinct values in the chunk is known.
+const bloomFPP = 0.01
+
+// The bitset of a bloom filter is a power of two number of
+// bytes between minBloomBytes and maxBloomBytes.
+const (
+	minBloomBytes = 32
+	maxBloomBytes = 128 << 20
+)
+
+// bloomSalt are the salts of the split block bloom
+// filter algorithm in the parquet format.
+var bloomSalt = [8]uint32{
+	0x47b6137b, 0x44974d91, 0x8824ad5b, 0xa2b7289d,
+	0x705495c7, 0x2df1424b, 0x9efc4947, 0x5c6bfb31,
+}
+
+// BloomFilter is the split block bloom filter of a column chunk,
+// which can tell that a value is definitely not in the chunk.
+type BloomFilter struct {
+	// words are the blocks of the filter, 8 words per block.
+	words []uint32
+}
+
+// newBloomFilter returns an empty bloom filter
+// that is big enough for ndv distinct values.
+func newBloomFilter(ndv int) *BloomFilter {
+	bits := -8 * float64(ndv) / math.Log(1-math.Pow(bloomFPP, 1.0/8))
+	n := minBloomBytes
+	for n < maxBloomBytes && float64(8*n) < bits {
+		n <<= 1
+	}
+	return &BloomFilter{words: make([]uint32, n/4)}
+}
+
+// Check returns false if v is definitely not in the column chunk
+// and true if it might be.  v is the plain encoding of the value:
+// the bytes of a string or []byte (without their length) and the
+// little endian bytes of a number (4 bytes for int32, uint32 and
+// float32 columns and 8 bytes for int64, uint64 and float64 ones).
+func (f *BloomFilter) Check(v []byte) bool {
+	return f.check(xxhash.Sum64(v))
+}
+
+func (f *BloomFilter) check(h uint64) bool {
+	block := f.block(h)
+	x := uint32(h)
+	for i, s := range bloomSalt {
+		if block[i]&(1<<((x*s)>>27)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *BloomFilter) insert(h uint64) {
+	block := f.block(h)
+	x := uint32(h)
+	for i, s := range bloomSalt {
+		block[i] |= 1 << ((x * s) >> 27)
+	}
+}
+
+// block returns the block that the hash h belongs to, which
+// is picked by the most significant 32 bits of the hash.
+func (f *BloomFilter) block(h uint64) []uint32 {
+	n := uint64(len(f.words) / 8)
+	i := ((h >> 32) * n) >> 32
+	return f.words[8*i : 8*i+8]
+}
+
+// bitset returns the little endian bytes of the words of f.
+func (f *BloomFilter) bitset() []byte {
+	out := make([]byte, 4*len(f.words))
+	for i, w := range f.words {
+		binary.LittleEndian.PutUint32(out[4*i:], w)
+	}
+	return out
+}
+
+// addBloom adds the hashes of the n plain encoded values in vals
+// to the bloom filter of the column at pth in the current row group.
+func (m *Metadata) addBloom(pth []string, vals []byte, n int) error {
+	col := strings.Join(pth, ".")
+	m.mu.Lock()
+	if len(m.rowGroups) == 0 {
+		m.mu.Unlock()
+		return fmt.Errorf("no row groups, you must call StartRowGroup at least once")
+	}
+
+	typ, err := columnType(col, m.schema)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+
+	if typ == sch.Type_BOOLEAN {
+		m.mu.Unlock()
+		return fmt.Errorf("unable to write a bloom filter for boolean column %s", col)
+	}
+
+	rg := m.rowGroups[len(m.rowGroups)-1]
+	hashes, ok := rg.blooms[col]
+	if !ok {
+		hashes = map[uint64]struct{}{}
+		rg.blooms[col] = hashes
+	}
+	m.mu.Unlock()
+
+	values, err := splitValues(vals, n, typ)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		if typ == sch.Type_BYTE_ARRAY {
+			v = v[4:]
+		}
+		hashes[xxhash.Sum64(v)] = struct{}{}
+	}
+	return nil
+}
+
+// bloomFilter builds the bloom filter of the column col from its
+// hashes and returns its header followed by its bitset, encrypted
+// if the column is.  rg is the ordinal of the row group.
+func (m *Metadata) bloomFilter(col string, hashes map[uint64]struct{}, rg int) ([]byte, error) {
+	f := newBloomFilter(len(hashes))
+	for h := range hashes {
+		f.insert(h)
+	}
+	bitset := f.bitset()
+
+	buf, err := m.ts.Write(context.TODO(), &sch.BloomFilterHeader{
+		NumBytes:    int32(len(bitset)),
+		Algorithm:   &sch.BloomFilterAlgorithm{BLOCK: &sch.SplitBlockAlgorithm{}},
+		Hash:        &sch.BloomFilterHash{XXHASH: &sch.XxHash{}},
+		Compression: &sch.BloomFilterCompression{UNCOMPRESSED: &sch.Uncompressed{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var key []byte
+	if m.enc != nil {
+		key, _ = m.enc.key(col)
+	}
+
+	if key == nil {
+		return append(buf, bitset...), nil
+	}
+
+	buf, err = m.enc.encrypt(key, moduleBloomFilterHeader, rg, m.enc.ordinals[col], 0, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	bitset, err = m.enc.encrypt(key, moduleBloomFilterBitset, rg, m.enc.ordinals[col], 0, bitset)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, bitset...), nil
+}
+
+// BloomFilter reads the bloom filter of the column at pth in
+// the i'th row group from r.  ReadFooter must be called first.
+// It returns nil if the column chunk doesn't have a bloom filter.
+func (m *Metadata) BloomFilter(r io.ReadSeeker, i int, pth []string) (*BloomFilter, error) {
+	if m.metadata == nil || i < 0 || i >= len(m.metadata.RowGroups) {
+		return nil, fmt.Errorf("there is no row group %d", i)
+	}
+
+	col := strings.Join(pth, ".")
+	var ch *sch.ColumnChunk
+	for _, c := range m.metadata.RowGroups[i].Columns {
+		if c.MetaData == nil {
+			return nil, fmt.Errorf("a column of row group %d is encrypted, a Decryption with its key is required", i)
+		}
+
+		if strings.Join(c.MetaData.PathInSchema, ".") == col {
+			ch = c
+		}
+	}
+
+	if ch == nil {
+		return nil, fmt.Errorf("row group %d doesn't have column %s", i, col)
+	}
+
+	if ch.MetaData.BloomFilterOffset == nil {
+		return nil, nil
+	}
+
+	if _, err := r.Seek(*ch.MetaData.BloomFilterOffset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var bitset []byte
+	if m.dec != nil && ch.CryptoMetadata != nil {
+		key, err := m.dec.columnKey(ch.CryptoMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := m.dec.ordinal(ch)
+		if err != nil {
+			return nil, err
+		}
+
+		module, err := readModule(r, m.limits.MaxPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err := m.dec.decrypt(key, moduleBloomFilterHeader, i, o, 0, module)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt bloom filter header: %s", err)
+		}
+
+		bh, err := readBloomFilterHeader(bytes.NewReader(buf), m.limits)
+		if err != nil {
+			return nil, err
+		}
+
+		if module, err = readModule(r, m.limits.MaxPageSize); err != nil {
+			return nil, err
+		}
+
+		if bitset, err = m.dec.decrypt(key, moduleBloomFilterBitset, i, o, 0, module); err != nil {
+			return nil, fmt.Errorf("unable to decrypt bloom filter: %s", err)
+		}
+
+		if len(bitset) != int(bh.NumBytes) {
+			return nil, corruptPage("bloom filter of %d bytes has a size of %d", len(bitset), bh.NumBytes)
+		}
+	} else {
+		bh, err := readBloomFilterHeader(r, m.limits)
+		if err != nil {
+			return nil, err
+		}
+
+		bitset = make([]byte, bh.NumBytes)
+		if _, err := io.ReadFull(r, bitset); err != nil {
+			return nil, err
+		}
+	}
+
+	f := &BloomFilter{words: make([]uint32, len(bitset)/4)}
+	for j := range f.words {
+		f.words[j] = binary.LittleEndian.Uint32(bitset[4*j:])
+	}
+	return f, nil
+}
+
+// readBloomFilterHeader reads the header of a bloom filter and
+// checks that it describes an uncompressed split block bloom
+// filter.
+func readBloomFilterHeader(r io.Reader, l Limits) (*sch.BloomFilterHeader, error) {
+	n := int64(l.MaxPageSize)
+	if n <= 0 {
+		n = math.MaxInt64
+	}
+
+	p := thrift.NewTCompactProtocol(newLimitedTransport(r, n))
+	bh := &sch.BloomFilterHeader{}
+	if err := bh.Read(p); err != nil {
+		return nil, corruptPage("%s", err)
+	}
+
+	if bh.Algorithm == nil || bh.Algorithm.BLOCK == nil || bh.Hash == nil || bh.Hash.XXHASH == nil || bh.Compression == nil || bh.Compression.UNCOMPRESSED == nil {
+		return nil, fmt.Errorf("%w: bloom filter %s", ErrUnsupportedEncoding, bh)
+	}
+
+	if bh.NumBytes < minBloomBytes || bh.NumBytes > maxBloomBytes || bh.NumBytes%minBloomBytes != 0 {
+		return nil, corruptPage("invalid bloom filter size %d", bh.NumBytes)
+	}
+
+	if err := l.checkPage(bh.NumBytes, 0); err != nil {
+		return nil, err
+	}
+	return bh, nil
+}
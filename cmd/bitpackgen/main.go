@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"strings"
 	"text/template"
 )
 
@@ -27,7 +28,6 @@ func main() {
 	}
 	for _, t := range []string{
 		bytesTpl,
-		intsTpl,
 	} {
 		var err error
 		tmpl, err = tmpl.Parse(t)
@@ -67,75 +67,65 @@ type bitback struct {
 
 type byt struct {
 	I     int
-	Or    string
-	And   int
+	And   uint64
 	Shift int
 	Dir   string
 }
 
+// unpack returns the expression that unpacks the i'th
+// value from the bytes it was packed into.
+func unpack(width, i int) string {
+	var terms []string
+	start := i * width
+	for x := start / 8; x*8 < start+width; x++ {
+		lo, hi := start-x*8, start+width-x*8
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > 8 {
+			hi = 8
+		}
+		mask := ((1 << uint(hi-lo)) - 1) << uint(lo)
+		if x*8 <= start {
+			terms = append(terms, fmt.Sprintf("(uint32(vals[%d]&%d) >> %d)", x, mask, lo))
+		} else {
+			terms = append(terms, fmt.Sprintf("(uint32(vals[%d]&%d) << %d)", x, mask, x*8-start))
+		}
+	}
+	return strings.Join(terms, " | ")
+}
+
 var (
 	funcs = template.FuncMap{
+		// pack returns, for each of the width bytes that 8 values
+		// are packed into, the bits of each value that land in it.
 		"pack": func(width int) [][]byt {
-			bs := [][]byt{{}}
-			var x int
-			and := 1<<uint(width) - 1
+			bs := make([][]byt, width)
+			and := uint64(1)<<uint(width) - 1
 			for i := 0; i < 8; i++ {
-				shift := (i * width) % 8
-				if shift+width > 8 {
-					a1 := 7 >> uint(width-(8-shift))
-					a2 := 7 - a1
-					s2 := 8 - shift
-					bs[x] = append(bs[x],
-						byt{
-							I:     i,
-							And:   a1,
-							Shift: shift,
-							Dir:   "<<",
-						})
-					x++
-					bs = append(bs, []byt{})
-					bs[x] = append(bs[x],
-						byt{
-							I:     i,
-							And:   a2,
-							Or:    " |\n",
-							Shift: s2,
-							Dir:   ">>",
-						},
-					)
-				} else {
-					o := " |\n"
-					if shift+width == 8 {
-						o = ""
-					}
-					bs[x] = append(bs[x], byt{
-						I:     i,
-						And:   and,
-						Or:    o,
-						Shift: shift,
-						Dir:   "<<",
-					})
-					if shift+width == 8 && i < 7 {
-						bs = append(bs, []byt{})
-						x++
+				start := i * width
+				for x := start / 8; x*8 < start+width; x++ {
+					b := byt{I: i, And: and, Dir: "<<", Shift: start - x*8}
+					if b.Shift < 0 {
+						b.Dir, b.Shift = ">>", -b.Shift
 					}
+					bs[x] = append(bs[x], b)
 				}
 			}
 			return bs
 		},
-		"uint8": func(width, i int) string {
-			shift := (i * width) % 8
-			index := (i * width) / 8
-			mask := ((1 << uint(width)) - 1) << uint(shift)
-			if mask < (1 << 8) {
-				return fmt.Sprintf("(uint8(vals[%d] & %d) >> %d),", index, mask, shift)
+		// unpack returns the statements that unpack n values from
+		// the n*width/8 bytes they were packed into.  The first two
+		// lines let the compiler drop the rest of the bounds checks.
+		"unpack": func(width, n int) string {
+			lines := []string{
+				fmt.Sprintf("_ = vals[%d]", n*width/8-1),
+				fmt.Sprintf("_ = dst[%d]", n-1),
 			}
-
-			return fmt.Sprintf(
-				"%s | %s,",
-				fmt.Sprintf("(uint8(vals[%d] & %d) >> %d)", index, mask&((1<<8)-1), shift),
-				fmt.Sprintf("(uint8(vals[%d] & %d) << %d)", index+1, mask>>8, 8-shift),
-			)
+			for i := 0; i < n; i++ {
+				lines = append(lines, fmt.Sprintf("dst[%d] = %s", i, unpack(width, i)))
+			}
+			return strings.Join(lines, "\n")
 		},
 		"N": func(start, end int) (stream chan int) {
 			stream = make(chan int)
@@ -149,17 +139,13 @@ var (
 		},
 	}
 
-	/*
-
-
-
-	 */
-
 	tpl = `package {{.Package}}
 
 // Code generated by github.com/parsyl/parquet.  DO NOT EDIT.
 
-func Pack(width int, vals []uint8) []byte {
+// Pack packs 8 values into width bytes, starting with the least
+// significant bit of the first value.
+func Pack(width int, vals []uint32) []byte {
 	switch width {
 		{{range $i := N 1 .Max }}case {{$i}}:
 			return pack{{$i}}(vals)
@@ -169,32 +155,58 @@ func Pack(width int, vals []uint8) []byte {
 }
 
 {{range $i := N 1 .Max}}
-func pack{{$i}}(vals []uint8) []byte {
+func pack{{$i}}(vals []uint32) []byte {
 return []byte{ {{template "bytes" $i}} }
 }
 {{end}}
 
-func Unpack(width int, vals []byte) []uint8 {
+// Unpack unpacks the 8 values that Pack packed into width bytes.
+func Unpack(width int, vals []byte) []uint32 {
+	f := Unpacker(width)
+	if f == nil {
+		return []uint32{}
+	}
+	out := make([]uint32, 8)
+	f(out, vals)
+	return out
+}
+
+// Unpacker returns the function that unpacks 8 values from width
+// bytes into dst, or nil if width isn't 1 through {{.Max}}.
+func Unpacker(width int) func(dst []uint32, vals []byte) {
+	switch width {
+		{{range $i := N 1 .Max }}case {{$i}}:
+			return unpack{{$i}}
+		{{end}}default:
+			return nil
+	}
+}
+
+// Unpacker32 is like Unpacker, but the function unpacks
+// 32 values from 4*width bytes.
+func Unpacker32(width int) func(dst []uint32, vals []byte) {
 	switch width {
 		{{range $i := N 1 .Max }}case {{$i}}:
-			return unpack{{$i}}(vals)
+			return unpack32x{{$i}}
 		{{end}}default:
-			return []uint8{}
+			return nil
 	}
 }
 
 {{range $i := N 1 .Max }}
-	   func unpack{{$i}}(vals []byte) []uint8 { {{template "ints" .}}
-	   }
+func unpack{{$i}}(dst []uint32, vals []byte) {
+{{unpack $i 8}}
+}
+
+func unpack32x{{$i}}(dst []uint32, vals []byte) {
+{{unpack $i 32}}
+}
 {{end}}
 `
 
 	bytesTpl = `{{define "bytes"}}
-{{ $bytes := pack .}} {{range $byte := $bytes}} ( {{ range $b := $byte}} byte((vals[{{$b.I}}]&{{$b.And}}){{$b.Dir}}{{$b.Shift}}){{$b.Or}}{{end}} ),
+{{ $bytes := pack .}} {{range $byte := $bytes}} ( {{ range $j, $b := $byte}}{{if $j}} |
+{{end}} byte((vals[{{$b.I}}]&{{$b.And}}){{$b.Dir}}{{$b.Shift}}){{end}} ),
 {{end}}
 {{end}}`
-	intsTpl = `{{define "ints"}}{{$width := .}}
-return []uint8{
-{{range $i := N 0 7}} {{uint8 $width $i}}
-{{end}} }{{end}}`
 )
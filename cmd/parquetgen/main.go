@@ -2,13 +2,18 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/parsyl/parquet"
+	"github.com/parsyl/parquet/internal/fields"
 	"github.com/parsyl/parquet/internal/gen"
+	"github.com/parsyl/parquet/internal/parse"
 	sch "github.com/parsyl/parquet/schema"
 )
 
@@ -23,16 +28,51 @@ var (
 	ignore       = flag.Bool("ignore", true, "ignore unsupported fields in -type, otherwise log.Fatal is called when an unsupported type is encountered")
 	parq         = flag.String("parquet", "", "path to a parquet file (if you are generating code based on an existing parquet file or printing the file metadata or page headers)")
 	structOutPth = flag.String("struct-output", "generated_struct.go", "name of the file that is produced, defaults to parquet.go")
+	check        = flag.Bool("check", false, "check that -type (in -input) can read a parquet file (-parquet), print the columns that don't match and exit with status 1 if there are any")
+	strict       = flag.Bool("strict", false, "with -check, also report columns that only one of -type and the parquet file has")
+	repair       = flag.String("repair", "", "path to a parquet file that is missing its footer, a copy with a new footer is written to -output (the schema comes from -input and -type or from a reference -parquet file)")
 )
 
+// typeFuncs set the parquet type of the columns of a struct
+// that is used by -repair and -check (see the generated Int32Type, etc).
+var typeFuncs = map[string]parquet.FieldFunc{
+	"Int32Type":   parquetType(sch.Type_INT32, nil),
+	"Uint32Type":  parquetType(sch.Type_INT32, sch.ConvertedTypePtr(sch.ConvertedType_UINT_32)),
+	"Int64Type":   parquetType(sch.Type_INT64, nil),
+	"Uint64Type":  parquetType(sch.Type_INT64, sch.ConvertedTypePtr(sch.ConvertedType_UINT_64)),
+	"Float32Type": parquetType(sch.Type_FLOAT, nil),
+	"Float64Type": parquetType(sch.Type_DOUBLE, nil),
+	"BoolType":    parquetType(sch.Type_BOOLEAN, nil),
+	"StringType":  parquetType(sch.Type_BYTE_ARRAY, nil),
+}
+
+// timeUnits are the units of the time.Time columns of a struct
+// (see the unit option of the parquet struct tag).
+var timeUnits = map[string]parquet.TimeUnit{
+	"millis": parquet.Millis,
+	"micros": parquet.Micros,
+	"nanos":  parquet.Nanos,
+	"int96":  parquet.Int96,
+}
+
 func main() {
 	flag.Parse()
 
-	if *pth != "" && *parq != "" {
+	if *pth != "" && *parq != "" && !*check {
 		log.Fatal("choose -parquet or -input, but not both")
 	}
 
-	if *metadata {
+	if flag.Arg(0) == "merge" {
+		mergeFiles(flag.Args()[1:])
+	} else if flag.Arg(0) == "split" {
+		splitFile(flag.Args()[1:])
+	} else if flag.Arg(0) == "compact" {
+		compactFiles(flag.Args()[1:])
+	} else if *check {
+		checkSchema()
+	} else if *repair != "" {
+		repairFile()
+	} else if *metadata {
 		readFooter()
 	} else if *pageheaders {
 		readPageHeaders()
@@ -43,6 +83,272 @@ func main() {
 	}
 }
 
+// mergeFiles is the merge command:
+//
+//	parquetgen merge -output merged.parquet a.parquet b.parquet ...
+func mergeFiles(args []string) {
+	fs := flag.NewFlagSet("merge", flag.ExitOnError)
+	output := fs.String("output", "", "path to the merged parquet file")
+	fs.Parse(args)
+
+	if *output == "" || fs.NArg() == 0 {
+		log.Fatal("usage: parquetgen merge -output <file> <input files>")
+	}
+
+	inputs := make([]io.ReadSeeker, fs.NArg())
+	for i, pth := range fs.Args() {
+		f, err := os.Open(pth)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		inputs[i] = f
+	}
+
+	out, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := parquet.Concat(out, inputs...); err != nil {
+		log.Fatal("couldn't merge files: ", err)
+	}
+
+	if err := out.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// compactFiles is the compact command:
+//
+//	parquetgen compact -rows 100000 -output compacted.parquet a.parquet b.parquet ...
+func compactFiles(args []string) {
+	fs := flag.NewFlagSet("compact", flag.ExitOnError)
+	output := fs.String("output", "", "path to the compacted parquet file")
+	codec := fs.String("codec", "", "compression of the compacted file (snappy, zstd or uncompressed), defaults to the compression of the input files")
+	var opts parquet.CompactOptions
+	fs.Int64Var(&opts.RowGroupRows, "rows", 0, "maximum number of rows in each row group")
+	fs.Int64Var(&opts.RowGroupSize, "size", 0, "target uncompressed size, in bytes, of each row group")
+	fs.IntVar(&opts.PageRows, "pagerows", 1000, "number of rows in each page")
+	fs.Parse(args)
+
+	if *output == "" || fs.NArg() == 0 {
+		log.Fatal("usage: parquetgen compact [-rows n] [-size bytes] [-pagerows n] [-codec name] -output <file> <input files>")
+	}
+
+	if *codec != "" {
+		c, err := sch.CompressionCodecFromString(strings.ToUpper(*codec))
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts.Codec = &c
+	}
+
+	inputs := make([]io.ReadSeeker, fs.NArg())
+	for i, pth := range fs.Args() {
+		f, err := os.Open(pth)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		inputs[i] = f
+	}
+
+	out, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	n, err := parquet.Compact(out, opts, inputs...)
+	if err != nil {
+		log.Fatal("couldn't compact files: ", err)
+	}
+
+	if err := out.Close(); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d rows", n)
+}
+
+// splitFile is the split command:
+//
+//	parquetgen split -rowgroups 10 -output part-%03d.parquet big.parquet
+func splitFile(args []string) {
+	fs := flag.NewFlagSet("split", flag.ExitOnError)
+	output := fs.String("output", "", "path of the output files, a printf format that is passed the index of each file (for example part-%03d.parquet)")
+	var opts parquet.SplitOptions
+	fs.IntVar(&opts.Files, "files", 0, "number of files to split the row groups over")
+	fs.IntVar(&opts.RowGroups, "rowgroups", 0, "maximum number of row groups in each file")
+	fs.Int64Var(&opts.MaxSize, "size", 0, "maximum size, in bytes, of the column chunks in each file")
+	fs.Parse(args)
+
+	if *output == "" || fs.NArg() != 1 {
+		log.Fatal("usage: parquetgen split [-files n] [-rowgroups n] [-size bytes] -output <format> <input file>")
+	}
+
+	in, err := os.Open(fs.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	var out *os.File
+	n, err := parquet.Split(in, opts, func(i int) (io.Writer, error) {
+		if out != nil {
+			if err := out.Close(); err != nil {
+				return nil, err
+			}
+		}
+		out, err = os.Create(fmt.Sprintf(*output, i))
+		return out, err
+	})
+	if err != nil {
+		log.Fatal("couldn't split file: ", err)
+	}
+
+	if out != nil {
+		if err := out.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("wrote %d files", n)
+}
+
+// checkSchema is the -check flag, which is meant for CI:
+//
+//	parquetgen -check -parquet people.parquet -input people.go -type Person
+func checkSchema() {
+	if *parq == "" {
+		log.Fatal("-check needs a -parquet file")
+	}
+
+	schema := structSchema()
+	f := openParquet()
+	footer := getFooter(f)
+	f.Close()
+
+	err := parquet.ValidateSchema(footer.Schema, schema, *strict)
+	var se *parquet.SchemaError
+	if errors.As(err, &se) {
+		for _, c := range se.Columns {
+			fmt.Println(c)
+		}
+		os.Exit(1)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s can read %s\n", *typ, *parq)
+}
+
+func repairFile() {
+	var output bool
+	flag.Visit(func(f *flag.Flag) { output = output || f.Name == "output" })
+	if !output {
+		log.Fatal("-output is required with -repair")
+	}
+
+	var schema []parquet.Field
+	if *parq != "" {
+		f := openParquet()
+		footer := getFooter(f)
+		f.Close()
+
+		var err error
+		schema, err = parquet.SchemaFields(footer.Schema)
+		if err != nil {
+			log.Fatal("couldn't read schema: ", err)
+		}
+	} else {
+		schema = structSchema()
+	}
+
+	in, err := os.Open(*repair)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	fi, err := in.Stat()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := os.Create(*outPth)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	n, err := parquet.Recover(in, fi.Size(), out, schema...)
+	if err != nil {
+		log.Fatal("couldn't repair file: ", err)
+	}
+
+	if err := out.Close(); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("recovered %d rows", n)
+}
+
+// structSchema returns the columns of -type (in -input) the
+// same way that the generated code's Fields would.
+func structSchema() []parquet.Field {
+	if *pth == "" || *typ == "" {
+		log.Fatal("-input and -type are required (-repair can use a reference -parquet file instead)")
+	}
+
+	result, err := parse.Fields(*typ, *pth)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, err := range result.Errors {
+		log.Println(err)
+	}
+
+	if len(result.Errors) > 0 && !*ignore {
+		log.Fatal("unsupported fields (-ignore set to false), err: ", result.Errors)
+	}
+
+	out := make([]parquet.Field, len(result.Fields))
+	for i, f := range result.Fields {
+		types := make([]int, len(f.RepetitionTypes))
+		for j, rt := range f.RepetitionTypes {
+			types[j] = int(rt)
+		}
+
+		rt := parquet.RepetitionRequired
+		switch f.RepetitionTypes[len(f.RepetitionTypes)-1] {
+		case fields.Optional:
+			rt = parquet.RepetitionOptional
+		case fields.Repeated:
+			rt = parquet.RepetitionRepeated
+		}
+
+		typ := typeFuncs[f.ParquetType]
+		if f.TimeUnit != "" {
+			typ = parquet.TimestampType(timeUnits[f.TimeUnit], !f.Local)
+		}
+
+		out[i] = parquet.Field{
+			Name:           strings.Join(f.ColumnNames, "."),
+			Path:           f.ColumnNames,
+			Types:          types,
+			Type:           typ,
+			RepetitionType: rt,
+		}
+	}
+	return out
+}
+
+func parquetType(t sch.Type, ct *sch.ConvertedType) parquet.FieldFunc {
+	return func(se *sch.SchemaElement) {
+		se.Type = &t
+		se.ConvertedType = ct
+	}
+}
+
 func readPageHeaders() {
 	f := openParquet()
 	footer := getFooter(f)
@@ -0,0 +1,575 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// CompactOptions say how Compact lays out the new file.  If neither
+// RowGroupRows nor RowGroupSize is set every row goes in one row group.
+type CompactOptions struct {
+	// RowGroupRows is the maximum number of rows in each row group.
+	RowGroupRows int64
+	// RowGroupSize is the target uncompressed size, in bytes, of each
+	// row group.  It is turned into a number of rows using the average
+	// row size of the inputs.
+	RowGroupSize int64
+	// PageRows is the number of rows in each page.  It defaults to
+	// 1000, the same as a ParquetWriter's MaxPageSize.
+	PageRows int
+	// Codec is the compression of the new file.  If it is nil each
+	// column keeps the codec of its first column chunk in the inputs.
+	Codec *sch.CompressionCodec
+}
+
+// Compact reads the rows of inputs, in order, and writes them to w
+// in new row groups and pages, which Concat can't do.  The inputs must
+// have identical schemas.  The columns are decoded with RequiredField
+// and OptionalField using the schema in the footer of the first input,
+// so no generated code is needed, and the page statistics are worked
+// out again.  Columns whose first column chunk in the inputs starts
+// with a dictionary page are dictionary encoded again, and the ones
+// whose first column chunk has a bloom filter get new bloom filters.  The key value
+// metadata of the first input is kept.  It returns the number of rows
+// that were written.
+//
+// Each row group is held in memory while it is being written.
+// Encrypted files can't be compacted.
+func Compact(w io.Writer, opts CompactOptions, inputs ...io.ReadSeeker) (int64, error) {
+	if len(inputs) == 0 {
+		return 0, fmt.Errorf("nothing to compact")
+	}
+
+	footers, err := readFooters(inputs)
+	if err != nil {
+		return 0, err
+	}
+
+	fields, err := SchemaFields(footers[0].Schema)
+	if err != nil {
+		return 0, err
+	}
+
+	cols := make([]*compactColumn, len(fields))
+	for i, f := range fields {
+		codec := compactCodec(opts, footers, strings.Join(f.Path, "."))
+		if codec != sch.CompressionCodec_SNAPPY && codec != sch.CompressionCodec_ZSTD && codec != sch.CompressionCodec_UNCOMPRESSED {
+			return 0, fmt.Errorf("%w: %s", ErrUnsupportedCodec, codec)
+		}
+
+		cols[i], err = newCompactColumn(f, codec)
+		if err != nil {
+			return 0, err
+		}
+
+		if md := firstChunk(footers, strings.Join(f.Path, ".")); md != nil && cols[i].typ != sch.Type_BOOLEAN {
+			dictionary := chunkOffset(md) != md.DataPageOffset
+			bloom := md.BloomFilterOffset != nil
+			if cols[i].req != nil {
+				cols[i].req.dictionary, cols[i].req.bloom = dictionary, bloom
+			} else {
+				cols[i].opt.dictionary, cols[i].opt.bloom = dictionary, bloom
+			}
+		}
+	}
+
+	pageRows := int64(opts.PageRows)
+	if pageRows <= 0 {
+		pageRows = 1000
+	}
+
+	rowGroupRows := compactRows(opts, footers)
+
+	if _, err := w.Write(Magic(nil)); err != nil {
+		return 0, err
+	}
+
+	m := New(fields...)
+	m.keyValues = footers[0].KeyValueMetadata
+
+	var pending int64
+	for i, r := range inputs {
+		rm := New(fields...)
+		rm.metadata = footers[i]
+		pages, err := rm.Pages()
+		if err != nil {
+			return 0, fmt.Errorf("input %d: %w", i, err)
+		}
+
+		for j, rg := range footers[i].RowGroups {
+			for _, c := range cols {
+				if j >= len(pages[c.name]) {
+					return 0, fmt.Errorf("input %d: %w", i, corruptFooter("row group %d is missing column %s", j, c.name))
+				}
+
+				if err := c.read(r, pages[c.name][j], rg.NumRows); err != nil {
+					return 0, fmt.Errorf("input %d: %w", i, err)
+				}
+			}
+
+			pending += rg.NumRows
+			for rowGroupRows > 0 && pending >= rowGroupRows {
+				if err := writeCompacted(w, m, cols, fields, rowGroupRows, pageRows); err != nil {
+					return 0, err
+				}
+				pending -= rowGroupRows
+			}
+		}
+	}
+
+	if pending > 0 {
+		if err := writeCompacted(w, m, cols, fields, pending, pageRows); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := m.Footer(w); err != nil {
+		return 0, err
+	}
+
+	_, err = w.Write(Magic(nil))
+	return m.docs, err
+}
+
+// compactCodec returns the codec that Compact writes col with.
+func compactCodec(opts CompactOptions, footers []*sch.FileMetaData, col string) sch.CompressionCodec {
+	if opts.Codec != nil {
+		return *opts.Codec
+	}
+
+	if md := firstChunk(footers, col); md != nil {
+		return md.Codec
+	}
+	return sch.CompressionCodec_SNAPPY
+}
+
+// firstChunk returns the metadata of the first
+// column chunk of col in the inputs, if there is one.
+func firstChunk(footers []*sch.FileMetaData, col string) *sch.ColumnMetaData {
+	for _, footer := range footers {
+		for _, rg := range footer.RowGroups {
+			for _, ch := range rg.Columns {
+				if ch.MetaData != nil && strings.Join(ch.MetaData.PathInSchema, ".") == col {
+					return ch.MetaData
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// compactRows returns the number of rows in each row
+// group that Compact writes, or 0 if there is no limit.
+func compactRows(opts CompactOptions, footers []*sch.FileMetaData) int64 {
+	rows := opts.RowGroupRows
+	if opts.RowGroupSize <= 0 {
+		return rows
+	}
+
+	var n, size int64
+	for _, footer := range footers {
+		for _, rg := range footer.RowGroups {
+			n += rg.NumRows
+			for _, ch := range rg.Columns {
+				if ch.MetaData != nil {
+					size += ch.MetaData.TotalUncompressedSize
+				}
+			}
+		}
+	}
+
+	if n == 0 || size == 0 {
+		return rows
+	}
+
+	r := int64(float64(opts.RowGroupSize) * float64(n) / float64(size))
+	if r < 1 {
+		r = 1
+	}
+
+	if rows == 0 || r < rows {
+		rows = r
+	}
+	return rows
+}
+
+// writeCompacted writes the next rows rows of each of cols
+// to w as a row group with pages of pageRows rows.
+func writeCompacted(w io.Writer, m *Metadata, cols []*compactColumn, fields []Field, rows, pageRows int64) error {
+	m.docs += rows
+	m.rowGroupDocs = rows
+	for _, c := range cols {
+		for n := rows; n > 0; n -= pageRows {
+			if err := c.write(w, m, min64(n, pageRows)); err != nil {
+				return err
+			}
+		}
+
+		if err := m.WriteDictionary(w, c.pth); err != nil {
+			return err
+		}
+	}
+	m.StartRowGroup(fields...)
+	return nil
+}
+
+// compactColumn holds the values and levels of a column that have
+// been read by Compact but not written yet.  Each value is its plain
+// encoding (without the length of byte arrays and with a whole byte
+// for bools) and refers to the page that it was read from.
+type compactColumn struct {
+	name     string
+	pth      []string
+	typ      sch.Type
+	unsigned bool
+	req      *RequiredField
+	opt      *OptionalField
+
+	vals [][]byte
+	defs []uint8
+	reps []uint8
+}
+
+func newCompactColumn(f Field, codec sch.CompressionCodec) (*compactColumn, error) {
+	var se sch.SchemaElement
+	f.Type(&se)
+	switch *se.Type {
+	case sch.Type_BOOLEAN, sch.Type_INT32, sch.Type_INT64, sch.Type_FLOAT, sch.Type_DOUBLE, sch.Type_BYTE_ARRAY:
+	default:
+		return nil, fmt.Errorf("unable to compact %s column %s", *se.Type, f.Name)
+	}
+
+	c := &compactColumn{name: f.Name, pth: f.Path, typ: *se.Type}
+	if se.ConvertedType != nil {
+		switch *se.ConvertedType {
+		case sch.ConvertedType_UINT_8, sch.ConvertedType_UINT_16, sch.ConvertedType_UINT_32, sch.ConvertedType_UINT_64:
+			c.unsigned = true
+		}
+	}
+
+	if getRepetitionTypes(f.Types).MaxDef() == 0 {
+		req := NewRequiredField(f.Path)
+		req.compression = codec
+		c.req = &req
+	} else {
+		opt := NewOptionalField(f.Path, f.Types)
+		opt.compression = codec
+		c.opt = &opt
+	}
+	return c, nil
+}
+
+// read reads the column chunk described by pg, which
+// should have rows rows, and adds its values and levels.
+func (c *compactColumn) read(r io.ReadSeeker, pg Page, rows int64) error {
+	if _, err := r.Seek(pg.Offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	var rr io.Reader
+	var sizes []int
+	var err error
+	var n int64
+	if c.req != nil {
+		rr, sizes, err = c.req.DoRead(r, pg)
+		n = int64(pg.N)
+	} else {
+		start := len(c.defs)
+		c.opt.Defs, c.opt.Reps = c.defs, c.reps
+		rr, sizes, err = c.opt.DoRead(r, pg)
+		c.defs, c.reps = c.opt.Defs, c.opt.Reps
+		if err == nil {
+			n = c.rows(start)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if n != rows {
+		return pageError(pg, pg.Offset, corruptPage("the column chunk has %d rows, expected %d", n, rows))
+	}
+
+	var count int
+	for _, s := range sizes {
+		count += s
+	}
+
+	if c.typ == sch.Type_BOOLEAN {
+		vals, err := GetBools(rr, count, sizes)
+		if err != nil {
+			return pageError(pg, pg.Offset, err)
+		}
+
+		for _, v := range vals {
+			if v {
+				c.vals = append(c.vals, compactTrue)
+			} else {
+				c.vals = append(c.vals, compactFalse)
+			}
+		}
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(rr)
+	if err != nil {
+		return err
+	}
+
+	if c.typ == sch.Type_BYTE_ARRAY {
+		vals, err := decodeByteArrays(data, count)
+		if err != nil {
+			return pageError(pg, pg.Offset, err)
+		}
+		c.vals = append(c.vals, vals...)
+		return nil
+	}
+
+	width := 4
+	if c.typ == sch.Type_INT64 || c.typ == sch.Type_DOUBLE {
+		width = 8
+	}
+
+	if len(data) < width*count {
+		return pageError(pg, pg.Offset, corruptPage("%d values don't fit in %d bytes", count, len(data)))
+	}
+
+	for i := 0; i < count; i++ {
+		c.vals = append(c.vals, data[i*width:(i+1)*width:(i+1)*width])
+	}
+	return nil
+}
+
+var (
+	compactFalse = []byte{0}
+	compactTrue  = []byte{1}
+)
+
+// rows returns the number of rows whose levels start at c.defs[start:].
+func (c *compactColumn) rows(start int) int64 {
+	if c.opt.MaxLevels.Rep == 0 {
+		return int64(len(c.defs) - start)
+	}
+
+	var n int64
+	for _, rep := range c.reps[start:] {
+		if rep == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// levels returns the number of levels that the first rows rows have.
+func (c *compactColumn) levels(rows int64) int {
+	if c.opt.MaxLevels.Rep == 0 {
+		return int(rows)
+	}
+
+	for i, rep := range c.reps {
+		if rep == 0 {
+			if rows == 0 {
+				return i
+			}
+			rows--
+		}
+	}
+	return len(c.reps)
+}
+
+// write writes the next rows rows of the column as a page.
+func (c *compactColumn) write(w io.Writer, m *Metadata, rows int64) error {
+	stats := &compactStats{typ: c.typ, unsigned: c.unsigned}
+	if c.req != nil {
+		vals := c.vals[:rows]
+		c.vals = c.vals[rows:]
+		for _, v := range vals {
+			stats.add(v)
+		}
+		return c.req.DoWrite(w, m, c.encode(vals), len(vals), stats)
+	}
+
+	l := c.levels(rows)
+	defs := c.defs[:l]
+	var reps []uint8
+	if c.opt.MaxLevels.Rep > 0 {
+		reps = c.reps[:l]
+		c.reps = c.reps[l:]
+	}
+	c.defs = c.defs[l:]
+
+	stats.nulls = new(int64)
+	var n int
+	for _, def := range defs {
+		if def < c.opt.MaxLevels.Def {
+			*stats.nulls++
+			continue
+		}
+		stats.add(c.vals[n])
+		n++
+	}
+
+	vals := c.vals[:n]
+	c.vals = c.vals[n:]
+	c.opt.Defs, c.opt.Reps = defs, reps
+	return c.opt.DoWrite(w, m, c.encode(vals), len(defs), stats)
+}
+
+// encode returns the plain encoding of vals.
+func (c *compactColumn) encode(vals [][]byte) []byte {
+	switch c.typ {
+	case sch.Type_BOOLEAN:
+		out := make([]byte, (len(vals)+7)/8)
+		for i, v := range vals {
+			if v[0] == 1 {
+				out[i/8] |= 1 << uint(i%8)
+			}
+		}
+		return out
+	case sch.Type_BYTE_ARRAY:
+		var out []byte
+		var l [4]byte
+		for _, v := range vals {
+			binary.LittleEndian.PutUint32(l[:], uint32(len(v)))
+			out = append(out, l[:]...)
+			out = append(out, v...)
+		}
+		return out
+	default:
+		var out []byte
+		for _, v := range vals {
+			out = append(out, v...)
+		}
+		return out
+	}
+}
+
+// compactStats are the page statistics that Compact writes.  Like
+// the generated fields' statistics, bool columns don't have a min
+// or max and only optional columns have a null count.
+type compactStats struct {
+	typ      sch.Type
+	unsigned bool
+	nulls    *int64
+	min, max []byte
+	set      bool
+}
+
+func (s *compactStats) add(val []byte) {
+	// NaN isn't included in the min and max
+	if s.typ == sch.Type_BOOLEAN || s.isNaN(val) {
+		return
+	}
+
+	if !s.set {
+		s.min, s.max, s.set = val, val, true
+		return
+	}
+	if s.less(val, s.min) {
+		s.min = val
+	}
+	if s.less(s.max, val) {
+		s.max = val
+	}
+}
+
+func (s *compactStats) isNaN(val []byte) bool {
+	switch s.typ {
+	case sch.Type_FLOAT:
+		return math.IsNaN(float64(math.Float32frombits(binary.LittleEndian.Uint32(val))))
+	case sch.Type_DOUBLE:
+		return math.IsNaN(math.Float64frombits(binary.LittleEndian.Uint64(val)))
+	}
+	return false
+}
+
+func (s *compactStats) isZero(val []byte) bool {
+	switch s.typ {
+	case sch.Type_FLOAT:
+		return math.Float32frombits(binary.LittleEndian.Uint32(val)) == 0
+	case sch.Type_DOUBLE:
+		return math.Float64frombits(binary.LittleEndian.Uint64(val)) == 0
+	}
+	return false
+}
+
+func (s *compactStats) less(a, b []byte) bool {
+	switch s.typ {
+	case sch.Type_INT32:
+		x, y := binary.LittleEndian.Uint32(a), binary.LittleEndian.Uint32(b)
+		if s.unsigned {
+			return x < y
+		}
+		return int32(x) < int32(y)
+	case sch.Type_INT64:
+		x, y := binary.LittleEndian.Uint64(a), binary.LittleEndian.Uint64(b)
+		if s.unsigned {
+			return x < y
+		}
+		return int64(x) < int64(y)
+	case sch.Type_FLOAT:
+		return math.Float32frombits(binary.LittleEndian.Uint32(a)) < math.Float32frombits(binary.LittleEndian.Uint32(b))
+	case sch.Type_DOUBLE:
+		return math.Float64frombits(binary.LittleEndian.Uint64(a)) < math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return bytes.Compare(a, b) < 0
+}
+
+func (s *compactStats) NullCount() *int64 {
+	return s.nulls
+}
+
+func (s *compactStats) DistinctCount() *int64 {
+	return nil
+}
+
+func (s *compactStats) Min() []byte {
+	if !s.set {
+		return nil
+	}
+
+	// a min of zero is written as -0
+	if s.isZero(s.min) {
+		return s.zero(true)
+	}
+	return s.min
+}
+
+func (s *compactStats) Max() []byte {
+	if !s.set {
+		return nil
+	}
+
+	// a max of zero is written as +0
+	if s.isZero(s.max) {
+		return s.zero(false)
+	}
+	return s.max
+}
+
+func (s *compactStats) zero(neg bool) []byte {
+	z := 0.0
+	if neg {
+		z = math.Copysign(0, -1)
+	}
+
+	if s.typ == sch.Type_FLOAT {
+		return EncodeFloat32s(nil, []float32{float32(z)})
+	}
+	return EncodeFloat64s(nil, []float64{z})
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
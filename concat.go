@@ -0,0 +1,207 @@
+package parquet
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// Concat writes a parquet file to w that has all of the row groups of
+// inputs, in order.  The inputs must have identical schemas.  The column
+// chunks (and their bloom filters) are copied without being decoded,
+// so only their offsets in the footer change.  The key value metadata of the first input is kept.
+// Encrypted files can't be concatenated.
+func Concat(w io.Writer, inputs ...io.ReadSeeker) error {
+	if len(inputs) == 0 {
+		return fmt.Errorf("nothing to concatenate")
+	}
+
+	footers, err := readFooters(inputs)
+	if err != nil {
+		return err
+	}
+
+	var rgs []rowGroupSource
+	for i, r := range inputs {
+		for _, rg := range footers[i].RowGroups {
+			rgs = append(rgs, rowGroupSource{r: r, rg: rg, name: fmt.Sprintf("input %d", i)})
+		}
+	}
+	return copyRowGroups(w, footers[0], rgs)
+}
+
+// readFooters reads the footer of each of inputs and checks
+// that they all have the same schema and aren't encrypted.
+func readFooters(inputs []io.ReadSeeker) ([]*sch.FileMetaData, error) {
+	footers := make([]*sch.FileMetaData, len(inputs))
+	for i, r := range inputs {
+		footer, err := ReadMetaData(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the footer of input %d: %w", i, err)
+		}
+
+		if err := checkNotEncrypted(footer); err != nil {
+			return nil, fmt.Errorf("input %d: %w", i, err)
+		}
+
+		if i > 0 {
+			if err := compareSchema(footer.Schema, footers[0].Schema); err != nil {
+				return nil, fmt.Errorf("input %d: %w", i, err)
+			}
+		}
+		footers[i] = footer
+	}
+	return footers, nil
+}
+
+// checkNotEncrypted returns ErrEncrypted if footer is the
+// plaintext footer of an encrypted file or has encrypted columns.
+func checkNotEncrypted(footer *sch.FileMetaData) error {
+	if footer.EncryptionAlgorithm != nil {
+		return fmt.Errorf("%w: it has a plaintext footer", ErrEncrypted)
+	}
+
+	for i, rg := range footer.RowGroups {
+		for _, ch := range rg.Columns {
+			if ch.CryptoMetadata != nil || ch.MetaData == nil {
+				return fmt.Errorf("%w: row group %d has an encrypted column", ErrEncrypted, i)
+			}
+		}
+	}
+	return nil
+}
+
+// rowGroupSource is a row group of the file r.  name
+// describes where it came from for errors.
+type rowGroupSource struct {
+	r    io.ReadSeeker
+	rg   *sch.RowGroup
+	name string
+}
+
+// copyRowGroups writes a parquet file with the row groups in rgs to
+// w.  The schema and key value metadata are taken from footer.  The
+// column chunks of rgs are updated to their offsets in the new file.
+func copyRowGroups(w io.Writer, footer *sch.FileMetaData, rgs []rowGroupSource) error {
+	wc := &writeCounter{w: w}
+	if _, err := wc.Write([]byte(magic)); err != nil {
+		return err
+	}
+
+	fmd := &sch.FileMetaData{
+		Version:          1,
+		Schema:           footer.Schema,
+		KeyValueMetadata: footer.KeyValueMetadata,
+		CreatedBy:        footer.CreatedBy,
+	}
+
+	for _, src := range rgs {
+		for _, ch := range src.rg.Columns {
+			if err := copyColumnChunk(wc, src.r, ch); err != nil {
+				return fmt.Errorf("%s: %w", src.name, err)
+			}
+		}
+		fmd.NumRows += src.rg.NumRows
+		fmd.RowGroups = append(fmd.RowGroups, src.rg)
+	}
+
+	// the bloom filters go between the last row group and the footer
+	for _, src := range rgs {
+		for _, ch := range src.rg.Columns {
+			if err := copyBloomFilter(wc, src.r, ch.MetaData); err != nil {
+				return fmt.Errorf("%s: %w", src.name, err)
+			}
+		}
+	}
+
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	buf, err := ts.Write(context.TODO(), fmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := wc.Write(buf); err != nil {
+		return err
+	}
+
+	if err := binary.Write(wc, binary.LittleEndian, uint32(len(buf))); err != nil {
+		return err
+	}
+
+	_, err = wc.Write([]byte(magic))
+	return err
+}
+
+// copyColumnChunk copies the pages of ch from r to the end of w and
+// moves the offsets of ch to where the pages are now.  Page indexes
+// are not copied so their offsets are removed.
+func copyColumnChunk(w *writeCounter, r io.ReadSeeker, ch *sch.ColumnChunk) error {
+	md := ch.MetaData
+	if md == nil {
+		return fmt.Errorf("%w: unable to copy an encrypted column", ErrEncrypted)
+	}
+
+	start := md.DataPageOffset
+	if md.DictionaryPageOffset != nil && *md.DictionaryPageOffset < start {
+		start = *md.DictionaryPageOffset
+	}
+
+	if _, err := r.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+
+	pos := w.n
+	if _, err := io.CopyN(w, r, md.TotalCompressedSize); err != nil {
+		return fmt.Errorf("unable to copy column %v: %w", md.PathInSchema, err)
+	}
+
+	delta := pos - start
+	ch.FileOffset += delta
+	md.DataPageOffset += delta
+	if md.DictionaryPageOffset != nil {
+		o := *md.DictionaryPageOffset + delta
+		md.DictionaryPageOffset = &o
+	}
+	if md.IndexPageOffset != nil {
+		o := *md.IndexPageOffset + delta
+		md.IndexPageOffset = &o
+	}
+
+	ch.OffsetIndexOffset, ch.OffsetIndexLength = nil, nil
+	ch.ColumnIndexOffset, ch.ColumnIndexLength = nil, nil
+	return nil
+}
+
+// copyBloomFilter copies the bloom filter of the column chunk md,
+// if it has one, from r to the end of w and moves its offset.
+func copyBloomFilter(w *writeCounter, r io.ReadSeeker, md *sch.ColumnMetaData) error {
+	if md.BloomFilterOffset == nil {
+		return nil
+	}
+
+	if _, err := r.Seek(*md.BloomFilterOffset, io.SeekStart); err != nil {
+		return err
+	}
+
+	rc := &readCounter{r: r}
+	bh, err := readBloomFilterHeader(rc, DefaultLimits)
+	if err != nil {
+		return fmt.Errorf("unable to read the bloom filter of column %v: %w", md.PathInSchema, err)
+	}
+
+	if _, err := r.Seek(*md.BloomFilterOffset, io.SeekStart); err != nil {
+		return err
+	}
+
+	pos := w.n
+	if _, err := io.CopyN(w, r, rc.n+int64(bh.NumBytes)); err != nil {
+		return fmt.Errorf("unable to copy the bloom filter of column %v: %w", md.PathInSchema, err)
+	}
+	md.BloomFilterOffset = &pos
+	return nil
+}
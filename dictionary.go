@@ -0,0 +1,351 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+
+	"github.com/parsyl/parquet/internal/rle"
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// maxDictionarySize is the largest (plain encoded) dictionary that is
+// written for a column chunk.  Once a page would make the dictionary
+// any larger it, and the rest of the pages of the chunk, are plain
+// encoded instead, which is what other parquet writers do too.
+const maxDictionarySize = 1 << 20
+
+// dictionary holds the distinct values of a dictionary encoded
+// column chunk while it is being written.  The pages of the chunk
+// are held back in pages until WriteDictionary writes them after
+// the dictionary page.
+type dictionary struct {
+	typ   sch.Type
+	codec sch.CompressionCodec
+	index map[string]uint32
+	// values is the plain encoding of the n values in the dictionary.
+	values []byte
+	n      int
+	pages  bytes.Buffer
+	// plain is true once a page was too big for the dictionary.
+	plain bool
+	// pageLen is the size of the dictionary page (with its
+	// header) once it has been written.
+	pageLen int64
+}
+
+// dictionaryPage adds the n plain encoded values of the next page of
+// the dictionary encoded column at pth to its dictionary.  It returns
+// the writer that the page must be written to, the page's values as
+// dictionary indices (or the values as they are if they don't fit in
+// the dictionary) and the encoding of the values.
+func (m *Metadata) dictionaryPage(pth []string, codec sch.CompressionCodec, vals []byte, n int) (io.Writer, []byte, sch.Encoding, error) {
+	col := strings.Join(pth, ".")
+	m.mu.Lock()
+	if len(m.rowGroups) == 0 {
+		m.mu.Unlock()
+		return nil, nil, 0, fmt.Errorf("no row groups, you must call StartRowGroup at least once")
+	}
+
+	rg := m.rowGroups[len(m.rowGroups)-1]
+	d, ok := rg.dictionaries[col]
+	if !ok {
+		typ, err := columnType(col, m.schema)
+		if err != nil {
+			m.mu.Unlock()
+			return nil, nil, 0, err
+		}
+
+		if typ == sch.Type_BOOLEAN {
+			m.mu.Unlock()
+			return nil, nil, 0, fmt.Errorf("unable to dictionary encode boolean column %s", col)
+		}
+
+		d = &dictionary{typ: typ, codec: codec, index: map[string]uint32{}}
+		rg.dictionaries[col] = d
+	}
+	m.mu.Unlock()
+
+	vals, enc, err := d.encode(vals, n)
+	return &d.pages, vals, enc, err
+}
+
+// encode adds the n plain encoded values in vals to d and returns
+// them as a bit width followed by RLE/bit-packed dictionary indices.
+func (d *dictionary) encode(vals []byte, n int) ([]byte, sch.Encoding, error) {
+	if d.plain {
+		return vals, sch.Encoding_PLAIN, nil
+	}
+
+	values, err := splitValues(vals, n, d.typ)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	start, size := d.n, len(d.values)
+	ids := make([]uint32, len(values))
+	for i, v := range values {
+		id, ok := d.index[string(v)]
+		if !ok {
+			if len(d.values)+len(v) > maxDictionarySize {
+				d.rollback(start, size)
+				d.plain = true
+				return vals, sch.Encoding_PLAIN, nil
+			}
+
+			id = uint32(d.n)
+			d.index[string(v)] = id
+			d.values = append(d.values, v...)
+			d.n++
+		}
+		ids[i] = id
+	}
+
+	var width int
+	if d.n > 1 {
+		width = bits.Len(uint(d.n - 1))
+	}
+
+	enc, err := rle.New(int32(width), len(ids))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, id := range ids {
+		enc.Write(id)
+	}
+
+	// unlike levels, the indices don't start with their length
+	return append([]byte{byte(width)}, enc.Bytes()[4:]...), sch.Encoding_PLAIN_DICTIONARY, nil
+}
+
+// rollback removes the values that were added to d after it
+// had start values that took up size bytes.
+func (d *dictionary) rollback(start, size int) {
+	values, _ := splitValues(d.values[size:], d.n-start, d.typ)
+	for _, v := range values {
+		delete(d.index, string(v))
+	}
+	d.values = d.values[:size]
+	d.n = start
+}
+
+// WriteDictionary finishes a column chunk.  Dictionary encoded columns
+// (see RequiredFieldDictionary and OptionalFieldDictionary) hold back
+// their pages until the whole chunk has been written since the
+// dictionary page, which needs every value of the chunk, has to come
+// first.  WriteDictionary writes the dictionary page of the column at
+// pth followed by its pages to w.  It does nothing for other columns.
+func (m *Metadata) WriteDictionary(w io.Writer, pth []string) error {
+	col := strings.Join(pth, ".")
+	m.mu.Lock()
+	var d *dictionary
+	if len(m.rowGroups) > 0 {
+		d = m.rowGroups[len(m.rowGroups)-1].dictionaries[col]
+	}
+	m.mu.Unlock()
+
+	if d == nil || d.pageLen > 0 {
+		return nil
+	}
+
+	l, _, vals := compress(d.codec, d.values)
+	crc := m.Checksum(vals)
+
+	m.mu.Lock()
+	buf, vals, err := m.dictionaryHeader(pth, d, l, vals, crc)
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, b := range [][]byte{buf, vals, d.pages.Bytes()} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	d.pages = bytes.Buffer{}
+	return nil
+}
+
+// dictionaryHeader encrypts the (compressed) dictionary page data of d,
+// if its column is encrypted, and returns it along with its page header.
+// The page is added to the column chunk.  m.mu must be held.
+func (m *Metadata) dictionaryHeader(pth []string, d *dictionary, dataLen int, vals []byte, crc *int32) ([]byte, []byte, error) {
+	col := strings.Join(pth, ".")
+	var key []byte
+	if m.enc != nil {
+		key, _ = m.enc.key(col)
+	}
+
+	var err error
+	if key != nil {
+		vals, err = m.enc.encrypt(key, moduleDictionaryPage, m.rowGroupOrdinal(), m.enc.ordinals[col], 0, vals)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ph := &sch.PageHeader{
+		Type:                 sch.PageType_DICTIONARY_PAGE,
+		UncompressedPageSize: int32(dataLen),
+		CompressedPageSize:   int32(len(vals)),
+		Crc:                  crc,
+		DictionaryPageHeader: &sch.DictionaryPageHeader{
+			NumValues: int32(d.n),
+			Encoding:  sch.Encoding_PLAIN_DICTIONARY,
+		},
+	}
+
+	buf, err := m.ts.Write(context.TODO(), ph)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if key != nil {
+		buf, err = m.enc.encrypt(key, moduleDictionaryPageHeader, m.rowGroupOrdinal(), m.enc.ordinals[col], 0, buf)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	rg := m.rowGroups[len(m.rowGroups)-1]
+	if err := rg.updateColumnChunk(pth, dataLen+len(buf), len(vals)+len(buf), 0, m.schema, d.codec); err != nil {
+		return nil, nil, err
+	}
+
+	md := rg.columns[col].MetaData
+	md.Encodings = []sch.Encoding{sch.Encoding_PLAIN_DICTIONARY}
+	if d.plain {
+		md.Encodings = append(md.Encodings, sch.Encoding_PLAIN)
+	}
+	d.pageLen = int64(len(buf) + len(vals))
+	return buf, vals, nil
+}
+
+// pageDictionary is the dictionary page of a column chunk that is being
+// read.  ids is reused for the indices of each of the chunk's pages.
+type pageDictionary struct {
+	values [][]byte
+	ids    []uint32
+}
+
+// readDictionary splits the data of a dictionary page into its values.
+func readDictionary(ph *sch.PageHeader, data []byte, typ sch.Type) (*pageDictionary, error) {
+	values, err := splitValues(data, int(ph.DictionaryPageHeader.NumValues), typ)
+	if err != nil {
+		return nil, err
+	}
+	return &pageDictionary{values: values}, nil
+}
+
+// lookup turns the dictionary indices of the n values of a data page
+// back into their plain encoding.  The plain values count towards
+// the MaxPageSize limit just like the data of a plain page.
+func (d *pageDictionary) lookup(data []byte, n int, lim Limits) ([]byte, error) {
+	if d == nil {
+		return nil, corruptPage("dictionary encoded page without a dictionary page")
+	}
+
+	if n == 0 {
+		return nil, nil
+	}
+
+	if len(data) == 0 {
+		return nil, corruptPage("dictionary encoded page is missing its bit width")
+	}
+
+	dec, err := rle.NewDecoder(int32(data[0]))
+	if err != nil {
+		return nil, corruptPage("unable to read dictionary indices: %s", err)
+	}
+
+	// the last bit-packed run can be padded with up to 7 values
+	dec.Limit(int(lim.MaxPageSize), n+7)
+	d.ids, err = dec.Decode(d.ids[:0], data[1:])
+	if err != nil {
+		return nil, corruptPage("unable to read dictionary indices: %s", err)
+	}
+
+	if len(d.ids) < n {
+		return nil, corruptPage("expected %d dictionary indices, got %d", n, len(d.ids))
+	}
+
+	var out []byte
+	for _, id := range d.ids[:n] {
+		if int(id) >= len(d.values) {
+			return nil, corruptPage("dictionary index %d is out of range, the dictionary has %d values", id, len(d.values))
+		}
+
+		v := d.values[id]
+		if lim.MaxPageSize > 0 && len(out)+len(v) > int(lim.MaxPageSize) {
+			return nil, corruptPage("dictionary encoded page is larger than the limit of %d", lim.MaxPageSize)
+		}
+		out = append(out, v...)
+	}
+	return out, nil
+}
+
+// splitValues splits n plain encoded values of type typ into
+// the bytes of each value.  Byte arrays keep their length.
+func splitValues(data []byte, n int, typ sch.Type) ([][]byte, error) {
+	if n < 0 {
+		return nil, corruptPage("negative number of values %d", n)
+	}
+
+	var size int
+	switch typ {
+	case sch.Type_INT32, sch.Type_FLOAT:
+		size = 4
+	case sch.Type_INT64, sch.Type_DOUBLE:
+		size = 8
+	case sch.Type_INT96:
+		size = 12
+	case sch.Type_BYTE_ARRAY:
+		return splitByteArrays(data, n)
+	default:
+		return nil, fmt.Errorf("%w: dictionary of %s values", ErrUnsupportedEncoding, typ)
+	}
+
+	if len(data) != n*size {
+		return nil, corruptPage("%d %s values don't fit in %d bytes", n, typ, len(data))
+	}
+
+	out := make([][]byte, n)
+	for i := range out {
+		out[i], data = data[:size:size], data[size:]
+	}
+	return out, nil
+}
+
+// splitByteArrays is splitValues for byte arrays, each
+// of which starts with its length.
+func splitByteArrays(data []byte, n int) ([][]byte, error) {
+	// every value needs at least 4 bytes for its length
+	if n > len(data)/4 {
+		return nil, corruptPage("%d byte arrays don't fit in %d bytes", n, len(data))
+	}
+
+	out := make([][]byte, n)
+	for i := range out {
+		if len(data) < 4 {
+			return nil, corruptPage("missing the length of byte array %d", i)
+		}
+
+		l := binary.LittleEndian.Uint32(data)
+		if l > uint32(len(data)-4) {
+			return nil, corruptPage("byte array length %d is longer than the page", int32(l))
+		}
+
+		out[i], data = data[:4+l:4+l], data[4+l:]
+	}
+
+	if len(data) > 0 {
+		return nil, corruptPage("%d bytes are left over after %d byte arrays", len(data), n)
+	}
+	return out, nil
+}
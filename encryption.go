@@ -0,0 +1,748 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	sch "github.com/parsyl/parquet/schema"
+)
+
+const (
+	magic          = "PAR1"
+	magicEncrypted = "PARE"
+
+	nonceLen     = 12
+	tagLen       = 16
+	signatureLen = nonceLen + tagLen
+)
+
+// module types that are part of the additional authenticated
+// data (AAD) of each encrypted module.
+const (
+	moduleFooter byte = iota
+	moduleColumnMetaData
+	moduleDataPage
+	moduleDictionaryPage
+	moduleDataPageHeader
+	moduleDictionaryPageHeader
+	moduleColumnIndex
+	moduleOffsetIndex
+	moduleBloomFilterHeader
+	moduleBloomFilterBitset
+)
+
+// EncryptionAlgorithm is one of the two ciphers defined by
+// parquet modular encryption.
+type EncryptionAlgorithm int
+
+const (
+	// AESGCM encrypts all modules with AES-GCM.
+	AESGCM EncryptionAlgorithm = 0
+	// AESGCMCTR encrypts page data with AES-CTR and all
+	// other modules with AES-GCM.
+	AESGCMCTR EncryptionAlgorithm = 1
+)
+
+// ColumnKey is the key used to encrypt a single column and
+// the metadata that lets a KeyRetriever find it again.
+type ColumnKey struct {
+	Key         []byte
+	KeyMetadata []byte
+}
+
+// Encryption configures parquet modular encryption for
+// a file that is being written.
+type Encryption struct {
+	Algorithm EncryptionAlgorithm
+
+	// FooterKey encrypts (or, with PlaintextFooter, signs) the footer.
+	// It is also the key for every column when Columns is empty.
+	FooterKey         []byte
+	FooterKeyMetadata []byte
+
+	// PlaintextFooter leaves the footer readable by readers that
+	// don't support encryption.  The footer is signed with FooterKey
+	// and the file starts and ends with PAR1 instead of PARE.
+	PlaintextFooter bool
+
+	// Columns maps column names (for example "hobby.name") to
+	// their keys.  If it is empty every column is encrypted with
+	// the FooterKey, otherwise only these columns are encrypted.
+	Columns map[string]ColumnKey
+
+	// AADPrefix is prepended to the additional authenticated data
+	// of every module.  If SupplyAADPrefix is true the prefix isn't
+	// stored in the file and readers must supply it.
+	AADPrefix       []byte
+	SupplyAADPrefix bool
+}
+
+// KeyRetriever is called by a reader with the key metadata that
+// is stored in an encrypted file in order to get the actual key.
+type KeyRetriever interface {
+	Key(keyMetadata []byte) ([]byte, error)
+}
+
+// InMemoryKeys is a KeyRetriever that maps key metadata to keys.
+type InMemoryKeys map[string][]byte
+
+// Key returns the key for the given key metadata.
+func (k InMemoryKeys) Key(keyMetadata []byte) ([]byte, error) {
+	key, ok := k[string(keyMetadata)]
+	if !ok {
+		return nil, fmt.Errorf("no key for key metadata %q", keyMetadata)
+	}
+	return key, nil
+}
+
+// Decryption configures how a reader decrypts a file that
+// was written with parquet modular encryption.
+type Decryption struct {
+	Keys KeyRetriever
+
+	// AADPrefix must be set if the file was written
+	// with Encryption.SupplyAADPrefix.
+	AADPrefix []byte
+}
+
+// Magic returns the bytes that start and end a parquet
+// file that is written with e (which may be nil).
+func Magic(e *Encryption) []byte {
+	if e != nil && !e.PlaintextFooter {
+		return []byte(magicEncrypted)
+	}
+	return []byte(magic)
+}
+
+// fileEncryptor keeps track of what is needed to
+// encrypt the modules of a file.
+type fileEncryptor struct {
+	cfg       Encryption
+	fileAAD   []byte
+	algorithm *sch.EncryptionAlgorithm
+	ordinals  map[string]int
+}
+
+func newFileEncryptor(e *Encryption, fields []Field) (*fileEncryptor, error) {
+	if err := checkKey(e.FooterKey); err != nil {
+		return nil, fmt.Errorf("invalid footer key: %s", err)
+	}
+
+	ordinals := make(map[string]int, len(fields))
+	for i, f := range fields {
+		ordinals[strings.Join(f.Path, ".")] = i
+	}
+
+	for col, k := range e.Columns {
+		if _, ok := ordinals[col]; !ok {
+			return nil, fmt.Errorf("can't encrypt unknown column %s", col)
+		}
+		if err := checkKey(k.Key); err != nil {
+			return nil, fmt.Errorf("invalid key for column %s: %s", col, err)
+		}
+	}
+
+	unique := make([]byte, 8)
+	if _, err := rand.Read(unique); err != nil {
+		return nil, err
+	}
+
+	var prefix []byte
+	var supply *bool
+	if len(e.AADPrefix) > 0 {
+		if e.SupplyAADPrefix {
+			t := true
+			supply = &t
+		} else {
+			prefix = e.AADPrefix
+		}
+	}
+
+	alg := &sch.EncryptionAlgorithm{}
+	switch e.Algorithm {
+	case AESGCM:
+		alg.AES_GCM_V1 = &sch.AesGcmV1{AadPrefix: prefix, AadFileUnique: unique, SupplyAadPrefix: supply}
+	case AESGCMCTR:
+		alg.AES_GCM_CTR_V1 = &sch.AesGcmCtrV1{AadPrefix: prefix, AadFileUnique: unique, SupplyAadPrefix: supply}
+	default:
+		return nil, fmt.Errorf("unknown encryption algorithm %d", e.Algorithm)
+	}
+
+	return &fileEncryptor{
+		cfg:       *e,
+		fileAAD:   append(append([]byte{}, e.AADPrefix...), unique...),
+		algorithm: alg,
+		ordinals:  ordinals,
+	}, nil
+}
+
+// key returns the key for a column and whether or
+// not the column is encrypted.
+func (f *fileEncryptor) key(col string) ([]byte, bool) {
+	if len(f.cfg.Columns) == 0 {
+		return f.cfg.FooterKey, true
+	}
+	k, ok := f.cfg.Columns[col]
+	return k.Key, ok
+}
+
+func (f *fileEncryptor) encrypt(key []byte, module byte, rg, col, page int, data []byte) ([]byte, error) {
+	aad, err := moduleAAD(f.fileAAD, module, rg, col, page)
+	if err != nil {
+		return nil, err
+	}
+
+	if (module == moduleDataPage || module == moduleDictionaryPage) && f.cfg.Algorithm == AESGCMCTR {
+		return encryptCTR(key, data)
+	}
+	return encryptGCM(key, aad, data)
+}
+
+// encryptColumn sets the crypto metadata of a column chunk and, if the
+// column has its own key or the footer is plaintext, moves its
+// ColumnMetaData into EncryptedColumnMetadata.
+func (f *fileEncryptor) encryptColumn(ts *thrift.TSerializer, ch *sch.ColumnChunk, rg int) error {
+	col := strings.Join(ch.MetaData.PathInSchema, ".")
+	key, ok := f.key(col)
+	if !ok {
+		return nil
+	}
+
+	ck, ok := f.cfg.Columns[col]
+	if !ok {
+		ch.CryptoMetadata = &sch.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: &sch.EncryptionWithFooterKey{}}
+	} else {
+		ch.CryptoMetadata = &sch.ColumnCryptoMetaData{ENCRYPTION_WITH_COLUMN_KEY: &sch.EncryptionWithColumnKey{
+			PathInSchema: ch.MetaData.PathInSchema,
+			KeyMetadata:  ck.KeyMetadata,
+		}}
+	}
+
+	if !ok && !f.cfg.PlaintextFooter {
+		return nil
+	}
+
+	buf, err := ts.Write(context.TODO(), ch.MetaData)
+	if err != nil {
+		return err
+	}
+
+	ch.EncryptedColumnMetadata, err = f.encrypt(key, moduleColumnMetaData, rg, f.ordinals[col], 0, buf)
+	if err != nil {
+		return err
+	}
+
+	if !f.cfg.PlaintextFooter {
+		ch.MetaData = nil
+		return nil
+	}
+
+	// legacy readers can still see the plaintext parts of the
+	// metadata, but not the statistics.
+	md := *ch.MetaData
+	md.Statistics = nil
+	ch.MetaData = &md
+	return nil
+}
+
+// footer writes the encrypted (or signed) FileMetaData and its length.
+func (f *fileEncryptor) footer(w io.Writer, ts *thrift.TSerializer, fmd *sch.FileMetaData) error {
+	aad, _ := moduleAAD(f.fileAAD, moduleFooter, 0, 0, 0)
+	if f.cfg.PlaintextFooter {
+		fmd.EncryptionAlgorithm = f.algorithm
+		fmd.FooterSigningKeyMetadata = f.cfg.FooterKeyMetadata
+		buf, err := ts.Write(context.TODO(), fmd)
+		if err != nil {
+			return err
+		}
+
+		enc, err := encryptGCM(f.cfg.FooterKey, aad, buf)
+		if err != nil {
+			return err
+		}
+
+		// the signature is the nonce and tag of the encrypted footer
+		sig := append(enc[4:4+nonceLen], enc[len(enc)-tagLen:]...)
+		return writeFooter(w, buf, sig)
+	}
+
+	buf, err := ts.Write(context.TODO(), fmd)
+	if err != nil {
+		return err
+	}
+
+	enc, err := encryptGCM(f.cfg.FooterKey, aad, buf)
+	if err != nil {
+		return err
+	}
+
+	cm, err := ts.Write(context.TODO(), &sch.FileCryptoMetaData{
+		EncryptionAlgorithm: f.algorithm,
+		KeyMetadata:         f.cfg.FooterKeyMetadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeFooter(w, cm, enc)
+}
+
+func writeFooter(w io.Writer, parts ...[]byte) error {
+	var n int
+	for _, p := range parts {
+		if _, err := w.Write(p); err != nil {
+			return err
+		}
+		n += len(p)
+	}
+	return binary.Write(w, binary.LittleEndian, uint32(n))
+}
+
+// fileDecryptor keeps track of what is needed to
+// decrypt the modules of a file.
+type fileDecryptor struct {
+	keys      KeyRetriever
+	algorithm EncryptionAlgorithm
+	fileAAD   []byte
+	footerKey []byte
+	cache     map[string][]byte
+	// ordinals are the positions of the columns in the
+	// schema, which is what the AAD of their modules uses.
+	ordinals map[string]int
+}
+
+func newFileDecryptor(d *Decryption, alg *sch.EncryptionAlgorithm, keyMetadata []byte) (*fileDecryptor, error) {
+	if d == nil || d.Keys == nil {
+		return nil, fmt.Errorf("the file is encrypted but no KeyRetriever was provided")
+	}
+
+	if alg == nil {
+		return nil, fmt.Errorf("the file is missing its encryption algorithm")
+	}
+
+	var a EncryptionAlgorithm
+	var prefix, unique []byte
+	var supply bool
+	switch {
+	case alg.IsSetAES_GCM_V1():
+		a = AESGCM
+		prefix, unique, supply = alg.AES_GCM_V1.AadPrefix, alg.AES_GCM_V1.AadFileUnique, alg.AES_GCM_V1.GetSupplyAadPrefix()
+	case alg.IsSetAES_GCM_CTR_V1():
+		a = AESGCMCTR
+		prefix, unique, supply = alg.AES_GCM_CTR_V1.AadPrefix, alg.AES_GCM_CTR_V1.AadFileUnique, alg.AES_GCM_CTR_V1.GetSupplyAadPrefix()
+	default:
+		return nil, fmt.Errorf("unsupported encryption algorithm %s", alg)
+	}
+
+	if supply {
+		if len(d.AADPrefix) == 0 {
+			return nil, fmt.Errorf("the file requires an AAD prefix but none was provided")
+		}
+		prefix = d.AADPrefix
+	} else if len(d.AADPrefix) > 0 && !bytes.Equal(prefix, d.AADPrefix) {
+		return nil, fmt.Errorf("the AAD prefix doesn't match the one stored in the file")
+	}
+
+	key, err := d.Keys.Key(keyMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve footer key: %s", err)
+	}
+
+	return &fileDecryptor{
+		keys:      d.Keys,
+		algorithm: a,
+		fileAAD:   append(append([]byte{}, prefix...), unique...),
+		footerKey: key,
+		cache:     map[string][]byte{},
+	}, nil
+}
+
+// columnKey returns the key that was used to encrypt a column.
+func (f *fileDecryptor) columnKey(cm *sch.ColumnCryptoMetaData) ([]byte, error) {
+	if !cm.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return f.footerKey, nil
+	}
+
+	md := cm.ENCRYPTION_WITH_COLUMN_KEY.KeyMetadata
+	key, ok := f.cache[string(md)]
+	if ok {
+		return key, nil
+	}
+
+	key, err := f.keys.Key(md)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve key for column %s: %s", strings.Join(cm.ENCRYPTION_WITH_COLUMN_KEY.PathInSchema, "."), err)
+	}
+	f.cache[string(md)] = key
+	return key, nil
+}
+
+// decryptColumns replaces the ColumnMetaData of each column
+// chunk with its decrypted EncryptedColumnMetadata.
+func (f *fileDecryptor) decryptColumns(fmd *sch.FileMetaData) error {
+	fields, err := SchemaFields(fmd.Schema)
+	if err != nil {
+		return corruptFooter("invalid schema: %s", err)
+	}
+
+	f.ordinals = make(map[string]int, len(fields))
+	for i, fld := range fields {
+		f.ordinals[fld.Name] = i
+	}
+
+	for i, rg := range fmd.RowGroups {
+		for _, ch := range rg.Columns {
+			if ch.CryptoMetadata == nil || ch.EncryptedColumnMetadata == nil {
+				continue
+			}
+
+			key, err := f.columnKey(ch.CryptoMetadata)
+			if err != nil {
+				return err
+			}
+
+			o, err := f.ordinal(ch)
+			if err != nil {
+				return err
+			}
+
+			buf, err := f.decrypt(key, moduleColumnMetaData, i, o, 0, ch.EncryptedColumnMetadata)
+			if err != nil {
+				return fmt.Errorf("unable to decrypt column metadata: %s", err)
+			}
+
+			p := thrift.NewTCompactProtocol(newLimitedTransport(bytes.NewReader(buf), int64(len(buf))))
+			md := sch.NewColumnMetaData()
+			if err := md.Read(p); err != nil {
+				return err
+			}
+			ch.MetaData = md
+		}
+	}
+	return nil
+}
+
+// ordinal returns the position of a column chunk's column in the schema
+// (not in its row group, where chunks could be missing or reordered).
+func (f *fileDecryptor) ordinal(ch *sch.ColumnChunk) (int, error) {
+	var pth []string
+	switch {
+	case ch.MetaData != nil:
+		pth = ch.MetaData.PathInSchema
+	case ch.CryptoMetadata != nil && ch.CryptoMetadata.IsSetENCRYPTION_WITH_COLUMN_KEY():
+		pth = ch.CryptoMetadata.ENCRYPTION_WITH_COLUMN_KEY.PathInSchema
+	}
+
+	col := strings.Join(pth, ".")
+	o, ok := f.ordinals[col]
+	if !ok {
+		return 0, corruptFooter("encrypted column %q isn't in the schema", col)
+	}
+	return o, nil
+}
+
+func (f *fileDecryptor) decrypt(key []byte, module byte, rg, col, page int, data []byte) ([]byte, error) {
+	aad, err := moduleAAD(f.fileAAD, module, rg, col, page)
+	if err != nil {
+		return nil, err
+	}
+
+	if (module == moduleDataPage || module == moduleDictionaryPage) && f.algorithm == AESGCMCTR {
+		return decryptCTR(key, data)
+	}
+	return decryptGCM(key, aad, data)
+}
+
+// pageDecryptor decrypts the pages of a single column chunk.
+type pageDecryptor struct {
+	file *fileDecryptor
+	key  []byte
+	rg   int
+	col  int
+}
+
+// header reads and decrypts the header of the dictionary page, or
+// of the i'th data page, of the column chunk.
+func (p *pageDecryptor) header(r io.Reader, dict bool, i int, l Limits) (*sch.PageHeader, error) {
+	module, err := readModule(r, l.MaxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := moduleDataPageHeader
+	if dict {
+		typ = moduleDictionaryPageHeader
+	}
+
+	buf, err := p.file.decrypt(p.key, typ, p.rg, p.col, i, module)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt page header: %s", err)
+	}
+	return readPageHeader(bytes.NewReader(buf), l)
+}
+
+// page decrypts the data of the dictionary page, or of
+// the i'th data page, of the column chunk.
+func (p *pageDecryptor) page(data []byte, dict bool, i int) ([]byte, error) {
+	typ := moduleDataPage
+	if dict {
+		typ = moduleDictionaryPage
+	}
+
+	buf, err := p.file.decrypt(p.key, typ, p.rg, p.col, i, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt page: %s", err)
+	}
+	return buf, nil
+}
+
+// ReadDecryptedMetaData reads the FileMetaData from the end of a parquet
+// file that was written with parquet modular encryption (either with an
+// encrypted or a signed plaintext footer).  The ColumnMetaData of columns
+// that are encrypted with their own keys is decrypted and set on each
+// ColumnChunk.  Files without encryption are read as usual.
+func ReadDecryptedMetaData(r io.ReadSeeker, d *Decryption) (*sch.FileMetaData, error) {
+	fmd, _, err := readDecryptedMetaData(r, d, DefaultLimits)
+	return fmd, err
+}
+
+func readDecryptedMetaData(r io.ReadSeeker, d *Decryption, l Limits) (*sch.FileMetaData, *fileDecryptor, error) {
+	size, mgc, err := getTail(r, l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	end, err := r.Seek(-(size + 8), io.SeekEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, nil, err
+	}
+
+	br := bytes.NewReader(buf)
+	p := thrift.NewTCompactProtocol(newLimitedTransport(br, size))
+	fmd := sch.NewFileMetaData()
+	var dec *fileDecryptor
+	if mgc == magicEncrypted {
+		cm := sch.NewFileCryptoMetaData()
+		if err := cm.Read(p); err != nil {
+			return nil, nil, corruptFooter("%s", err)
+		}
+
+		dec, err = newFileDecryptor(d, cm.EncryptionAlgorithm, cm.KeyMetadata)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		plain, err := dec.decrypt(dec.footerKey, moduleFooter, 0, 0, 0, buf[len(buf)-br.Len():])
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decrypt footer: %s", err)
+		}
+
+		p = thrift.NewTCompactProtocol(newLimitedTransport(bytes.NewReader(plain), int64(len(plain))))
+		if err := fmd.Read(p); err != nil {
+			return nil, nil, corruptFooter("%s", err)
+		}
+	} else {
+		if err := fmd.Read(p); err != nil {
+			return nil, nil, corruptFooter("%s", err)
+		}
+
+		if fmd.EncryptionAlgorithm == nil {
+			return fmd, nil, validateFooter(fmd, end)
+		}
+
+		dec, err = newFileDecryptor(d, fmd.EncryptionAlgorithm, fmd.FooterSigningKeyMetadata)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		n := len(buf) - br.Len()
+		if err := dec.verify(buf[:n], buf[n:]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := dec.decryptColumns(fmd); err != nil {
+		return nil, nil, err
+	}
+	return fmd, dec, validateFooter(fmd, end)
+}
+
+// verify checks the signature of a plaintext footer.
+func (f *fileDecryptor) verify(footer, sig []byte) error {
+	if len(sig) != signatureLen {
+		return fmt.Errorf("invalid footer signature length %d", len(sig))
+	}
+
+	gcm, err := newGCM(f.footerKey)
+	if err != nil {
+		return err
+	}
+
+	aad, _ := moduleAAD(f.fileAAD, moduleFooter, 0, 0, 0)
+	enc := gcm.Seal(nil, sig[:nonceLen], footer, aad)
+	if subtle.ConstantTimeCompare(enc[len(enc)-tagLen:], sig[nonceLen:]) != 1 {
+		return fmt.Errorf("footer signature verification failed")
+	}
+	return nil
+}
+
+// moduleAAD builds the additional authenticated data of a module.
+func moduleAAD(fileAAD []byte, module byte, rg, col, page int) ([]byte, error) {
+	out := append(append(make([]byte, 0, len(fileAAD)+7), fileAAD...), module)
+	if module == moduleFooter {
+		return out, nil
+	}
+
+	for _, o := range []int{rg, col} {
+		if o > math.MaxInt16 {
+			return nil, fmt.Errorf("ordinal %d is too large to encrypt", o)
+		}
+		out = append(out, byte(o), byte(o>>8))
+	}
+
+	if module == moduleDataPage || module == moduleDataPageHeader {
+		if page > math.MaxInt16 {
+			return nil, fmt.Errorf("page ordinal %d is too large to encrypt", page)
+		}
+		out = append(out, byte(page), byte(page>>8))
+	}
+	return out, nil
+}
+
+func checkKey(key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	default:
+		return fmt.Errorf("key must be 16, 24, or 32 bytes long, got %d", len(key))
+	}
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptGCM returns the length, nonce, ciphertext and tag of data.
+func encryptGCM(key, aad, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, nonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 4, 4+nonceLen+len(data)+tagLen)
+	out = append(out, nonce...)
+	out = gcm.Seal(out, nonce, data, aad)
+	binary.LittleEndian.PutUint32(out, uint32(len(out)-4))
+	return out, nil
+}
+
+func decryptGCM(key, aad, module []byte) ([]byte, error) {
+	body, err := moduleBody(module, nonceLen+tagLen)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, body[:nonceLen], body[nonceLen:], aad)
+}
+
+// encryptCTR returns the length, nonce and ciphertext of data.
+func encryptCTR(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 4+nonceLen+len(data))
+	binary.LittleEndian.PutUint32(out, uint32(len(out)-4))
+	nonce := out[4 : 4+nonceLen]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	cipher.NewCTR(block, ctrIV(nonce)).XORKeyStream(out[4+nonceLen:], data)
+	return out, nil
+}
+
+func decryptCTR(key, module []byte) ([]byte, error) {
+	body, err := moduleBody(module, nonceLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(body)-nonceLen)
+	cipher.NewCTR(block, ctrIV(body[:nonceLen])).XORKeyStream(out, body[nonceLen:])
+	return out, nil
+}
+
+// ctrIV is the nonce followed by a 4 byte counter that starts at 1.
+func ctrIV(nonce []byte) []byte {
+	return append(append(make([]byte, 0, aes.BlockSize), nonce...), 0, 0, 0, 1)
+}
+
+// moduleBody strips and checks the length of an encrypted module.
+func moduleBody(module []byte, min int) ([]byte, error) {
+	if len(module) < 4 {
+		return nil, fmt.Errorf("encrypted module is too short (%d bytes)", len(module))
+	}
+
+	n := binary.LittleEndian.Uint32(module)
+	body := module[4:]
+	if int64(n) != int64(len(body)) || len(body) < min {
+		return nil, fmt.Errorf("invalid encrypted module length %d", n)
+	}
+	return body, nil
+}
+
+// readModule reads a length prefixed encrypted module
+// that is at most max bytes long (0 means no limit).
+func readModule(r io.Reader, max int32) ([]byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+
+	n := binary.LittleEndian.Uint32(l[:])
+	if max > 0 && n > uint32(max) {
+		return nil, corruptPage("encrypted module size %d is larger than the limit of %d", n, max)
+	}
+
+	out := make([]byte, 4+n)
+	copy(out, l[:])
+	if _, err := io.ReadFull(r, out[4:]); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,125 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotParquet is returned when a file is too small to be a
+	// parquet file or doesn't start and end with the magic bytes.
+	ErrNotParquet = errors.New("not a parquet file")
+
+	// ErrCorruptFooter is returned when the FileMetaData can't be
+	// decoded or describes column chunks that can't exist.
+	ErrCorruptFooter = errors.New("corrupt parquet footer")
+
+	// ErrCorruptPage is returned when a page header or the data
+	// of a page can't be decoded.
+	ErrCorruptPage = errors.New("corrupt parquet page")
+
+	// ErrUnsupportedEncoding is returned for pages that are valid
+	// parquet but use a page type or encoding this package can't read.
+	ErrUnsupportedEncoding = errors.New("unsupported parquet encoding")
+
+	// ErrUnsupportedCodec is returned for column chunks that are
+	// compressed with something other than snappy or zstd.
+	ErrUnsupportedCodec = errors.New("unsupported parquet compression codec")
+
+	// ErrSchemaMismatch is returned when the schema of an existing
+	// file isn't the schema that is being written or read.
+	ErrSchemaMismatch = errors.New("parquet schema mismatch")
+
+	// ErrEncrypted is returned by ReadMetaData for files with an
+	// encrypted footer and by Concat, Compact and Split for any
+	// encrypted file, whose column chunks can't be copied as they are.
+	ErrEncrypted = errors.New("parquet file is encrypted")
+)
+
+// PageError is returned when a page of a column chunk can't be read.
+// Err is usually (or wraps) ErrCorruptPage or ErrUnsupportedEncoding.
+type PageError struct {
+	Column   string
+	RowGroup int
+	// Offset is the position of the page header in the file.
+	Offset int64
+	Err    error
+}
+
+func (e *PageError) Error() string {
+	return fmt.Sprintf("unable to read page of column %s, row group %d, at offset %d: %s", e.Column, e.RowGroup, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// ChecksumError is returned when the CRC32 of a page's data
+// doesn't match the CRC that is stored in its page header.
+type ChecksumError struct {
+	Column   string
+	RowGroup int
+	// Offset is the position of the page header in the file.
+	Offset   int64
+	Expected uint32
+	Actual   uint32
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch in column %s, row group %d, page at offset %d: expected %08x, got %08x", e.Column, e.RowGroup, e.Offset, e.Expected, e.Actual)
+}
+
+// Is makes a ChecksumError match ErrCorruptPage.
+func (e *ChecksumError) Is(target error) bool {
+	return target == ErrCorruptPage
+}
+
+// SchemaError is returned when the columns of a file don't match the
+// columns they are read with.  It lists every column that differs and
+// matches ErrSchemaMismatch.
+type SchemaError struct {
+	Columns []ColumnMismatch
+}
+
+// ColumnMismatch is a column whose type and repetition in a file
+// (File) aren't what the reader expects (Expected).  File or
+// Expected is empty if only one of them has the column.
+type ColumnMismatch struct {
+	Column   string
+	File     string
+	Expected string
+}
+
+func (c ColumnMismatch) String() string {
+	switch {
+	case c.File == "":
+		return fmt.Sprintf("the file doesn't have column %s (%s)", c.Column, c.Expected)
+	case c.Expected == "":
+		return fmt.Sprintf("unexpected column %s (%s)", c.Column, c.File)
+	default:
+		return fmt.Sprintf("column %s is %s, expected %s", c.Column, c.File, c.Expected)
+	}
+}
+
+func (e *SchemaError) Error() string {
+	out := make([]string, len(e.Columns))
+	for i, c := range e.Columns {
+		out[i] = c.String()
+	}
+	return fmt.Sprintf("%s: %s", ErrSchemaMismatch, strings.Join(out, "; "))
+}
+
+// Is makes a SchemaError match ErrSchemaMismatch.
+func (e *SchemaError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+func corruptFooter(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrCorruptFooter, fmt.Sprintf(format, args...))
+}
+
+func corruptPage(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrCorruptPage, fmt.Sprintf(format, args...))
+}
@@ -6,10 +6,13 @@ import (
 	"strings"
 
 	"fmt"
+	"hash/crc32"
 
 	"io"
+	"sync"
 
 	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/parsyl/parquet/internal/fields"
 	"github.com/parsyl/parquet/internal/rle"
 	sch "github.com/parsyl/parquet/schema"
@@ -19,6 +22,10 @@ import (
 type RequiredField struct {
 	pth         []string
 	compression sch.CompressionCodec
+	ids         []int
+	noStats     bool
+	dictionary  bool
+	bloom       bool
 }
 
 // NewRequiredField creates a required field.
@@ -45,14 +52,75 @@ func RequiredFieldUncompressed(r *RequiredField) {
 	r.compression = sch.CompressionCodec_UNCOMPRESSED
 }
 
+// RequiredFieldZstd sets the compression for a column to zstd
+// It is an optional arg to NewRequiredField
+func RequiredFieldZstd(r *RequiredField) {
+	r.compression = sch.CompressionCodec_ZSTD
+}
+
+// RequiredFieldIDs sets the field id of each level of the path
+// of a column (-1 for levels that don't have one).
+// It is an optional arg to NewRequiredField
+func RequiredFieldIDs(ids ...int) func(*RequiredField) {
+	return func(r *RequiredField) {
+		r.ids = ids
+	}
+}
+
+// RequiredFieldNoStats leaves the statistics out of the
+// page headers of a column.
+// It is an optional arg to NewRequiredField
+func RequiredFieldNoStats(r *RequiredField) {
+	r.noStats = true
+}
+
+// RequiredFieldDictionary dictionary encodes the pages of a
+// column (see Metadata.WriteDictionary).
+// It is an optional arg to NewRequiredField
+func RequiredFieldDictionary(r *RequiredField) {
+	r.dictionary = true
+}
+
+// RequiredFieldBloom writes a bloom filter of the values of each
+// column chunk (see Metadata.BloomFilter).
+// It is an optional arg to NewRequiredField
+func RequiredFieldBloom(r *RequiredField) {
+	r.bloom = true
+}
+
 // DoWrite writes the actual raw data.
 func (f *RequiredField) DoWrite(w io.Writer, meta *Metadata, vals []byte, count int, stats Stats) error {
-	l, cl, vals := compress(f.compression, vals)
-	if err := meta.WritePageHeader(w, f.pth, l, cl, count, count, 0, 0, f.compression, stats); err != nil {
+	if f.noStats {
+		stats = nil
+	}
+
+	if f.bloom {
+		if err := meta.addBloom(f.pth, vals, count); err != nil {
+			return err
+		}
+	}
+
+	enc := sch.Encoding_PLAIN
+	if f.dictionary {
+		var err error
+		w, vals, enc, err = meta.dictionaryPage(f.pth, f.compression, vals, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	l, _, vals := compress(f.compression, vals)
+	crc := meta.Checksum(vals)
+	vals, err := meta.EncryptPage(f.pth, vals)
+	if err != nil {
 		return err
 	}
 
-	_, err := w.Write(vals)
+	if err := meta.writePageHeader(w, f.pth, l, len(vals), count, f.compression, enc, stats, crc); err != nil {
+		return err
+	}
+
+	_, err = w.Write(vals)
 	return err
 }
 
@@ -61,22 +129,41 @@ func (f *RequiredField) DoRead(r io.ReadSeeker, pg Page) (io.Reader, []int, erro
 	var nRead int
 	var out []byte
 	var sizes []int
-	for nRead < pg.N {
-		ph, err := PageHeader(r)
+	var dict *pageDictionary
+	rc := &readCounter{r: r}
+	for i := 0; nRead < pg.N; i++ {
+		offset := pg.Offset + rc.n
+		ph, data, err := readPage(rc, pg, i, offset)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		sizes = append(sizes, int(ph.DataPageHeader.NumValues))
+		if ph.Type == sch.PageType_DICTIONARY_PAGE {
+			if dict, err = readDictionary(ph, data, pg.typ); err != nil {
+				return nil, nil, pageError(pg, offset, err)
+			}
+			continue
+		}
 
-		data, err := pageData(r, ph, pg)
-		if err != nil {
-			return nil, nil, err
+		if ph.DataPageHeader.Encoding != sch.Encoding_PLAIN {
+			if data, err = dict.lookup(data, int(ph.DataPageHeader.NumValues), pg.limits); err != nil {
+				return nil, nil, pageError(pg, offset, err)
+			}
+		}
+
+		// every value takes up at least one bit
+		if int64(ph.DataPageHeader.NumValues) > 8*int64(len(data)) {
+			return nil, nil, pageError(pg, offset, corruptPage("%d values don't fit in %d bytes", ph.DataPageHeader.NumValues, len(data)))
 		}
 
+		sizes = append(sizes, int(ph.DataPageHeader.NumValues))
 		out = append(out, data...)
 		nRead += int(ph.DataPageHeader.NumValues)
 	}
+
+	if nRead != pg.N {
+		return nil, nil, pageError(pg, pg.Offset, corruptPage("the pages have %d values, expected %d", nRead, pg.N))
+	}
 	return bytes.NewBuffer(out), sizes, nil
 }
 
@@ -90,6 +177,11 @@ func (f *RequiredField) Path() []string {
 	return f.pth
 }
 
+// IDs returns the field ids of this field (see RequiredFieldIDs)
+func (f *RequiredField) IDs() []int {
+	return f.ids
+}
+
 // MaxLevel holds the maximum definition and
 // repeptition level for a given field.
 type MaxLevel struct {
@@ -108,6 +200,11 @@ type OptionalField struct {
 	RepetitionType FieldFunc
 	Types          []int
 	repeated       bool
+	levels         []uint32
+	ids            []int
+	noStats        bool
+	dictionary     bool
+	bloom          bool
 }
 
 func getRepetitionTypes(in []int) fields.RepetitionTypes {
@@ -151,6 +248,42 @@ func OptionalFieldUncompressed(o *OptionalField) {
 	o.compression = sch.CompressionCodec_UNCOMPRESSED
 }
 
+// OptionalFieldZstd sets the compression for a column to zstd
+// It is an optional arg to NewOptionalField
+func OptionalFieldZstd(o *OptionalField) {
+	o.compression = sch.CompressionCodec_ZSTD
+}
+
+// OptionalFieldIDs sets the field id of each level of the path
+// of a column (-1 for levels that don't have one).
+// It is an optional arg to NewOptionalField
+func OptionalFieldIDs(ids ...int) func(*OptionalField) {
+	return func(o *OptionalField) {
+		o.ids = ids
+	}
+}
+
+// OptionalFieldNoStats leaves the statistics out of the
+// page headers of a column.
+// It is an optional arg to NewOptionalField
+func OptionalFieldNoStats(o *OptionalField) {
+	o.noStats = true
+}
+
+// OptionalFieldDictionary dictionary encodes the pages of a
+// column (see Metadata.WriteDictionary).
+// It is an optional arg to NewOptionalField
+func OptionalFieldDictionary(o *OptionalField) {
+	o.dictionary = true
+}
+
+// OptionalFieldBloom writes a bloom filter of the values of each
+// column chunk (see Metadata.BloomFilter).
+// It is an optional arg to NewOptionalField
+func OptionalFieldBloom(o *OptionalField) {
+	o.bloom = true
+}
+
 // Values reads the definition levels and uses them
 // to return the values from the page data.
 func (f *OptionalField) Values() int {
@@ -170,27 +303,47 @@ func (f *OptionalField) valsFromDefs(defs []uint8, max uint8) int {
 // DoWrite is called by all optional field types to write the definition levels
 // and raw data to the io.Writer
 func (f *OptionalField) DoWrite(w io.Writer, meta *Metadata, vals []byte, count int, stats Stats) error {
+	if f.noStats {
+		stats = nil
+	}
+
+	if f.bloom {
+		if err := meta.addBloom(f.pth, vals, f.Values()); err != nil {
+			return err
+		}
+	}
+
+	enc := sch.Encoding_PLAIN
+	if f.dictionary {
+		var err error
+		w, vals, enc, err = meta.dictionaryPage(f.pth, f.compression, vals, f.Values())
+		if err != nil {
+			return err
+		}
+	}
+
 	buf := bytes.Buffer{}
-	wc := &writeCounter{w: &buf}
-	err := writeLevels(wc, f.Defs, int32(bits.Len(uint(f.MaxLevels.Def))))
+	err := writeLevels(&buf, f.Defs, int32(bits.Len(uint(f.MaxLevels.Def))))
 	if err != nil {
 		return err
 	}
 
-	defLen := wc.n
-
 	if f.repeated {
-		err := writeLevels(wc, f.Reps, int32(bits.Len(uint(f.MaxLevels.Rep))))
+		err := writeLevels(&buf, f.Reps, int32(bits.Len(uint(f.MaxLevels.Rep))))
 		if err != nil {
 			return err
 		}
 	}
 
-	repLen := wc.n - defLen
+	buf.Write(vals)
+	l, _, vals := compress(f.compression, buf.Bytes())
+	crc := meta.Checksum(vals)
+	vals, err = meta.EncryptPage(f.pth, vals)
+	if err != nil {
+		return err
+	}
 
-	wc.Write(vals)
-	l, cl, vals := compress(f.compression, buf.Bytes())
-	if err := meta.WritePageHeader(w, f.pth, l, cl, len(f.Defs), count, defLen, repLen, f.compression, stats); err != nil {
+	if err := meta.writePageHeader(w, f.pth, l, len(vals), count, f.compression, enc, stats, crc); err != nil {
 		return err
 	}
 	_, err = w.Write(vals)
@@ -204,42 +357,91 @@ func (f *OptionalField) DoRead(r io.ReadSeeker, pg Page) (io.Reader, []int, erro
 	var out []byte
 	var sizes []int
 	var rc *readCounter
+	var dict *pageDictionary
 
-	for nRead < pg.Size {
+	for i := 0; nRead < pg.Size; i++ {
 		rc = &readCounter{r: r}
-		ph, err := PageHeader(rc)
+		offset := pg.Offset + int64(nRead)
+		ph, data, err := readPage(rc, pg, i, offset)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		data, err := pageData(rc, ph, pg)
-		if err != nil {
-			return nil, nil, err
+		if ph.Type == sch.PageType_DICTIONARY_PAGE {
+			if dict, err = readDictionary(ph, data, pg.typ); err != nil {
+				return nil, nil, pageError(pg, offset, err)
+			}
+			nRead += int(rc.n)
+			continue
 		}
 
-		defs, l, err := readLevels(bytes.NewBuffer(data), int32(bits.Len(uint(f.MaxLevels.Def))))
+		count := int(ph.DataPageHeader.NumValues)
+		start := len(f.Defs)
+		var l int
+		f.Defs, l, err = f.readLevels(f.Defs, data, ph.DataPageHeader.DefinitionLevelEncoding, count, f.MaxLevels.Def, pg.limits)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, pageError(pg, offset, err)
 		}
 
-		f.Defs = append(f.Defs, defs[:int(ph.DataPageHeader.NumValues)]...)
 		if f.repeated {
-			reps, l2, err := readLevels(bytes.NewBuffer(data[l:]), int32(bits.Len(uint(f.MaxLevels.Rep))))
+			var l2 int
+			f.Reps, l2, err = f.readLevels(f.Reps, data[l:], ph.DataPageHeader.RepetitionLevelEncoding, count, f.MaxLevels.Rep, pg.limits)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, pageError(pg, offset, err)
 			}
 			l += l2
-			f.Reps = append(f.Reps, reps[:int(ph.DataPageHeader.NumValues)]...)
 		}
 
-		n := f.valsFromDefs(defs, uint8(f.MaxLevels.Def))
+		n := f.valsFromDefs(f.Defs[start:], uint8(f.MaxLevels.Def))
+		vals := data[l:]
+		if ph.DataPageHeader.Encoding != sch.Encoding_PLAIN {
+			if vals, err = dict.lookup(vals, n, pg.limits); err != nil {
+				return nil, nil, pageError(pg, offset, err)
+			}
+		}
+
 		sizes = append(sizes, n)
-		out = append(out, data[l:]...)
+		out = append(out, vals...)
 		nRead += int(rc.n)
 	}
 	return bytes.NewBuffer(out), sizes, nil
 }
 
+// readLevels reads count definition or repetition levels from the
+// start of data, checks that none are above max and appends them
+// to dst.  The levels are decoded into f.levels, which is reused
+// for every page.
+func (f *OptionalField) readLevels(dst []uint8, data []byte, enc sch.Encoding, count int, max uint8, lim Limits) ([]uint8, int, error) {
+	if enc != sch.Encoding_RLE {
+		return nil, 0, fmt.Errorf("%w: %s levels", ErrUnsupportedEncoding, enc)
+	}
+
+	dec, err := rle.NewDecoder(int32(bits.Len(uint(max))))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the last bit-packed run can be padded with up to 7 values
+	dec.Limit(int(lim.MaxPageSize), count+7)
+	var l int
+	f.levels, l, err = dec.Read(f.levels[:0], data)
+	if err != nil {
+		return nil, 0, corruptPage("unable to read levels: %s", err)
+	}
+
+	if len(f.levels) < count {
+		return nil, 0, corruptPage("expected %d levels, got %d", count, len(f.levels))
+	}
+
+	for _, lvl := range f.levels[:count] {
+		if lvl > uint32(max) {
+			return nil, 0, corruptPage("level %d is larger than the max level %d", lvl, max)
+		}
+		dst = append(dst, uint8(lvl))
+	}
+	return dst, l, nil
+}
+
 // Name returns the column name of this field
 func (f *OptionalField) Name() string {
 	return strings.Join(f.pth, ".")
@@ -250,6 +452,11 @@ func (f *OptionalField) Path() []string {
 	return f.pth
 }
 
+// IDs returns the field ids of this field (see OptionalFieldIDs)
+func (f *OptionalField) IDs() []int {
+	return f.ids
+}
+
 // writeCounter keeps track of the number of bytes written
 // it is used for calls to binary.Write, which does not
 // return the number of bytes written.
@@ -279,27 +486,171 @@ func (r *readCounter) Read(p []byte) (int, error) {
 	return n, err
 }
 
-func pageData(r io.Reader, ph *sch.PageHeader, pg Page) ([]byte, error) {
+// pageHeader reads the i'th page header of a column chunk,
+// decrypting it if the column is encrypted, and checks
+// that it describes a page that can be read.  Only the
+// first page of a chunk can be a dictionary page.
+func pageHeader(r io.Reader, pg Page, i int) (*sch.PageHeader, error) {
+	var ph *sch.PageHeader
+	var err error
+	if pg.decrypt == nil {
+		ph, err = readPageHeader(r, pg.limits)
+	} else {
+		dict, j := pg.ordinal(i)
+		ph, err = pg.decrypt.header(r, dict, j, pg.limits)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if ph.CompressedPageSize < 0 || ph.UncompressedPageSize < 0 {
+		return nil, corruptPage("negative page sizes")
+	}
+
+	if ph.Type == sch.PageType_DICTIONARY_PAGE && i == 0 {
+		dph := ph.DictionaryPageHeader
+		if dph == nil {
+			return nil, corruptPage("dictionary page is missing its dictionary page header")
+		}
+
+		if dph.Encoding != sch.Encoding_PLAIN && dph.Encoding != sch.Encoding_PLAIN_DICTIONARY {
+			return nil, fmt.Errorf("%w: %s dictionary", ErrUnsupportedEncoding, dph.Encoding)
+		}
+
+		if dph.NumValues < 0 {
+			return nil, corruptPage("negative number of dictionary values")
+		}
+		return ph, nil
+	}
+
+	if ph.Type != sch.PageType_DATA_PAGE {
+		return nil, fmt.Errorf("%w: %s page", ErrUnsupportedEncoding, ph.Type)
+	}
+
+	dph := ph.DataPageHeader
+	if dph == nil {
+		return nil, corruptPage("data page is missing its data page header")
+	}
+
+	switch dph.Encoding {
+	case sch.Encoding_PLAIN, sch.Encoding_PLAIN_DICTIONARY, sch.Encoding_RLE_DICTIONARY:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedEncoding, dph.Encoding)
+	}
+
+	if dph.NumValues < 0 {
+		return nil, corruptPage("negative page sizes")
+	}
+	return ph, nil
+}
+
+// ordinal returns whether the i'th page of the column chunk is its
+// dictionary page and, if it isn't, its position among the chunk's
+// data pages, which is what the AAD of an encrypted page has.
+func (pg Page) ordinal(i int) (bool, int) {
+	if !pg.dictionary {
+		return false, i
+	}
+	return i == 0, i - 1
+}
+
+// readPage reads the i'th page header and page data of a column
+// chunk.  offset is the position of the page header in the file.
+func readPage(r io.Reader, pg Page, i int, offset int64) (*sch.PageHeader, []byte, error) {
+	ph, err := pageHeader(r, pg, i)
+	if err != nil {
+		return nil, nil, pageError(pg, offset, err)
+	}
+
+	data, err := pageData(r, ph, pg, i, offset)
+	if err != nil {
+		return nil, nil, pageError(pg, offset, err)
+	}
+	return ph, data, nil
+}
+
+// pageError adds the location of a page to err.  ChecksumErrors
+// already know where they happened and are returned as is.
+func pageError(pg Page, offset int64, err error) error {
+	if _, ok := err.(*ChecksumError); ok {
+		return err
+	}
+	return &PageError{Column: pg.column, RowGroup: pg.rowGroup, Offset: offset, Err: err}
+}
+
+// pageData reads the i'th page of a column chunk.  offset is the
+// position of the page's header and is only used for errors.
+func pageData(r io.Reader, ph *sch.PageHeader, pg Page, i int, offset int64) ([]byte, error) {
+	size := ph.CompressedPageSize
+	if pg.decrypt != nil || (pg.verify && ph.IsSetCrc()) {
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, corruptPage("unable to read page data: %s", err)
+		}
+
+		if pg.decrypt != nil {
+			var err error
+			dict, j := pg.ordinal(i)
+			buf, err = pg.decrypt.page(buf, dict, j)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if pg.verify && ph.IsSetCrc() {
+			crc := crc32.ChecksumIEEE(buf)
+			if crc != uint32(*ph.Crc) {
+				return nil, &ChecksumError{
+					Column:   pg.column,
+					RowGroup: pg.rowGroup,
+					Offset:   offset,
+					Expected: uint32(*ph.Crc),
+					Actual:   crc,
+				}
+			}
+		}
+		r, size = bytes.NewReader(buf), int32(len(buf))
+	}
+
 	var data []byte
 	switch pg.Codec {
 	case sch.CompressionCodec_SNAPPY:
-		compressed := make([]byte, ph.CompressedPageSize)
-		if _, err := r.Read(compressed); err != nil {
-			return nil, err
+		compressed := make([]byte, size)
+		if _, err := io.ReadFull(r, compressed); err != nil {
+			return nil, corruptPage("unable to read page data: %s", err)
+		}
+
+		if n, err := snappy.DecodedLen(compressed); err != nil || n != int(ph.UncompressedPageSize) {
+			return nil, corruptPage("snappy data doesn't decode to %d bytes", ph.UncompressedPageSize)
 		}
 
 		var err error
 		data, err = snappy.Decode(nil, compressed)
+		if err != nil {
+			return nil, corruptPage("%s", err)
+		}
+	case sch.CompressionCodec_ZSTD:
+		compressed := make([]byte, size)
+		if _, err := io.ReadFull(r, compressed); err != nil {
+			return nil, corruptPage("unable to read page data: %s", err)
+		}
+
+		var err error
+		data, err = unzstd(compressed, ph.UncompressedPageSize)
 		if err != nil {
 			return nil, err
 		}
 	case sch.CompressionCodec_UNCOMPRESSED:
-		data = make([]byte, ph.UncompressedPageSize)
-		if _, err := r.Read(data); err != nil {
-			return nil, err
+		if size != ph.UncompressedPageSize {
+			return nil, corruptPage("uncompressed page of %d bytes has an uncompressed size of %d", size, ph.UncompressedPageSize)
+		}
+
+		data = make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, corruptPage("unable to read page data: %s", err)
 		}
 	default:
-		return nil, fmt.Errorf("unsupported column chunk codec: %s", pg.Codec)
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCodec, pg.Codec)
 	}
 
 	return data, nil
@@ -312,6 +663,10 @@ func compress(codec sch.CompressionCodec, vals []byte) (int, int, []byte) {
 		l = len(vals)
 		vals = snappy.Encode(nil, vals)
 		cl = len(vals)
+	case sch.CompressionCodec_ZSTD:
+		l = len(vals)
+		vals = zstdEncoder.EncodeAll(vals, nil)
+		cl = len(vals)
 	case sch.CompressionCodec_UNCOMPRESSED:
 		l = len(vals)
 		cl = len(vals)
@@ -319,24 +674,60 @@ func compress(codec sch.CompressionCodec, vals []byte) (int, int, []byte) {
 	return l, cl, vals
 }
 
+var (
+	// zstdEncoder is shared by every column, EncodeAll can be called
+	// by concurrent goroutines.  Empty pages (like the dictionary of
+	// a column that is all nulls) still get a frame.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithZeroFrames(true))
+
+	// zstdDecoders decode synchronously, so they
+	// don't have goroutines that need to be closed.
+	zstdDecoders = sync.Pool{
+		New: func() interface{} {
+			dec, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+			return dec
+		},
+	}
+)
+
+// unzstd decompresses a zstd page that must decode to exactly
+// size bytes.  The data is streamed into a buffer of that size,
+// which has already been checked against the Limits, so a page
+// that claims to be small can't decode to something huge.
+func unzstd(compressed []byte, size int32) ([]byte, error) {
+	var h zstd.Header
+	if err := h.Decode(compressed); err != nil {
+		return nil, corruptPage("%s", err)
+	}
+
+	if h.HasFCS && h.FrameContentSize != uint64(size) {
+		return nil, corruptPage("zstd data doesn't decode to %d bytes", size)
+	}
+
+	dec := zstdDecoders.Get().(*zstd.Decoder)
+	defer zstdDecoders.Put(dec)
+	if err := dec.Reset(bytes.NewReader(compressed)); err != nil {
+		return nil, corruptPage("%s", err)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(dec, data); err != nil {
+		return nil, corruptPage("zstd data doesn't decode to %d bytes: %s", size, err)
+	}
+
+	var extra [1]byte
+	if n, _ := dec.Read(extra[:]); n > 0 {
+		return nil, corruptPage("zstd data doesn't decode to %d bytes", size)
+	}
+	return data, nil
+}
+
 // writeLevels writes vals to w as RLE/bitpack encoded data
 func writeLevels(w io.Writer, levels []uint8, width int32) error {
 	enc, _ := rle.New(width, len(levels)) //TODO: len(levels) is probably too big.  Chop it down a bit?
 	for _, l := range levels {
-		enc.Write(l)
+		enc.Write(uint32(l))
 	}
 	_, err := w.Write(enc.Bytes())
 	return err
 }
-
-// readLevels reads the RLE/bitpack encoded definition and repetition levels
-func readLevels(in io.Reader, width int32) ([]uint8, int, error) {
-	var out []uint8
-	dec, _ := rle.New(width, 0)
-	out, n, err := dec.Read(in)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return out, n, nil
-}
@@ -0,0 +1,78 @@
+//go:build go1.18
+// +build go1.18
+
+package parquet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parsyl/parquet"
+)
+
+// fuzzLimits are small enough that the fuzzer can't make a
+// test run out of memory by claiming huge sizes.
+var fuzzLimits = parquet.Limits{
+	MaxFooterSize: 1 << 16,
+	MaxPageSize:   1 << 16,
+	MaxPageValues: 1 << 12,
+}
+
+func fuzzFile(f *testing.F) []byte {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(3))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, p := range getPeople(5, 10)[0] {
+		w.Add(p)
+	}
+	if err := w.Write(); err != nil {
+		f.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		f.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func FuzzReadMetaData(f *testing.F) {
+	f.Add(fuzzFile(f))
+	f.Add([]byte("PAR1PAR1"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		parquet.ReadMetaData(bytes.NewReader(b))
+		parquet.ReadMetaDataAt(bytes.NewReader(b), int64(len(b)))
+	})
+}
+
+func FuzzPageHeader(f *testing.F) {
+	b := fuzzFile(f)
+	f.Add(b[4:])
+	f.Fuzz(func(t *testing.T, b []byte) {
+		parquet.PageHeader(bytes.NewReader(b))
+	})
+}
+
+func FuzzGetBools(f *testing.F) {
+	f.Add([]byte{0xff, 0x01}, 9, 9)
+	f.Fuzz(func(t *testing.T, b []byte, n, size int) {
+		if size < 0 || size > 1<<12 {
+			return
+		}
+		parquet.GetBools(bytes.NewReader(b), n, []int{size})
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add(fuzzFile(f))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		r, err := NewParquetReader(bytes.NewReader(b), Limits(fuzzLimits))
+		if err != nil {
+			return
+		}
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+		}
+	})
+}
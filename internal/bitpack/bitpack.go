@@ -2,7 +2,9 @@ package bitpack
 
 // Code generated by github.com/parsyl/parquet.  DO NOT EDIT.
 
-func Pack(width int, vals []uint8) []byte {
+// Pack packs 8 values into width bytes, starting with the least
+// significant bit of the first value.
+func Pack(width int, vals []uint32) []byte {
 	switch width {
 	case 1:
 		return pack1(vals)
@@ -12,12 +14,68 @@ func Pack(width int, vals []uint8) []byte {
 		return pack3(vals)
 	case 4:
 		return pack4(vals)
+	case 5:
+		return pack5(vals)
+	case 6:
+		return pack6(vals)
+	case 7:
+		return pack7(vals)
+	case 8:
+		return pack8(vals)
+	case 9:
+		return pack9(vals)
+	case 10:
+		return pack10(vals)
+	case 11:
+		return pack11(vals)
+	case 12:
+		return pack12(vals)
+	case 13:
+		return pack13(vals)
+	case 14:
+		return pack14(vals)
+	case 15:
+		return pack15(vals)
+	case 16:
+		return pack16(vals)
+	case 17:
+		return pack17(vals)
+	case 18:
+		return pack18(vals)
+	case 19:
+		return pack19(vals)
+	case 20:
+		return pack20(vals)
+	case 21:
+		return pack21(vals)
+	case 22:
+		return pack22(vals)
+	case 23:
+		return pack23(vals)
+	case 24:
+		return pack24(vals)
+	case 25:
+		return pack25(vals)
+	case 26:
+		return pack26(vals)
+	case 27:
+		return pack27(vals)
+	case 28:
+		return pack28(vals)
+	case 29:
+		return pack29(vals)
+	case 30:
+		return pack30(vals)
+	case 31:
+		return pack31(vals)
+	case 32:
+		return pack32(vals)
 	default:
 		return []byte{}
 	}
 }
 
-func pack1(vals []uint8) []byte {
+func pack1(vals []uint32) []byte {
 	return []byte{
 		(byte((vals[0]&1)<<0) |
 			byte((vals[1]&1)<<1) |
@@ -30,7 +88,7 @@ func pack1(vals []uint8) []byte {
 	}
 }
 
-func pack2(vals []uint8) []byte {
+func pack2(vals []uint32) []byte {
 	return []byte{
 		(byte((vals[0]&3)<<0) |
 			byte((vals[1]&3)<<2) |
@@ -43,22 +101,22 @@ func pack2(vals []uint8) []byte {
 	}
 }
 
-func pack3(vals []uint8) []byte {
+func pack3(vals []uint32) []byte {
 	return []byte{
 		(byte((vals[0]&7)<<0) |
 			byte((vals[1]&7)<<3) |
-			byte((vals[2]&3)<<6)),
-		(byte((vals[2]&4)>>2) |
+			byte((vals[2]&7)<<6)),
+		(byte((vals[2]&7)>>2) |
 			byte((vals[3]&7)<<1) |
 			byte((vals[4]&7)<<4) |
-			byte((vals[5]&1)<<7)),
-		(byte((vals[5]&6)>>1) |
+			byte((vals[5]&7)<<7)),
+		(byte((vals[5]&7)>>1) |
 			byte((vals[6]&7)<<2) |
 			byte((vals[7]&7)<<5)),
 	}
 }
 
-func pack4(vals []uint8) []byte {
+func pack4(vals []uint32) []byte {
 	return []byte{
 		(byte((vals[0]&15)<<0) |
 			byte((vals[1]&15)<<4)),
@@ -71,69 +129,2569 @@ func pack4(vals []uint8) []byte {
 	}
 }
 
-func Unpack(width int, vals []byte) []uint8 {
+func pack5(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0]&31)<<0) |
+			byte((vals[1]&31)<<5)),
+		(byte((vals[1]&31)>>3) |
+			byte((vals[2]&31)<<2) |
+			byte((vals[3]&31)<<7)),
+		(byte((vals[3]&31)>>1) |
+			byte((vals[4]&31)<<4)),
+		(byte((vals[4]&31)>>4) |
+			byte((vals[5]&31)<<1) |
+			byte((vals[6]&31)<<6)),
+		(byte((vals[6]&31)>>2) |
+			byte((vals[7]&31)<<3)),
+	}
+}
+
+func pack6(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0]&63)<<0) |
+			byte((vals[1]&63)<<6)),
+		(byte((vals[1]&63)>>2) |
+			byte((vals[2]&63)<<4)),
+		(byte((vals[2]&63)>>4) |
+			byte((vals[3]&63)<<2)),
+		(byte((vals[4]&63)<<0) |
+			byte((vals[5]&63)<<6)),
+		(byte((vals[5]&63)>>2) |
+			byte((vals[6]&63)<<4)),
+		(byte((vals[6]&63)>>4) |
+			byte((vals[7]&63)<<2)),
+	}
+}
+
+func pack7(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0]&127)<<0) |
+			byte((vals[1]&127)<<7)),
+		(byte((vals[1]&127)>>1) |
+			byte((vals[2]&127)<<6)),
+		(byte((vals[2]&127)>>2) |
+			byte((vals[3]&127)<<5)),
+		(byte((vals[3]&127)>>3) |
+			byte((vals[4]&127)<<4)),
+		(byte((vals[4]&127)>>4) |
+			byte((vals[5]&127)<<3)),
+		(byte((vals[5]&127)>>5) |
+			byte((vals[6]&127)<<2)),
+		(byte((vals[6]&127)>>6) |
+			byte((vals[7]&127)<<1)),
+	}
+}
+
+func pack8(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 255) << 0)),
+		(byte((vals[1] & 255) << 0)),
+		(byte((vals[2] & 255) << 0)),
+		(byte((vals[3] & 255) << 0)),
+		(byte((vals[4] & 255) << 0)),
+		(byte((vals[5] & 255) << 0)),
+		(byte((vals[6] & 255) << 0)),
+		(byte((vals[7] & 255) << 0)),
+	}
+}
+
+func pack9(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 511) << 0)),
+		(byte((vals[0]&511)>>8) |
+			byte((vals[1]&511)<<1)),
+		(byte((vals[1]&511)>>7) |
+			byte((vals[2]&511)<<2)),
+		(byte((vals[2]&511)>>6) |
+			byte((vals[3]&511)<<3)),
+		(byte((vals[3]&511)>>5) |
+			byte((vals[4]&511)<<4)),
+		(byte((vals[4]&511)>>4) |
+			byte((vals[5]&511)<<5)),
+		(byte((vals[5]&511)>>3) |
+			byte((vals[6]&511)<<6)),
+		(byte((vals[6]&511)>>2) |
+			byte((vals[7]&511)<<7)),
+		(byte((vals[7] & 511) >> 1)),
+	}
+}
+
+func pack10(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 1023) << 0)),
+		(byte((vals[0]&1023)>>8) |
+			byte((vals[1]&1023)<<2)),
+		(byte((vals[1]&1023)>>6) |
+			byte((vals[2]&1023)<<4)),
+		(byte((vals[2]&1023)>>4) |
+			byte((vals[3]&1023)<<6)),
+		(byte((vals[3] & 1023) >> 2)),
+		(byte((vals[4] & 1023) << 0)),
+		(byte((vals[4]&1023)>>8) |
+			byte((vals[5]&1023)<<2)),
+		(byte((vals[5]&1023)>>6) |
+			byte((vals[6]&1023)<<4)),
+		(byte((vals[6]&1023)>>4) |
+			byte((vals[7]&1023)<<6)),
+		(byte((vals[7] & 1023) >> 2)),
+	}
+}
+
+func pack11(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 2047) << 0)),
+		(byte((vals[0]&2047)>>8) |
+			byte((vals[1]&2047)<<3)),
+		(byte((vals[1]&2047)>>5) |
+			byte((vals[2]&2047)<<6)),
+		(byte((vals[2] & 2047) >> 2)),
+		(byte((vals[2]&2047)>>10) |
+			byte((vals[3]&2047)<<1)),
+		(byte((vals[3]&2047)>>7) |
+			byte((vals[4]&2047)<<4)),
+		(byte((vals[4]&2047)>>4) |
+			byte((vals[5]&2047)<<7)),
+		(byte((vals[5] & 2047) >> 1)),
+		(byte((vals[5]&2047)>>9) |
+			byte((vals[6]&2047)<<2)),
+		(byte((vals[6]&2047)>>6) |
+			byte((vals[7]&2047)<<5)),
+		(byte((vals[7] & 2047) >> 3)),
+	}
+}
+
+func pack12(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 4095) << 0)),
+		(byte((vals[0]&4095)>>8) |
+			byte((vals[1]&4095)<<4)),
+		(byte((vals[1] & 4095) >> 4)),
+		(byte((vals[2] & 4095) << 0)),
+		(byte((vals[2]&4095)>>8) |
+			byte((vals[3]&4095)<<4)),
+		(byte((vals[3] & 4095) >> 4)),
+		(byte((vals[4] & 4095) << 0)),
+		(byte((vals[4]&4095)>>8) |
+			byte((vals[5]&4095)<<4)),
+		(byte((vals[5] & 4095) >> 4)),
+		(byte((vals[6] & 4095) << 0)),
+		(byte((vals[6]&4095)>>8) |
+			byte((vals[7]&4095)<<4)),
+		(byte((vals[7] & 4095) >> 4)),
+	}
+}
+
+func pack13(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 8191) << 0)),
+		(byte((vals[0]&8191)>>8) |
+			byte((vals[1]&8191)<<5)),
+		(byte((vals[1] & 8191) >> 3)),
+		(byte((vals[1]&8191)>>11) |
+			byte((vals[2]&8191)<<2)),
+		(byte((vals[2]&8191)>>6) |
+			byte((vals[3]&8191)<<7)),
+		(byte((vals[3] & 8191) >> 1)),
+		(byte((vals[3]&8191)>>9) |
+			byte((vals[4]&8191)<<4)),
+		(byte((vals[4] & 8191) >> 4)),
+		(byte((vals[4]&8191)>>12) |
+			byte((vals[5]&8191)<<1)),
+		(byte((vals[5]&8191)>>7) |
+			byte((vals[6]&8191)<<6)),
+		(byte((vals[6] & 8191) >> 2)),
+		(byte((vals[6]&8191)>>10) |
+			byte((vals[7]&8191)<<3)),
+		(byte((vals[7] & 8191) >> 5)),
+	}
+}
+
+func pack14(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 16383) << 0)),
+		(byte((vals[0]&16383)>>8) |
+			byte((vals[1]&16383)<<6)),
+		(byte((vals[1] & 16383) >> 2)),
+		(byte((vals[1]&16383)>>10) |
+			byte((vals[2]&16383)<<4)),
+		(byte((vals[2] & 16383) >> 4)),
+		(byte((vals[2]&16383)>>12) |
+			byte((vals[3]&16383)<<2)),
+		(byte((vals[3] & 16383) >> 6)),
+		(byte((vals[4] & 16383) << 0)),
+		(byte((vals[4]&16383)>>8) |
+			byte((vals[5]&16383)<<6)),
+		(byte((vals[5] & 16383) >> 2)),
+		(byte((vals[5]&16383)>>10) |
+			byte((vals[6]&16383)<<4)),
+		(byte((vals[6] & 16383) >> 4)),
+		(byte((vals[6]&16383)>>12) |
+			byte((vals[7]&16383)<<2)),
+		(byte((vals[7] & 16383) >> 6)),
+	}
+}
+
+func pack15(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 32767) << 0)),
+		(byte((vals[0]&32767)>>8) |
+			byte((vals[1]&32767)<<7)),
+		(byte((vals[1] & 32767) >> 1)),
+		(byte((vals[1]&32767)>>9) |
+			byte((vals[2]&32767)<<6)),
+		(byte((vals[2] & 32767) >> 2)),
+		(byte((vals[2]&32767)>>10) |
+			byte((vals[3]&32767)<<5)),
+		(byte((vals[3] & 32767) >> 3)),
+		(byte((vals[3]&32767)>>11) |
+			byte((vals[4]&32767)<<4)),
+		(byte((vals[4] & 32767) >> 4)),
+		(byte((vals[4]&32767)>>12) |
+			byte((vals[5]&32767)<<3)),
+		(byte((vals[5] & 32767) >> 5)),
+		(byte((vals[5]&32767)>>13) |
+			byte((vals[6]&32767)<<2)),
+		(byte((vals[6] & 32767) >> 6)),
+		(byte((vals[6]&32767)>>14) |
+			byte((vals[7]&32767)<<1)),
+		(byte((vals[7] & 32767) >> 7)),
+	}
+}
+
+func pack16(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 65535) << 0)),
+		(byte((vals[0] & 65535) >> 8)),
+		(byte((vals[1] & 65535) << 0)),
+		(byte((vals[1] & 65535) >> 8)),
+		(byte((vals[2] & 65535) << 0)),
+		(byte((vals[2] & 65535) >> 8)),
+		(byte((vals[3] & 65535) << 0)),
+		(byte((vals[3] & 65535) >> 8)),
+		(byte((vals[4] & 65535) << 0)),
+		(byte((vals[4] & 65535) >> 8)),
+		(byte((vals[5] & 65535) << 0)),
+		(byte((vals[5] & 65535) >> 8)),
+		(byte((vals[6] & 65535) << 0)),
+		(byte((vals[6] & 65535) >> 8)),
+		(byte((vals[7] & 65535) << 0)),
+		(byte((vals[7] & 65535) >> 8)),
+	}
+}
+
+func pack17(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 131071) << 0)),
+		(byte((vals[0] & 131071) >> 8)),
+		(byte((vals[0]&131071)>>16) |
+			byte((vals[1]&131071)<<1)),
+		(byte((vals[1] & 131071) >> 7)),
+		(byte((vals[1]&131071)>>15) |
+			byte((vals[2]&131071)<<2)),
+		(byte((vals[2] & 131071) >> 6)),
+		(byte((vals[2]&131071)>>14) |
+			byte((vals[3]&131071)<<3)),
+		(byte((vals[3] & 131071) >> 5)),
+		(byte((vals[3]&131071)>>13) |
+			byte((vals[4]&131071)<<4)),
+		(byte((vals[4] & 131071) >> 4)),
+		(byte((vals[4]&131071)>>12) |
+			byte((vals[5]&131071)<<5)),
+		(byte((vals[5] & 131071) >> 3)),
+		(byte((vals[5]&131071)>>11) |
+			byte((vals[6]&131071)<<6)),
+		(byte((vals[6] & 131071) >> 2)),
+		(byte((vals[6]&131071)>>10) |
+			byte((vals[7]&131071)<<7)),
+		(byte((vals[7] & 131071) >> 1)),
+		(byte((vals[7] & 131071) >> 9)),
+	}
+}
+
+func pack18(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 262143) << 0)),
+		(byte((vals[0] & 262143) >> 8)),
+		(byte((vals[0]&262143)>>16) |
+			byte((vals[1]&262143)<<2)),
+		(byte((vals[1] & 262143) >> 6)),
+		(byte((vals[1]&262143)>>14) |
+			byte((vals[2]&262143)<<4)),
+		(byte((vals[2] & 262143) >> 4)),
+		(byte((vals[2]&262143)>>12) |
+			byte((vals[3]&262143)<<6)),
+		(byte((vals[3] & 262143) >> 2)),
+		(byte((vals[3] & 262143) >> 10)),
+		(byte((vals[4] & 262143) << 0)),
+		(byte((vals[4] & 262143) >> 8)),
+		(byte((vals[4]&262143)>>16) |
+			byte((vals[5]&262143)<<2)),
+		(byte((vals[5] & 262143) >> 6)),
+		(byte((vals[5]&262143)>>14) |
+			byte((vals[6]&262143)<<4)),
+		(byte((vals[6] & 262143) >> 4)),
+		(byte((vals[6]&262143)>>12) |
+			byte((vals[7]&262143)<<6)),
+		(byte((vals[7] & 262143) >> 2)),
+		(byte((vals[7] & 262143) >> 10)),
+	}
+}
+
+func pack19(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 524287) << 0)),
+		(byte((vals[0] & 524287) >> 8)),
+		(byte((vals[0]&524287)>>16) |
+			byte((vals[1]&524287)<<3)),
+		(byte((vals[1] & 524287) >> 5)),
+		(byte((vals[1]&524287)>>13) |
+			byte((vals[2]&524287)<<6)),
+		(byte((vals[2] & 524287) >> 2)),
+		(byte((vals[2] & 524287) >> 10)),
+		(byte((vals[2]&524287)>>18) |
+			byte((vals[3]&524287)<<1)),
+		(byte((vals[3] & 524287) >> 7)),
+		(byte((vals[3]&524287)>>15) |
+			byte((vals[4]&524287)<<4)),
+		(byte((vals[4] & 524287) >> 4)),
+		(byte((vals[4]&524287)>>12) |
+			byte((vals[5]&524287)<<7)),
+		(byte((vals[5] & 524287) >> 1)),
+		(byte((vals[5] & 524287) >> 9)),
+		(byte((vals[5]&524287)>>17) |
+			byte((vals[6]&524287)<<2)),
+		(byte((vals[6] & 524287) >> 6)),
+		(byte((vals[6]&524287)>>14) |
+			byte((vals[7]&524287)<<5)),
+		(byte((vals[7] & 524287) >> 3)),
+		(byte((vals[7] & 524287) >> 11)),
+	}
+}
+
+func pack20(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 1048575) << 0)),
+		(byte((vals[0] & 1048575) >> 8)),
+		(byte((vals[0]&1048575)>>16) |
+			byte((vals[1]&1048575)<<4)),
+		(byte((vals[1] & 1048575) >> 4)),
+		(byte((vals[1] & 1048575) >> 12)),
+		(byte((vals[2] & 1048575) << 0)),
+		(byte((vals[2] & 1048575) >> 8)),
+		(byte((vals[2]&1048575)>>16) |
+			byte((vals[3]&1048575)<<4)),
+		(byte((vals[3] & 1048575) >> 4)),
+		(byte((vals[3] & 1048575) >> 12)),
+		(byte((vals[4] & 1048575) << 0)),
+		(byte((vals[4] & 1048575) >> 8)),
+		(byte((vals[4]&1048575)>>16) |
+			byte((vals[5]&1048575)<<4)),
+		(byte((vals[5] & 1048575) >> 4)),
+		(byte((vals[5] & 1048575) >> 12)),
+		(byte((vals[6] & 1048575) << 0)),
+		(byte((vals[6] & 1048575) >> 8)),
+		(byte((vals[6]&1048575)>>16) |
+			byte((vals[7]&1048575)<<4)),
+		(byte((vals[7] & 1048575) >> 4)),
+		(byte((vals[7] & 1048575) >> 12)),
+	}
+}
+
+func pack21(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 2097151) << 0)),
+		(byte((vals[0] & 2097151) >> 8)),
+		(byte((vals[0]&2097151)>>16) |
+			byte((vals[1]&2097151)<<5)),
+		(byte((vals[1] & 2097151) >> 3)),
+		(byte((vals[1] & 2097151) >> 11)),
+		(byte((vals[1]&2097151)>>19) |
+			byte((vals[2]&2097151)<<2)),
+		(byte((vals[2] & 2097151) >> 6)),
+		(byte((vals[2]&2097151)>>14) |
+			byte((vals[3]&2097151)<<7)),
+		(byte((vals[3] & 2097151) >> 1)),
+		(byte((vals[3] & 2097151) >> 9)),
+		(byte((vals[3]&2097151)>>17) |
+			byte((vals[4]&2097151)<<4)),
+		(byte((vals[4] & 2097151) >> 4)),
+		(byte((vals[4] & 2097151) >> 12)),
+		(byte((vals[4]&2097151)>>20) |
+			byte((vals[5]&2097151)<<1)),
+		(byte((vals[5] & 2097151) >> 7)),
+		(byte((vals[5]&2097151)>>15) |
+			byte((vals[6]&2097151)<<6)),
+		(byte((vals[6] & 2097151) >> 2)),
+		(byte((vals[6] & 2097151) >> 10)),
+		(byte((vals[6]&2097151)>>18) |
+			byte((vals[7]&2097151)<<3)),
+		(byte((vals[7] & 2097151) >> 5)),
+		(byte((vals[7] & 2097151) >> 13)),
+	}
+}
+
+func pack22(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 4194303) << 0)),
+		(byte((vals[0] & 4194303) >> 8)),
+		(byte((vals[0]&4194303)>>16) |
+			byte((vals[1]&4194303)<<6)),
+		(byte((vals[1] & 4194303) >> 2)),
+		(byte((vals[1] & 4194303) >> 10)),
+		(byte((vals[1]&4194303)>>18) |
+			byte((vals[2]&4194303)<<4)),
+		(byte((vals[2] & 4194303) >> 4)),
+		(byte((vals[2] & 4194303) >> 12)),
+		(byte((vals[2]&4194303)>>20) |
+			byte((vals[3]&4194303)<<2)),
+		(byte((vals[3] & 4194303) >> 6)),
+		(byte((vals[3] & 4194303) >> 14)),
+		(byte((vals[4] & 4194303) << 0)),
+		(byte((vals[4] & 4194303) >> 8)),
+		(byte((vals[4]&4194303)>>16) |
+			byte((vals[5]&4194303)<<6)),
+		(byte((vals[5] & 4194303) >> 2)),
+		(byte((vals[5] & 4194303) >> 10)),
+		(byte((vals[5]&4194303)>>18) |
+			byte((vals[6]&4194303)<<4)),
+		(byte((vals[6] & 4194303) >> 4)),
+		(byte((vals[6] & 4194303) >> 12)),
+		(byte((vals[6]&4194303)>>20) |
+			byte((vals[7]&4194303)<<2)),
+		(byte((vals[7] & 4194303) >> 6)),
+		(byte((vals[7] & 4194303) >> 14)),
+	}
+}
+
+func pack23(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 8388607) << 0)),
+		(byte((vals[0] & 8388607) >> 8)),
+		(byte((vals[0]&8388607)>>16) |
+			byte((vals[1]&8388607)<<7)),
+		(byte((vals[1] & 8388607) >> 1)),
+		(byte((vals[1] & 8388607) >> 9)),
+		(byte((vals[1]&8388607)>>17) |
+			byte((vals[2]&8388607)<<6)),
+		(byte((vals[2] & 8388607) >> 2)),
+		(byte((vals[2] & 8388607) >> 10)),
+		(byte((vals[2]&8388607)>>18) |
+			byte((vals[3]&8388607)<<5)),
+		(byte((vals[3] & 8388607) >> 3)),
+		(byte((vals[3] & 8388607) >> 11)),
+		(byte((vals[3]&8388607)>>19) |
+			byte((vals[4]&8388607)<<4)),
+		(byte((vals[4] & 8388607) >> 4)),
+		(byte((vals[4] & 8388607) >> 12)),
+		(byte((vals[4]&8388607)>>20) |
+			byte((vals[5]&8388607)<<3)),
+		(byte((vals[5] & 8388607) >> 5)),
+		(byte((vals[5] & 8388607) >> 13)),
+		(byte((vals[5]&8388607)>>21) |
+			byte((vals[6]&8388607)<<2)),
+		(byte((vals[6] & 8388607) >> 6)),
+		(byte((vals[6] & 8388607) >> 14)),
+		(byte((vals[6]&8388607)>>22) |
+			byte((vals[7]&8388607)<<1)),
+		(byte((vals[7] & 8388607) >> 7)),
+		(byte((vals[7] & 8388607) >> 15)),
+	}
+}
+
+func pack24(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 16777215) << 0)),
+		(byte((vals[0] & 16777215) >> 8)),
+		(byte((vals[0] & 16777215) >> 16)),
+		(byte((vals[1] & 16777215) << 0)),
+		(byte((vals[1] & 16777215) >> 8)),
+		(byte((vals[1] & 16777215) >> 16)),
+		(byte((vals[2] & 16777215) << 0)),
+		(byte((vals[2] & 16777215) >> 8)),
+		(byte((vals[2] & 16777215) >> 16)),
+		(byte((vals[3] & 16777215) << 0)),
+		(byte((vals[3] & 16777215) >> 8)),
+		(byte((vals[3] & 16777215) >> 16)),
+		(byte((vals[4] & 16777215) << 0)),
+		(byte((vals[4] & 16777215) >> 8)),
+		(byte((vals[4] & 16777215) >> 16)),
+		(byte((vals[5] & 16777215) << 0)),
+		(byte((vals[5] & 16777215) >> 8)),
+		(byte((vals[5] & 16777215) >> 16)),
+		(byte((vals[6] & 16777215) << 0)),
+		(byte((vals[6] & 16777215) >> 8)),
+		(byte((vals[6] & 16777215) >> 16)),
+		(byte((vals[7] & 16777215) << 0)),
+		(byte((vals[7] & 16777215) >> 8)),
+		(byte((vals[7] & 16777215) >> 16)),
+	}
+}
+
+func pack25(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 33554431) << 0)),
+		(byte((vals[0] & 33554431) >> 8)),
+		(byte((vals[0] & 33554431) >> 16)),
+		(byte((vals[0]&33554431)>>24) |
+			byte((vals[1]&33554431)<<1)),
+		(byte((vals[1] & 33554431) >> 7)),
+		(byte((vals[1] & 33554431) >> 15)),
+		(byte((vals[1]&33554431)>>23) |
+			byte((vals[2]&33554431)<<2)),
+		(byte((vals[2] & 33554431) >> 6)),
+		(byte((vals[2] & 33554431) >> 14)),
+		(byte((vals[2]&33554431)>>22) |
+			byte((vals[3]&33554431)<<3)),
+		(byte((vals[3] & 33554431) >> 5)),
+		(byte((vals[3] & 33554431) >> 13)),
+		(byte((vals[3]&33554431)>>21) |
+			byte((vals[4]&33554431)<<4)),
+		(byte((vals[4] & 33554431) >> 4)),
+		(byte((vals[4] & 33554431) >> 12)),
+		(byte((vals[4]&33554431)>>20) |
+			byte((vals[5]&33554431)<<5)),
+		(byte((vals[5] & 33554431) >> 3)),
+		(byte((vals[5] & 33554431) >> 11)),
+		(byte((vals[5]&33554431)>>19) |
+			byte((vals[6]&33554431)<<6)),
+		(byte((vals[6] & 33554431) >> 2)),
+		(byte((vals[6] & 33554431) >> 10)),
+		(byte((vals[6]&33554431)>>18) |
+			byte((vals[7]&33554431)<<7)),
+		(byte((vals[7] & 33554431) >> 1)),
+		(byte((vals[7] & 33554431) >> 9)),
+		(byte((vals[7] & 33554431) >> 17)),
+	}
+}
+
+func pack26(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 67108863) << 0)),
+		(byte((vals[0] & 67108863) >> 8)),
+		(byte((vals[0] & 67108863) >> 16)),
+		(byte((vals[0]&67108863)>>24) |
+			byte((vals[1]&67108863)<<2)),
+		(byte((vals[1] & 67108863) >> 6)),
+		(byte((vals[1] & 67108863) >> 14)),
+		(byte((vals[1]&67108863)>>22) |
+			byte((vals[2]&67108863)<<4)),
+		(byte((vals[2] & 67108863) >> 4)),
+		(byte((vals[2] & 67108863) >> 12)),
+		(byte((vals[2]&67108863)>>20) |
+			byte((vals[3]&67108863)<<6)),
+		(byte((vals[3] & 67108863) >> 2)),
+		(byte((vals[3] & 67108863) >> 10)),
+		(byte((vals[3] & 67108863) >> 18)),
+		(byte((vals[4] & 67108863) << 0)),
+		(byte((vals[4] & 67108863) >> 8)),
+		(byte((vals[4] & 67108863) >> 16)),
+		(byte((vals[4]&67108863)>>24) |
+			byte((vals[5]&67108863)<<2)),
+		(byte((vals[5] & 67108863) >> 6)),
+		(byte((vals[5] & 67108863) >> 14)),
+		(byte((vals[5]&67108863)>>22) |
+			byte((vals[6]&67108863)<<4)),
+		(byte((vals[6] & 67108863) >> 4)),
+		(byte((vals[6] & 67108863) >> 12)),
+		(byte((vals[6]&67108863)>>20) |
+			byte((vals[7]&67108863)<<6)),
+		(byte((vals[7] & 67108863) >> 2)),
+		(byte((vals[7] & 67108863) >> 10)),
+		(byte((vals[7] & 67108863) >> 18)),
+	}
+}
+
+func pack27(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 134217727) << 0)),
+		(byte((vals[0] & 134217727) >> 8)),
+		(byte((vals[0] & 134217727) >> 16)),
+		(byte((vals[0]&134217727)>>24) |
+			byte((vals[1]&134217727)<<3)),
+		(byte((vals[1] & 134217727) >> 5)),
+		(byte((vals[1] & 134217727) >> 13)),
+		(byte((vals[1]&134217727)>>21) |
+			byte((vals[2]&134217727)<<6)),
+		(byte((vals[2] & 134217727) >> 2)),
+		(byte((vals[2] & 134217727) >> 10)),
+		(byte((vals[2] & 134217727) >> 18)),
+		(byte((vals[2]&134217727)>>26) |
+			byte((vals[3]&134217727)<<1)),
+		(byte((vals[3] & 134217727) >> 7)),
+		(byte((vals[3] & 134217727) >> 15)),
+		(byte((vals[3]&134217727)>>23) |
+			byte((vals[4]&134217727)<<4)),
+		(byte((vals[4] & 134217727) >> 4)),
+		(byte((vals[4] & 134217727) >> 12)),
+		(byte((vals[4]&134217727)>>20) |
+			byte((vals[5]&134217727)<<7)),
+		(byte((vals[5] & 134217727) >> 1)),
+		(byte((vals[5] & 134217727) >> 9)),
+		(byte((vals[5] & 134217727) >> 17)),
+		(byte((vals[5]&134217727)>>25) |
+			byte((vals[6]&134217727)<<2)),
+		(byte((vals[6] & 134217727) >> 6)),
+		(byte((vals[6] & 134217727) >> 14)),
+		(byte((vals[6]&134217727)>>22) |
+			byte((vals[7]&134217727)<<5)),
+		(byte((vals[7] & 134217727) >> 3)),
+		(byte((vals[7] & 134217727) >> 11)),
+		(byte((vals[7] & 134217727) >> 19)),
+	}
+}
+
+func pack28(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 268435455) << 0)),
+		(byte((vals[0] & 268435455) >> 8)),
+		(byte((vals[0] & 268435455) >> 16)),
+		(byte((vals[0]&268435455)>>24) |
+			byte((vals[1]&268435455)<<4)),
+		(byte((vals[1] & 268435455) >> 4)),
+		(byte((vals[1] & 268435455) >> 12)),
+		(byte((vals[1] & 268435455) >> 20)),
+		(byte((vals[2] & 268435455) << 0)),
+		(byte((vals[2] & 268435455) >> 8)),
+		(byte((vals[2] & 268435455) >> 16)),
+		(byte((vals[2]&268435455)>>24) |
+			byte((vals[3]&268435455)<<4)),
+		(byte((vals[3] & 268435455) >> 4)),
+		(byte((vals[3] & 268435455) >> 12)),
+		(byte((vals[3] & 268435455) >> 20)),
+		(byte((vals[4] & 268435455) << 0)),
+		(byte((vals[4] & 268435455) >> 8)),
+		(byte((vals[4] & 268435455) >> 16)),
+		(byte((vals[4]&268435455)>>24) |
+			byte((vals[5]&268435455)<<4)),
+		(byte((vals[5] & 268435455) >> 4)),
+		(byte((vals[5] & 268435455) >> 12)),
+		(byte((vals[5] & 268435455) >> 20)),
+		(byte((vals[6] & 268435455) << 0)),
+		(byte((vals[6] & 268435455) >> 8)),
+		(byte((vals[6] & 268435455) >> 16)),
+		(byte((vals[6]&268435455)>>24) |
+			byte((vals[7]&268435455)<<4)),
+		(byte((vals[7] & 268435455) >> 4)),
+		(byte((vals[7] & 268435455) >> 12)),
+		(byte((vals[7] & 268435455) >> 20)),
+	}
+}
+
+func pack29(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 536870911) << 0)),
+		(byte((vals[0] & 536870911) >> 8)),
+		(byte((vals[0] & 536870911) >> 16)),
+		(byte((vals[0]&536870911)>>24) |
+			byte((vals[1]&536870911)<<5)),
+		(byte((vals[1] & 536870911) >> 3)),
+		(byte((vals[1] & 536870911) >> 11)),
+		(byte((vals[1] & 536870911) >> 19)),
+		(byte((vals[1]&536870911)>>27) |
+			byte((vals[2]&536870911)<<2)),
+		(byte((vals[2] & 536870911) >> 6)),
+		(byte((vals[2] & 536870911) >> 14)),
+		(byte((vals[2]&536870911)>>22) |
+			byte((vals[3]&536870911)<<7)),
+		(byte((vals[3] & 536870911) >> 1)),
+		(byte((vals[3] & 536870911) >> 9)),
+		(byte((vals[3] & 536870911) >> 17)),
+		(byte((vals[3]&536870911)>>25) |
+			byte((vals[4]&536870911)<<4)),
+		(byte((vals[4] & 536870911) >> 4)),
+		(byte((vals[4] & 536870911) >> 12)),
+		(byte((vals[4] & 536870911) >> 20)),
+		(byte((vals[4]&536870911)>>28) |
+			byte((vals[5]&536870911)<<1)),
+		(byte((vals[5] & 536870911) >> 7)),
+		(byte((vals[5] & 536870911) >> 15)),
+		(byte((vals[5]&536870911)>>23) |
+			byte((vals[6]&536870911)<<6)),
+		(byte((vals[6] & 536870911) >> 2)),
+		(byte((vals[6] & 536870911) >> 10)),
+		(byte((vals[6] & 536870911) >> 18)),
+		(byte((vals[6]&536870911)>>26) |
+			byte((vals[7]&536870911)<<3)),
+		(byte((vals[7] & 536870911) >> 5)),
+		(byte((vals[7] & 536870911) >> 13)),
+		(byte((vals[7] & 536870911) >> 21)),
+	}
+}
+
+func pack30(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 1073741823) << 0)),
+		(byte((vals[0] & 1073741823) >> 8)),
+		(byte((vals[0] & 1073741823) >> 16)),
+		(byte((vals[0]&1073741823)>>24) |
+			byte((vals[1]&1073741823)<<6)),
+		(byte((vals[1] & 1073741823) >> 2)),
+		(byte((vals[1] & 1073741823) >> 10)),
+		(byte((vals[1] & 1073741823) >> 18)),
+		(byte((vals[1]&1073741823)>>26) |
+			byte((vals[2]&1073741823)<<4)),
+		(byte((vals[2] & 1073741823) >> 4)),
+		(byte((vals[2] & 1073741823) >> 12)),
+		(byte((vals[2] & 1073741823) >> 20)),
+		(byte((vals[2]&1073741823)>>28) |
+			byte((vals[3]&1073741823)<<2)),
+		(byte((vals[3] & 1073741823) >> 6)),
+		(byte((vals[3] & 1073741823) >> 14)),
+		(byte((vals[3] & 1073741823) >> 22)),
+		(byte((vals[4] & 1073741823) << 0)),
+		(byte((vals[4] & 1073741823) >> 8)),
+		(byte((vals[4] & 1073741823) >> 16)),
+		(byte((vals[4]&1073741823)>>24) |
+			byte((vals[5]&1073741823)<<6)),
+		(byte((vals[5] & 1073741823) >> 2)),
+		(byte((vals[5] & 1073741823) >> 10)),
+		(byte((vals[5] & 1073741823) >> 18)),
+		(byte((vals[5]&1073741823)>>26) |
+			byte((vals[6]&1073741823)<<4)),
+		(byte((vals[6] & 1073741823) >> 4)),
+		(byte((vals[6] & 1073741823) >> 12)),
+		(byte((vals[6] & 1073741823) >> 20)),
+		(byte((vals[6]&1073741823)>>28) |
+			byte((vals[7]&1073741823)<<2)),
+		(byte((vals[7] & 1073741823) >> 6)),
+		(byte((vals[7] & 1073741823) >> 14)),
+		(byte((vals[7] & 1073741823) >> 22)),
+	}
+}
+
+func pack31(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 2147483647) << 0)),
+		(byte((vals[0] & 2147483647) >> 8)),
+		(byte((vals[0] & 2147483647) >> 16)),
+		(byte((vals[0]&2147483647)>>24) |
+			byte((vals[1]&2147483647)<<7)),
+		(byte((vals[1] & 2147483647) >> 1)),
+		(byte((vals[1] & 2147483647) >> 9)),
+		(byte((vals[1] & 2147483647) >> 17)),
+		(byte((vals[1]&2147483647)>>25) |
+			byte((vals[2]&2147483647)<<6)),
+		(byte((vals[2] & 2147483647) >> 2)),
+		(byte((vals[2] & 2147483647) >> 10)),
+		(byte((vals[2] & 2147483647) >> 18)),
+		(byte((vals[2]&2147483647)>>26) |
+			byte((vals[3]&2147483647)<<5)),
+		(byte((vals[3] & 2147483647) >> 3)),
+		(byte((vals[3] & 2147483647) >> 11)),
+		(byte((vals[3] & 2147483647) >> 19)),
+		(byte((vals[3]&2147483647)>>27) |
+			byte((vals[4]&2147483647)<<4)),
+		(byte((vals[4] & 2147483647) >> 4)),
+		(byte((vals[4] & 2147483647) >> 12)),
+		(byte((vals[4] & 2147483647) >> 20)),
+		(byte((vals[4]&2147483647)>>28) |
+			byte((vals[5]&2147483647)<<3)),
+		(byte((vals[5] & 2147483647) >> 5)),
+		(byte((vals[5] & 2147483647) >> 13)),
+		(byte((vals[5] & 2147483647) >> 21)),
+		(byte((vals[5]&2147483647)>>29) |
+			byte((vals[6]&2147483647)<<2)),
+		(byte((vals[6] & 2147483647) >> 6)),
+		(byte((vals[6] & 2147483647) >> 14)),
+		(byte((vals[6] & 2147483647) >> 22)),
+		(byte((vals[6]&2147483647)>>30) |
+			byte((vals[7]&2147483647)<<1)),
+		(byte((vals[7] & 2147483647) >> 7)),
+		(byte((vals[7] & 2147483647) >> 15)),
+		(byte((vals[7] & 2147483647) >> 23)),
+	}
+}
+
+func pack32(vals []uint32) []byte {
+	return []byte{
+		(byte((vals[0] & 4294967295) << 0)),
+		(byte((vals[0] & 4294967295) >> 8)),
+		(byte((vals[0] & 4294967295) >> 16)),
+		(byte((vals[0] & 4294967295) >> 24)),
+		(byte((vals[1] & 4294967295) << 0)),
+		(byte((vals[1] & 4294967295) >> 8)),
+		(byte((vals[1] & 4294967295) >> 16)),
+		(byte((vals[1] & 4294967295) >> 24)),
+		(byte((vals[2] & 4294967295) << 0)),
+		(byte((vals[2] & 4294967295) >> 8)),
+		(byte((vals[2] & 4294967295) >> 16)),
+		(byte((vals[2] & 4294967295) >> 24)),
+		(byte((vals[3] & 4294967295) << 0)),
+		(byte((vals[3] & 4294967295) >> 8)),
+		(byte((vals[3] & 4294967295) >> 16)),
+		(byte((vals[3] & 4294967295) >> 24)),
+		(byte((vals[4] & 4294967295) << 0)),
+		(byte((vals[4] & 4294967295) >> 8)),
+		(byte((vals[4] & 4294967295) >> 16)),
+		(byte((vals[4] & 4294967295) >> 24)),
+		(byte((vals[5] & 4294967295) << 0)),
+		(byte((vals[5] & 4294967295) >> 8)),
+		(byte((vals[5] & 4294967295) >> 16)),
+		(byte((vals[5] & 4294967295) >> 24)),
+		(byte((vals[6] & 4294967295) << 0)),
+		(byte((vals[6] & 4294967295) >> 8)),
+		(byte((vals[6] & 4294967295) >> 16)),
+		(byte((vals[6] & 4294967295) >> 24)),
+		(byte((vals[7] & 4294967295) << 0)),
+		(byte((vals[7] & 4294967295) >> 8)),
+		(byte((vals[7] & 4294967295) >> 16)),
+		(byte((vals[7] & 4294967295) >> 24)),
+	}
+}
+
+// Unpack unpacks the 8 values that Pack packed into width bytes.
+func Unpack(width int, vals []byte) []uint32 {
+	f := Unpacker(width)
+	if f == nil {
+		return []uint32{}
+	}
+	out := make([]uint32, 8)
+	f(out, vals)
+	return out
+}
+
+// Unpacker returns the function that unpacks 8 values from width
+// bytes into dst, or nil if width isn't 1 through 32.
+func Unpacker(width int) func(dst []uint32, vals []byte) {
 	switch width {
 	case 1:
-		return unpack1(vals)
+		return unpack1
 	case 2:
-		return unpack2(vals)
+		return unpack2
 	case 3:
-		return unpack3(vals)
+		return unpack3
 	case 4:
-		return unpack4(vals)
+		return unpack4
+	case 5:
+		return unpack5
+	case 6:
+		return unpack6
+	case 7:
+		return unpack7
+	case 8:
+		return unpack8
+	case 9:
+		return unpack9
+	case 10:
+		return unpack10
+	case 11:
+		return unpack11
+	case 12:
+		return unpack12
+	case 13:
+		return unpack13
+	case 14:
+		return unpack14
+	case 15:
+		return unpack15
+	case 16:
+		return unpack16
+	case 17:
+		return unpack17
+	case 18:
+		return unpack18
+	case 19:
+		return unpack19
+	case 20:
+		return unpack20
+	case 21:
+		return unpack21
+	case 22:
+		return unpack22
+	case 23:
+		return unpack23
+	case 24:
+		return unpack24
+	case 25:
+		return unpack25
+	case 26:
+		return unpack26
+	case 27:
+		return unpack27
+	case 28:
+		return unpack28
+	case 29:
+		return unpack29
+	case 30:
+		return unpack30
+	case 31:
+		return unpack31
+	case 32:
+		return unpack32
 	default:
-		return []uint8{}
+		return nil
 	}
 }
 
-func unpack1(vals []byte) []uint8 {
-	return []uint8{
-		(uint8(vals[0]&1) >> 0),
-		(uint8(vals[0]&2) >> 1),
-		(uint8(vals[0]&4) >> 2),
-		(uint8(vals[0]&8) >> 3),
-		(uint8(vals[0]&16) >> 4),
-		(uint8(vals[0]&32) >> 5),
-		(uint8(vals[0]&64) >> 6),
-		(uint8(vals[0]&128) >> 7),
+// Unpacker32 is like Unpacker, but the function unpacks
+// 32 values from 4*width bytes.
+func Unpacker32(width int) func(dst []uint32, vals []byte) {
+	switch width {
+	case 1:
+		return unpack32x1
+	case 2:
+		return unpack32x2
+	case 3:
+		return unpack32x3
+	case 4:
+		return unpack32x4
+	case 5:
+		return unpack32x5
+	case 6:
+		return unpack32x6
+	case 7:
+		return unpack32x7
+	case 8:
+		return unpack32x8
+	case 9:
+		return unpack32x9
+	case 10:
+		return unpack32x10
+	case 11:
+		return unpack32x11
+	case 12:
+		return unpack32x12
+	case 13:
+		return unpack32x13
+	case 14:
+		return unpack32x14
+	case 15:
+		return unpack32x15
+	case 16:
+		return unpack32x16
+	case 17:
+		return unpack32x17
+	case 18:
+		return unpack32x18
+	case 19:
+		return unpack32x19
+	case 20:
+		return unpack32x20
+	case 21:
+		return unpack32x21
+	case 22:
+		return unpack32x22
+	case 23:
+		return unpack32x23
+	case 24:
+		return unpack32x24
+	case 25:
+		return unpack32x25
+	case 26:
+		return unpack32x26
+	case 27:
+		return unpack32x27
+	case 28:
+		return unpack32x28
+	case 29:
+		return unpack32x29
+	case 30:
+		return unpack32x30
+	case 31:
+		return unpack32x31
+	case 32:
+		return unpack32x32
+	default:
+		return nil
 	}
 }
 
-func unpack2(vals []byte) []uint8 {
-	return []uint8{
-		(uint8(vals[0]&3) >> 0),
-		(uint8(vals[0]&12) >> 2),
-		(uint8(vals[0]&48) >> 4),
-		(uint8(vals[0]&192) >> 6),
-		(uint8(vals[1]&3) >> 0),
-		(uint8(vals[1]&12) >> 2),
-		(uint8(vals[1]&48) >> 4),
-		(uint8(vals[1]&192) >> 6),
-	}
+func unpack1(dst []uint32, vals []byte) {
+	_ = vals[0]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&1) >> 0)
+	dst[1] = (uint32(vals[0]&2) >> 1)
+	dst[2] = (uint32(vals[0]&4) >> 2)
+	dst[3] = (uint32(vals[0]&8) >> 3)
+	dst[4] = (uint32(vals[0]&16) >> 4)
+	dst[5] = (uint32(vals[0]&32) >> 5)
+	dst[6] = (uint32(vals[0]&64) >> 6)
+	dst[7] = (uint32(vals[0]&128) >> 7)
 }
 
-func unpack3(vals []byte) []uint8 {
-	return []uint8{
-		(uint8(vals[0]&7) >> 0),
-		(uint8(vals[0]&56) >> 3),
-		(uint8(vals[0]&192) >> 6) | (uint8(vals[1]&1) << 2),
-		(uint8(vals[1]&14) >> 1),
-		(uint8(vals[1]&112) >> 4),
-		(uint8(vals[1]&128) >> 7) | (uint8(vals[2]&3) << 1),
-		(uint8(vals[2]&28) >> 2),
-		(uint8(vals[2]&224) >> 5),
-	}
+func unpack32x1(dst []uint32, vals []byte) {
+	_ = vals[3]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&1) >> 0)
+	dst[1] = (uint32(vals[0]&2) >> 1)
+	dst[2] = (uint32(vals[0]&4) >> 2)
+	dst[3] = (uint32(vals[0]&8) >> 3)
+	dst[4] = (uint32(vals[0]&16) >> 4)
+	dst[5] = (uint32(vals[0]&32) >> 5)
+	dst[6] = (uint32(vals[0]&64) >> 6)
+	dst[7] = (uint32(vals[0]&128) >> 7)
+	dst[8] = (uint32(vals[1]&1) >> 0)
+	dst[9] = (uint32(vals[1]&2) >> 1)
+	dst[10] = (uint32(vals[1]&4) >> 2)
+	dst[11] = (uint32(vals[1]&8) >> 3)
+	dst[12] = (uint32(vals[1]&16) >> 4)
+	dst[13] = (uint32(vals[1]&32) >> 5)
+	dst[14] = (uint32(vals[1]&64) >> 6)
+	dst[15] = (uint32(vals[1]&128) >> 7)
+	dst[16] = (uint32(vals[2]&1) >> 0)
+	dst[17] = (uint32(vals[2]&2) >> 1)
+	dst[18] = (uint32(vals[2]&4) >> 2)
+	dst[19] = (uint32(vals[2]&8) >> 3)
+	dst[20] = (uint32(vals[2]&16) >> 4)
+	dst[21] = (uint32(vals[2]&32) >> 5)
+	dst[22] = (uint32(vals[2]&64) >> 6)
+	dst[23] = (uint32(vals[2]&128) >> 7)
+	dst[24] = (uint32(vals[3]&1) >> 0)
+	dst[25] = (uint32(vals[3]&2) >> 1)
+	dst[26] = (uint32(vals[3]&4) >> 2)
+	dst[27] = (uint32(vals[3]&8) >> 3)
+	dst[28] = (uint32(vals[3]&16) >> 4)
+	dst[29] = (uint32(vals[3]&32) >> 5)
+	dst[30] = (uint32(vals[3]&64) >> 6)
+	dst[31] = (uint32(vals[3]&128) >> 7)
 }
 
-func unpack4(vals []byte) []uint8 {
-	return []uint8{
-		(uint8(vals[0]&15) >> 0),
-		(uint8(vals[0]&240) >> 4),
-		(uint8(vals[1]&15) >> 0),
-		(uint8(vals[1]&240) >> 4),
-		(uint8(vals[2]&15) >> 0),
-		(uint8(vals[2]&240) >> 4),
-		(uint8(vals[3]&15) >> 0),
-		(uint8(vals[3]&240) >> 4),
-	}
+func unpack2(dst []uint32, vals []byte) {
+	_ = vals[1]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&3) >> 0)
+	dst[1] = (uint32(vals[0]&12) >> 2)
+	dst[2] = (uint32(vals[0]&48) >> 4)
+	dst[3] = (uint32(vals[0]&192) >> 6)
+	dst[4] = (uint32(vals[1]&3) >> 0)
+	dst[5] = (uint32(vals[1]&12) >> 2)
+	dst[6] = (uint32(vals[1]&48) >> 4)
+	dst[7] = (uint32(vals[1]&192) >> 6)
+}
+
+func unpack32x2(dst []uint32, vals []byte) {
+	_ = vals[7]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&3) >> 0)
+	dst[1] = (uint32(vals[0]&12) >> 2)
+	dst[2] = (uint32(vals[0]&48) >> 4)
+	dst[3] = (uint32(vals[0]&192) >> 6)
+	dst[4] = (uint32(vals[1]&3) >> 0)
+	dst[5] = (uint32(vals[1]&12) >> 2)
+	dst[6] = (uint32(vals[1]&48) >> 4)
+	dst[7] = (uint32(vals[1]&192) >> 6)
+	dst[8] = (uint32(vals[2]&3) >> 0)
+	dst[9] = (uint32(vals[2]&12) >> 2)
+	dst[10] = (uint32(vals[2]&48) >> 4)
+	dst[11] = (uint32(vals[2]&192) >> 6)
+	dst[12] = (uint32(vals[3]&3) >> 0)
+	dst[13] = (uint32(vals[3]&12) >> 2)
+	dst[14] = (uint32(vals[3]&48) >> 4)
+	dst[15] = (uint32(vals[3]&192) >> 6)
+	dst[16] = (uint32(vals[4]&3) >> 0)
+	dst[17] = (uint32(vals[4]&12) >> 2)
+	dst[18] = (uint32(vals[4]&48) >> 4)
+	dst[19] = (uint32(vals[4]&192) >> 6)
+	dst[20] = (uint32(vals[5]&3) >> 0)
+	dst[21] = (uint32(vals[5]&12) >> 2)
+	dst[22] = (uint32(vals[5]&48) >> 4)
+	dst[23] = (uint32(vals[5]&192) >> 6)
+	dst[24] = (uint32(vals[6]&3) >> 0)
+	dst[25] = (uint32(vals[6]&12) >> 2)
+	dst[26] = (uint32(vals[6]&48) >> 4)
+	dst[27] = (uint32(vals[6]&192) >> 6)
+	dst[28] = (uint32(vals[7]&3) >> 0)
+	dst[29] = (uint32(vals[7]&12) >> 2)
+	dst[30] = (uint32(vals[7]&48) >> 4)
+	dst[31] = (uint32(vals[7]&192) >> 6)
+}
+
+func unpack3(dst []uint32, vals []byte) {
+	_ = vals[2]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&7) >> 0)
+	dst[1] = (uint32(vals[0]&56) >> 3)
+	dst[2] = (uint32(vals[0]&192) >> 6) | (uint32(vals[1]&1) << 2)
+	dst[3] = (uint32(vals[1]&14) >> 1)
+	dst[4] = (uint32(vals[1]&112) >> 4)
+	dst[5] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&3) << 1)
+	dst[6] = (uint32(vals[2]&28) >> 2)
+	dst[7] = (uint32(vals[2]&224) >> 5)
+}
+
+func unpack32x3(dst []uint32, vals []byte) {
+	_ = vals[11]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&7) >> 0)
+	dst[1] = (uint32(vals[0]&56) >> 3)
+	dst[2] = (uint32(vals[0]&192) >> 6) | (uint32(vals[1]&1) << 2)
+	dst[3] = (uint32(vals[1]&14) >> 1)
+	dst[4] = (uint32(vals[1]&112) >> 4)
+	dst[5] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&3) << 1)
+	dst[6] = (uint32(vals[2]&28) >> 2)
+	dst[7] = (uint32(vals[2]&224) >> 5)
+	dst[8] = (uint32(vals[3]&7) >> 0)
+	dst[9] = (uint32(vals[3]&56) >> 3)
+	dst[10] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&1) << 2)
+	dst[11] = (uint32(vals[4]&14) >> 1)
+	dst[12] = (uint32(vals[4]&112) >> 4)
+	dst[13] = (uint32(vals[4]&128) >> 7) | (uint32(vals[5]&3) << 1)
+	dst[14] = (uint32(vals[5]&28) >> 2)
+	dst[15] = (uint32(vals[5]&224) >> 5)
+	dst[16] = (uint32(vals[6]&7) >> 0)
+	dst[17] = (uint32(vals[6]&56) >> 3)
+	dst[18] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&1) << 2)
+	dst[19] = (uint32(vals[7]&14) >> 1)
+	dst[20] = (uint32(vals[7]&112) >> 4)
+	dst[21] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&3) << 1)
+	dst[22] = (uint32(vals[8]&28) >> 2)
+	dst[23] = (uint32(vals[8]&224) >> 5)
+	dst[24] = (uint32(vals[9]&7) >> 0)
+	dst[25] = (uint32(vals[9]&56) >> 3)
+	dst[26] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&1) << 2)
+	dst[27] = (uint32(vals[10]&14) >> 1)
+	dst[28] = (uint32(vals[10]&112) >> 4)
+	dst[29] = (uint32(vals[10]&128) >> 7) | (uint32(vals[11]&3) << 1)
+	dst[30] = (uint32(vals[11]&28) >> 2)
+	dst[31] = (uint32(vals[11]&224) >> 5)
+}
+
+func unpack4(dst []uint32, vals []byte) {
+	_ = vals[3]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&15) >> 0)
+	dst[1] = (uint32(vals[0]&240) >> 4)
+	dst[2] = (uint32(vals[1]&15) >> 0)
+	dst[3] = (uint32(vals[1]&240) >> 4)
+	dst[4] = (uint32(vals[2]&15) >> 0)
+	dst[5] = (uint32(vals[2]&240) >> 4)
+	dst[6] = (uint32(vals[3]&15) >> 0)
+	dst[7] = (uint32(vals[3]&240) >> 4)
+}
+
+func unpack32x4(dst []uint32, vals []byte) {
+	_ = vals[15]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&15) >> 0)
+	dst[1] = (uint32(vals[0]&240) >> 4)
+	dst[2] = (uint32(vals[1]&15) >> 0)
+	dst[3] = (uint32(vals[1]&240) >> 4)
+	dst[4] = (uint32(vals[2]&15) >> 0)
+	dst[5] = (uint32(vals[2]&240) >> 4)
+	dst[6] = (uint32(vals[3]&15) >> 0)
+	dst[7] = (uint32(vals[3]&240) >> 4)
+	dst[8] = (uint32(vals[4]&15) >> 0)
+	dst[9] = (uint32(vals[4]&240) >> 4)
+	dst[10] = (uint32(vals[5]&15) >> 0)
+	dst[11] = (uint32(vals[5]&240) >> 4)
+	dst[12] = (uint32(vals[6]&15) >> 0)
+	dst[13] = (uint32(vals[6]&240) >> 4)
+	dst[14] = (uint32(vals[7]&15) >> 0)
+	dst[15] = (uint32(vals[7]&240) >> 4)
+	dst[16] = (uint32(vals[8]&15) >> 0)
+	dst[17] = (uint32(vals[8]&240) >> 4)
+	dst[18] = (uint32(vals[9]&15) >> 0)
+	dst[19] = (uint32(vals[9]&240) >> 4)
+	dst[20] = (uint32(vals[10]&15) >> 0)
+	dst[21] = (uint32(vals[10]&240) >> 4)
+	dst[22] = (uint32(vals[11]&15) >> 0)
+	dst[23] = (uint32(vals[11]&240) >> 4)
+	dst[24] = (uint32(vals[12]&15) >> 0)
+	dst[25] = (uint32(vals[12]&240) >> 4)
+	dst[26] = (uint32(vals[13]&15) >> 0)
+	dst[27] = (uint32(vals[13]&240) >> 4)
+	dst[28] = (uint32(vals[14]&15) >> 0)
+	dst[29] = (uint32(vals[14]&240) >> 4)
+	dst[30] = (uint32(vals[15]&15) >> 0)
+	dst[31] = (uint32(vals[15]&240) >> 4)
+}
+
+func unpack5(dst []uint32, vals []byte) {
+	_ = vals[4]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&31) >> 0)
+	dst[1] = (uint32(vals[0]&224) >> 5) | (uint32(vals[1]&3) << 3)
+	dst[2] = (uint32(vals[1]&124) >> 2)
+	dst[3] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&15) << 1)
+	dst[4] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&1) << 4)
+	dst[5] = (uint32(vals[3]&62) >> 1)
+	dst[6] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&7) << 2)
+	dst[7] = (uint32(vals[4]&248) >> 3)
+}
+
+func unpack32x5(dst []uint32, vals []byte) {
+	_ = vals[19]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&31) >> 0)
+	dst[1] = (uint32(vals[0]&224) >> 5) | (uint32(vals[1]&3) << 3)
+	dst[2] = (uint32(vals[1]&124) >> 2)
+	dst[3] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&15) << 1)
+	dst[4] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&1) << 4)
+	dst[5] = (uint32(vals[3]&62) >> 1)
+	dst[6] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&7) << 2)
+	dst[7] = (uint32(vals[4]&248) >> 3)
+	dst[8] = (uint32(vals[5]&31) >> 0)
+	dst[9] = (uint32(vals[5]&224) >> 5) | (uint32(vals[6]&3) << 3)
+	dst[10] = (uint32(vals[6]&124) >> 2)
+	dst[11] = (uint32(vals[6]&128) >> 7) | (uint32(vals[7]&15) << 1)
+	dst[12] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&1) << 4)
+	dst[13] = (uint32(vals[8]&62) >> 1)
+	dst[14] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&7) << 2)
+	dst[15] = (uint32(vals[9]&248) >> 3)
+	dst[16] = (uint32(vals[10]&31) >> 0)
+	dst[17] = (uint32(vals[10]&224) >> 5) | (uint32(vals[11]&3) << 3)
+	dst[18] = (uint32(vals[11]&124) >> 2)
+	dst[19] = (uint32(vals[11]&128) >> 7) | (uint32(vals[12]&15) << 1)
+	dst[20] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&1) << 4)
+	dst[21] = (uint32(vals[13]&62) >> 1)
+	dst[22] = (uint32(vals[13]&192) >> 6) | (uint32(vals[14]&7) << 2)
+	dst[23] = (uint32(vals[14]&248) >> 3)
+	dst[24] = (uint32(vals[15]&31) >> 0)
+	dst[25] = (uint32(vals[15]&224) >> 5) | (uint32(vals[16]&3) << 3)
+	dst[26] = (uint32(vals[16]&124) >> 2)
+	dst[27] = (uint32(vals[16]&128) >> 7) | (uint32(vals[17]&15) << 1)
+	dst[28] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&1) << 4)
+	dst[29] = (uint32(vals[18]&62) >> 1)
+	dst[30] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&7) << 2)
+	dst[31] = (uint32(vals[19]&248) >> 3)
+}
+
+func unpack6(dst []uint32, vals []byte) {
+	_ = vals[5]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&63) >> 0)
+	dst[1] = (uint32(vals[0]&192) >> 6) | (uint32(vals[1]&15) << 2)
+	dst[2] = (uint32(vals[1]&240) >> 4) | (uint32(vals[2]&3) << 4)
+	dst[3] = (uint32(vals[2]&252) >> 2)
+	dst[4] = (uint32(vals[3]&63) >> 0)
+	dst[5] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&15) << 2)
+	dst[6] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&3) << 4)
+	dst[7] = (uint32(vals[5]&252) >> 2)
+}
+
+func unpack32x6(dst []uint32, vals []byte) {
+	_ = vals[23]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&63) >> 0)
+	dst[1] = (uint32(vals[0]&192) >> 6) | (uint32(vals[1]&15) << 2)
+	dst[2] = (uint32(vals[1]&240) >> 4) | (uint32(vals[2]&3) << 4)
+	dst[3] = (uint32(vals[2]&252) >> 2)
+	dst[4] = (uint32(vals[3]&63) >> 0)
+	dst[5] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&15) << 2)
+	dst[6] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&3) << 4)
+	dst[7] = (uint32(vals[5]&252) >> 2)
+	dst[8] = (uint32(vals[6]&63) >> 0)
+	dst[9] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&15) << 2)
+	dst[10] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&3) << 4)
+	dst[11] = (uint32(vals[8]&252) >> 2)
+	dst[12] = (uint32(vals[9]&63) >> 0)
+	dst[13] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&15) << 2)
+	dst[14] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&3) << 4)
+	dst[15] = (uint32(vals[11]&252) >> 2)
+	dst[16] = (uint32(vals[12]&63) >> 0)
+	dst[17] = (uint32(vals[12]&192) >> 6) | (uint32(vals[13]&15) << 2)
+	dst[18] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&3) << 4)
+	dst[19] = (uint32(vals[14]&252) >> 2)
+	dst[20] = (uint32(vals[15]&63) >> 0)
+	dst[21] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&15) << 2)
+	dst[22] = (uint32(vals[16]&240) >> 4) | (uint32(vals[17]&3) << 4)
+	dst[23] = (uint32(vals[17]&252) >> 2)
+	dst[24] = (uint32(vals[18]&63) >> 0)
+	dst[25] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&15) << 2)
+	dst[26] = (uint32(vals[19]&240) >> 4) | (uint32(vals[20]&3) << 4)
+	dst[27] = (uint32(vals[20]&252) >> 2)
+	dst[28] = (uint32(vals[21]&63) >> 0)
+	dst[29] = (uint32(vals[21]&192) >> 6) | (uint32(vals[22]&15) << 2)
+	dst[30] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&3) << 4)
+	dst[31] = (uint32(vals[23]&252) >> 2)
+}
+
+func unpack7(dst []uint32, vals []byte) {
+	_ = vals[6]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&127) >> 0)
+	dst[1] = (uint32(vals[0]&128) >> 7) | (uint32(vals[1]&63) << 1)
+	dst[2] = (uint32(vals[1]&192) >> 6) | (uint32(vals[2]&31) << 2)
+	dst[3] = (uint32(vals[2]&224) >> 5) | (uint32(vals[3]&15) << 3)
+	dst[4] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&7) << 4)
+	dst[5] = (uint32(vals[4]&248) >> 3) | (uint32(vals[5]&3) << 5)
+	dst[6] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&1) << 6)
+	dst[7] = (uint32(vals[6]&254) >> 1)
+}
+
+func unpack32x7(dst []uint32, vals []byte) {
+	_ = vals[27]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&127) >> 0)
+	dst[1] = (uint32(vals[0]&128) >> 7) | (uint32(vals[1]&63) << 1)
+	dst[2] = (uint32(vals[1]&192) >> 6) | (uint32(vals[2]&31) << 2)
+	dst[3] = (uint32(vals[2]&224) >> 5) | (uint32(vals[3]&15) << 3)
+	dst[4] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&7) << 4)
+	dst[5] = (uint32(vals[4]&248) >> 3) | (uint32(vals[5]&3) << 5)
+	dst[6] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&1) << 6)
+	dst[7] = (uint32(vals[6]&254) >> 1)
+	dst[8] = (uint32(vals[7]&127) >> 0)
+	dst[9] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&63) << 1)
+	dst[10] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&31) << 2)
+	dst[11] = (uint32(vals[9]&224) >> 5) | (uint32(vals[10]&15) << 3)
+	dst[12] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&7) << 4)
+	dst[13] = (uint32(vals[11]&248) >> 3) | (uint32(vals[12]&3) << 5)
+	dst[14] = (uint32(vals[12]&252) >> 2) | (uint32(vals[13]&1) << 6)
+	dst[15] = (uint32(vals[13]&254) >> 1)
+	dst[16] = (uint32(vals[14]&127) >> 0)
+	dst[17] = (uint32(vals[14]&128) >> 7) | (uint32(vals[15]&63) << 1)
+	dst[18] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&31) << 2)
+	dst[19] = (uint32(vals[16]&224) >> 5) | (uint32(vals[17]&15) << 3)
+	dst[20] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&7) << 4)
+	dst[21] = (uint32(vals[18]&248) >> 3) | (uint32(vals[19]&3) << 5)
+	dst[22] = (uint32(vals[19]&252) >> 2) | (uint32(vals[20]&1) << 6)
+	dst[23] = (uint32(vals[20]&254) >> 1)
+	dst[24] = (uint32(vals[21]&127) >> 0)
+	dst[25] = (uint32(vals[21]&128) >> 7) | (uint32(vals[22]&63) << 1)
+	dst[26] = (uint32(vals[22]&192) >> 6) | (uint32(vals[23]&31) << 2)
+	dst[27] = (uint32(vals[23]&224) >> 5) | (uint32(vals[24]&15) << 3)
+	dst[28] = (uint32(vals[24]&240) >> 4) | (uint32(vals[25]&7) << 4)
+	dst[29] = (uint32(vals[25]&248) >> 3) | (uint32(vals[26]&3) << 5)
+	dst[30] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&1) << 6)
+	dst[31] = (uint32(vals[27]&254) >> 1)
+}
+
+func unpack8(dst []uint32, vals []byte) {
+	_ = vals[7]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0)
+	dst[1] = (uint32(vals[1]&255) >> 0)
+	dst[2] = (uint32(vals[2]&255) >> 0)
+	dst[3] = (uint32(vals[3]&255) >> 0)
+	dst[4] = (uint32(vals[4]&255) >> 0)
+	dst[5] = (uint32(vals[5]&255) >> 0)
+	dst[6] = (uint32(vals[6]&255) >> 0)
+	dst[7] = (uint32(vals[7]&255) >> 0)
+}
+
+func unpack32x8(dst []uint32, vals []byte) {
+	_ = vals[31]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0)
+	dst[1] = (uint32(vals[1]&255) >> 0)
+	dst[2] = (uint32(vals[2]&255) >> 0)
+	dst[3] = (uint32(vals[3]&255) >> 0)
+	dst[4] = (uint32(vals[4]&255) >> 0)
+	dst[5] = (uint32(vals[5]&255) >> 0)
+	dst[6] = (uint32(vals[6]&255) >> 0)
+	dst[7] = (uint32(vals[7]&255) >> 0)
+	dst[8] = (uint32(vals[8]&255) >> 0)
+	dst[9] = (uint32(vals[9]&255) >> 0)
+	dst[10] = (uint32(vals[10]&255) >> 0)
+	dst[11] = (uint32(vals[11]&255) >> 0)
+	dst[12] = (uint32(vals[12]&255) >> 0)
+	dst[13] = (uint32(vals[13]&255) >> 0)
+	dst[14] = (uint32(vals[14]&255) >> 0)
+	dst[15] = (uint32(vals[15]&255) >> 0)
+	dst[16] = (uint32(vals[16]&255) >> 0)
+	dst[17] = (uint32(vals[17]&255) >> 0)
+	dst[18] = (uint32(vals[18]&255) >> 0)
+	dst[19] = (uint32(vals[19]&255) >> 0)
+	dst[20] = (uint32(vals[20]&255) >> 0)
+	dst[21] = (uint32(vals[21]&255) >> 0)
+	dst[22] = (uint32(vals[22]&255) >> 0)
+	dst[23] = (uint32(vals[23]&255) >> 0)
+	dst[24] = (uint32(vals[24]&255) >> 0)
+	dst[25] = (uint32(vals[25]&255) >> 0)
+	dst[26] = (uint32(vals[26]&255) >> 0)
+	dst[27] = (uint32(vals[27]&255) >> 0)
+	dst[28] = (uint32(vals[28]&255) >> 0)
+	dst[29] = (uint32(vals[29]&255) >> 0)
+	dst[30] = (uint32(vals[30]&255) >> 0)
+	dst[31] = (uint32(vals[31]&255) >> 0)
+}
+
+func unpack9(dst []uint32, vals []byte) {
+	_ = vals[8]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&1) << 8)
+	dst[1] = (uint32(vals[1]&254) >> 1) | (uint32(vals[2]&3) << 7)
+	dst[2] = (uint32(vals[2]&252) >> 2) | (uint32(vals[3]&7) << 6)
+	dst[3] = (uint32(vals[3]&248) >> 3) | (uint32(vals[4]&15) << 5)
+	dst[4] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&31) << 4)
+	dst[5] = (uint32(vals[5]&224) >> 5) | (uint32(vals[6]&63) << 3)
+	dst[6] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&127) << 2)
+	dst[7] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&255) << 1)
+}
+
+func unpack32x9(dst []uint32, vals []byte) {
+	_ = vals[35]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&1) << 8)
+	dst[1] = (uint32(vals[1]&254) >> 1) | (uint32(vals[2]&3) << 7)
+	dst[2] = (uint32(vals[2]&252) >> 2) | (uint32(vals[3]&7) << 6)
+	dst[3] = (uint32(vals[3]&248) >> 3) | (uint32(vals[4]&15) << 5)
+	dst[4] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&31) << 4)
+	dst[5] = (uint32(vals[5]&224) >> 5) | (uint32(vals[6]&63) << 3)
+	dst[6] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&127) << 2)
+	dst[7] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&255) << 1)
+	dst[8] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&1) << 8)
+	dst[9] = (uint32(vals[10]&254) >> 1) | (uint32(vals[11]&3) << 7)
+	dst[10] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&7) << 6)
+	dst[11] = (uint32(vals[12]&248) >> 3) | (uint32(vals[13]&15) << 5)
+	dst[12] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&31) << 4)
+	dst[13] = (uint32(vals[14]&224) >> 5) | (uint32(vals[15]&63) << 3)
+	dst[14] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&127) << 2)
+	dst[15] = (uint32(vals[16]&128) >> 7) | (uint32(vals[17]&255) << 1)
+	dst[16] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&1) << 8)
+	dst[17] = (uint32(vals[19]&254) >> 1) | (uint32(vals[20]&3) << 7)
+	dst[18] = (uint32(vals[20]&252) >> 2) | (uint32(vals[21]&7) << 6)
+	dst[19] = (uint32(vals[21]&248) >> 3) | (uint32(vals[22]&15) << 5)
+	dst[20] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&31) << 4)
+	dst[21] = (uint32(vals[23]&224) >> 5) | (uint32(vals[24]&63) << 3)
+	dst[22] = (uint32(vals[24]&192) >> 6) | (uint32(vals[25]&127) << 2)
+	dst[23] = (uint32(vals[25]&128) >> 7) | (uint32(vals[26]&255) << 1)
+	dst[24] = (uint32(vals[27]&255) >> 0) | (uint32(vals[28]&1) << 8)
+	dst[25] = (uint32(vals[28]&254) >> 1) | (uint32(vals[29]&3) << 7)
+	dst[26] = (uint32(vals[29]&252) >> 2) | (uint32(vals[30]&7) << 6)
+	dst[27] = (uint32(vals[30]&248) >> 3) | (uint32(vals[31]&15) << 5)
+	dst[28] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&31) << 4)
+	dst[29] = (uint32(vals[32]&224) >> 5) | (uint32(vals[33]&63) << 3)
+	dst[30] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&127) << 2)
+	dst[31] = (uint32(vals[34]&128) >> 7) | (uint32(vals[35]&255) << 1)
+}
+
+func unpack10(dst []uint32, vals []byte) {
+	_ = vals[9]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&3) << 8)
+	dst[1] = (uint32(vals[1]&252) >> 2) | (uint32(vals[2]&15) << 6)
+	dst[2] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&63) << 4)
+	dst[3] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2)
+	dst[4] = (uint32(vals[5]&255) >> 0) | (uint32(vals[6]&3) << 8)
+	dst[5] = (uint32(vals[6]&252) >> 2) | (uint32(vals[7]&15) << 6)
+	dst[6] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&63) << 4)
+	dst[7] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&255) << 2)
+}
+
+func unpack32x10(dst []uint32, vals []byte) {
+	_ = vals[39]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&3) << 8)
+	dst[1] = (uint32(vals[1]&252) >> 2) | (uint32(vals[2]&15) << 6)
+	dst[2] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&63) << 4)
+	dst[3] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2)
+	dst[4] = (uint32(vals[5]&255) >> 0) | (uint32(vals[6]&3) << 8)
+	dst[5] = (uint32(vals[6]&252) >> 2) | (uint32(vals[7]&15) << 6)
+	dst[6] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&63) << 4)
+	dst[7] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&255) << 2)
+	dst[8] = (uint32(vals[10]&255) >> 0) | (uint32(vals[11]&3) << 8)
+	dst[9] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&15) << 6)
+	dst[10] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&63) << 4)
+	dst[11] = (uint32(vals[13]&192) >> 6) | (uint32(vals[14]&255) << 2)
+	dst[12] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&3) << 8)
+	dst[13] = (uint32(vals[16]&252) >> 2) | (uint32(vals[17]&15) << 6)
+	dst[14] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&63) << 4)
+	dst[15] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2)
+	dst[16] = (uint32(vals[20]&255) >> 0) | (uint32(vals[21]&3) << 8)
+	dst[17] = (uint32(vals[21]&252) >> 2) | (uint32(vals[22]&15) << 6)
+	dst[18] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&63) << 4)
+	dst[19] = (uint32(vals[23]&192) >> 6) | (uint32(vals[24]&255) << 2)
+	dst[20] = (uint32(vals[25]&255) >> 0) | (uint32(vals[26]&3) << 8)
+	dst[21] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&15) << 6)
+	dst[22] = (uint32(vals[27]&240) >> 4) | (uint32(vals[28]&63) << 4)
+	dst[23] = (uint32(vals[28]&192) >> 6) | (uint32(vals[29]&255) << 2)
+	dst[24] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&3) << 8)
+	dst[25] = (uint32(vals[31]&252) >> 2) | (uint32(vals[32]&15) << 6)
+	dst[26] = (uint32(vals[32]&240) >> 4) | (uint32(vals[33]&63) << 4)
+	dst[27] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&255) << 2)
+	dst[28] = (uint32(vals[35]&255) >> 0) | (uint32(vals[36]&3) << 8)
+	dst[29] = (uint32(vals[36]&252) >> 2) | (uint32(vals[37]&15) << 6)
+	dst[30] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&63) << 4)
+	dst[31] = (uint32(vals[38]&192) >> 6) | (uint32(vals[39]&255) << 2)
+}
+
+func unpack11(dst []uint32, vals []byte) {
+	_ = vals[10]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&7) << 8)
+	dst[1] = (uint32(vals[1]&248) >> 3) | (uint32(vals[2]&63) << 5)
+	dst[2] = (uint32(vals[2]&192) >> 6) | (uint32(vals[3]&255) << 2) | (uint32(vals[4]&1) << 10)
+	dst[3] = (uint32(vals[4]&254) >> 1) | (uint32(vals[5]&15) << 7)
+	dst[4] = (uint32(vals[5]&240) >> 4) | (uint32(vals[6]&127) << 4)
+	dst[5] = (uint32(vals[6]&128) >> 7) | (uint32(vals[7]&255) << 1) | (uint32(vals[8]&3) << 9)
+	dst[6] = (uint32(vals[8]&252) >> 2) | (uint32(vals[9]&31) << 6)
+	dst[7] = (uint32(vals[9]&224) >> 5) | (uint32(vals[10]&255) << 3)
+}
+
+func unpack32x11(dst []uint32, vals []byte) {
+	_ = vals[43]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&7) << 8)
+	dst[1] = (uint32(vals[1]&248) >> 3) | (uint32(vals[2]&63) << 5)
+	dst[2] = (uint32(vals[2]&192) >> 6) | (uint32(vals[3]&255) << 2) | (uint32(vals[4]&1) << 10)
+	dst[3] = (uint32(vals[4]&254) >> 1) | (uint32(vals[5]&15) << 7)
+	dst[4] = (uint32(vals[5]&240) >> 4) | (uint32(vals[6]&127) << 4)
+	dst[5] = (uint32(vals[6]&128) >> 7) | (uint32(vals[7]&255) << 1) | (uint32(vals[8]&3) << 9)
+	dst[6] = (uint32(vals[8]&252) >> 2) | (uint32(vals[9]&31) << 6)
+	dst[7] = (uint32(vals[9]&224) >> 5) | (uint32(vals[10]&255) << 3)
+	dst[8] = (uint32(vals[11]&255) >> 0) | (uint32(vals[12]&7) << 8)
+	dst[9] = (uint32(vals[12]&248) >> 3) | (uint32(vals[13]&63) << 5)
+	dst[10] = (uint32(vals[13]&192) >> 6) | (uint32(vals[14]&255) << 2) | (uint32(vals[15]&1) << 10)
+	dst[11] = (uint32(vals[15]&254) >> 1) | (uint32(vals[16]&15) << 7)
+	dst[12] = (uint32(vals[16]&240) >> 4) | (uint32(vals[17]&127) << 4)
+	dst[13] = (uint32(vals[17]&128) >> 7) | (uint32(vals[18]&255) << 1) | (uint32(vals[19]&3) << 9)
+	dst[14] = (uint32(vals[19]&252) >> 2) | (uint32(vals[20]&31) << 6)
+	dst[15] = (uint32(vals[20]&224) >> 5) | (uint32(vals[21]&255) << 3)
+	dst[16] = (uint32(vals[22]&255) >> 0) | (uint32(vals[23]&7) << 8)
+	dst[17] = (uint32(vals[23]&248) >> 3) | (uint32(vals[24]&63) << 5)
+	dst[18] = (uint32(vals[24]&192) >> 6) | (uint32(vals[25]&255) << 2) | (uint32(vals[26]&1) << 10)
+	dst[19] = (uint32(vals[26]&254) >> 1) | (uint32(vals[27]&15) << 7)
+	dst[20] = (uint32(vals[27]&240) >> 4) | (uint32(vals[28]&127) << 4)
+	dst[21] = (uint32(vals[28]&128) >> 7) | (uint32(vals[29]&255) << 1) | (uint32(vals[30]&3) << 9)
+	dst[22] = (uint32(vals[30]&252) >> 2) | (uint32(vals[31]&31) << 6)
+	dst[23] = (uint32(vals[31]&224) >> 5) | (uint32(vals[32]&255) << 3)
+	dst[24] = (uint32(vals[33]&255) >> 0) | (uint32(vals[34]&7) << 8)
+	dst[25] = (uint32(vals[34]&248) >> 3) | (uint32(vals[35]&63) << 5)
+	dst[26] = (uint32(vals[35]&192) >> 6) | (uint32(vals[36]&255) << 2) | (uint32(vals[37]&1) << 10)
+	dst[27] = (uint32(vals[37]&254) >> 1) | (uint32(vals[38]&15) << 7)
+	dst[28] = (uint32(vals[38]&240) >> 4) | (uint32(vals[39]&127) << 4)
+	dst[29] = (uint32(vals[39]&128) >> 7) | (uint32(vals[40]&255) << 1) | (uint32(vals[41]&3) << 9)
+	dst[30] = (uint32(vals[41]&252) >> 2) | (uint32(vals[42]&31) << 6)
+	dst[31] = (uint32(vals[42]&224) >> 5) | (uint32(vals[43]&255) << 3)
+}
+
+func unpack12(dst []uint32, vals []byte) {
+	_ = vals[11]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&15) << 8)
+	dst[1] = (uint32(vals[1]&240) >> 4) | (uint32(vals[2]&255) << 4)
+	dst[2] = (uint32(vals[3]&255) >> 0) | (uint32(vals[4]&15) << 8)
+	dst[3] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&255) << 4)
+	dst[4] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&15) << 8)
+	dst[5] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4)
+	dst[6] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&15) << 8)
+	dst[7] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4)
+}
+
+func unpack32x12(dst []uint32, vals []byte) {
+	_ = vals[47]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&15) << 8)
+	dst[1] = (uint32(vals[1]&240) >> 4) | (uint32(vals[2]&255) << 4)
+	dst[2] = (uint32(vals[3]&255) >> 0) | (uint32(vals[4]&15) << 8)
+	dst[3] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&255) << 4)
+	dst[4] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&15) << 8)
+	dst[5] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4)
+	dst[6] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&15) << 8)
+	dst[7] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4)
+	dst[8] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&15) << 8)
+	dst[9] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&255) << 4)
+	dst[10] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&15) << 8)
+	dst[11] = (uint32(vals[16]&240) >> 4) | (uint32(vals[17]&255) << 4)
+	dst[12] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&15) << 8)
+	dst[13] = (uint32(vals[19]&240) >> 4) | (uint32(vals[20]&255) << 4)
+	dst[14] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&15) << 8)
+	dst[15] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4)
+	dst[16] = (uint32(vals[24]&255) >> 0) | (uint32(vals[25]&15) << 8)
+	dst[17] = (uint32(vals[25]&240) >> 4) | (uint32(vals[26]&255) << 4)
+	dst[18] = (uint32(vals[27]&255) >> 0) | (uint32(vals[28]&15) << 8)
+	dst[19] = (uint32(vals[28]&240) >> 4) | (uint32(vals[29]&255) << 4)
+	dst[20] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&15) << 8)
+	dst[21] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&255) << 4)
+	dst[22] = (uint32(vals[33]&255) >> 0) | (uint32(vals[34]&15) << 8)
+	dst[23] = (uint32(vals[34]&240) >> 4) | (uint32(vals[35]&255) << 4)
+	dst[24] = (uint32(vals[36]&255) >> 0) | (uint32(vals[37]&15) << 8)
+	dst[25] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&255) << 4)
+	dst[26] = (uint32(vals[39]&255) >> 0) | (uint32(vals[40]&15) << 8)
+	dst[27] = (uint32(vals[40]&240) >> 4) | (uint32(vals[41]&255) << 4)
+	dst[28] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&15) << 8)
+	dst[29] = (uint32(vals[43]&240) >> 4) | (uint32(vals[44]&255) << 4)
+	dst[30] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&15) << 8)
+	dst[31] = (uint32(vals[46]&240) >> 4) | (uint32(vals[47]&255) << 4)
+}
+
+func unpack13(dst []uint32, vals []byte) {
+	_ = vals[12]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&31) << 8)
+	dst[1] = (uint32(vals[1]&224) >> 5) | (uint32(vals[2]&255) << 3) | (uint32(vals[3]&3) << 11)
+	dst[2] = (uint32(vals[3]&252) >> 2) | (uint32(vals[4]&127) << 6)
+	dst[3] = (uint32(vals[4]&128) >> 7) | (uint32(vals[5]&255) << 1) | (uint32(vals[6]&15) << 9)
+	dst[4] = (uint32(vals[6]&240) >> 4) | (uint32(vals[7]&255) << 4) | (uint32(vals[8]&1) << 12)
+	dst[5] = (uint32(vals[8]&254) >> 1) | (uint32(vals[9]&63) << 7)
+	dst[6] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&255) << 2) | (uint32(vals[11]&7) << 10)
+	dst[7] = (uint32(vals[11]&248) >> 3) | (uint32(vals[12]&255) << 5)
+}
+
+func unpack32x13(dst []uint32, vals []byte) {
+	_ = vals[51]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&31) << 8)
+	dst[1] = (uint32(vals[1]&224) >> 5) | (uint32(vals[2]&255) << 3) | (uint32(vals[3]&3) << 11)
+	dst[2] = (uint32(vals[3]&252) >> 2) | (uint32(vals[4]&127) << 6)
+	dst[3] = (uint32(vals[4]&128) >> 7) | (uint32(vals[5]&255) << 1) | (uint32(vals[6]&15) << 9)
+	dst[4] = (uint32(vals[6]&240) >> 4) | (uint32(vals[7]&255) << 4) | (uint32(vals[8]&1) << 12)
+	dst[5] = (uint32(vals[8]&254) >> 1) | (uint32(vals[9]&63) << 7)
+	dst[6] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&255) << 2) | (uint32(vals[11]&7) << 10)
+	dst[7] = (uint32(vals[11]&248) >> 3) | (uint32(vals[12]&255) << 5)
+	dst[8] = (uint32(vals[13]&255) >> 0) | (uint32(vals[14]&31) << 8)
+	dst[9] = (uint32(vals[14]&224) >> 5) | (uint32(vals[15]&255) << 3) | (uint32(vals[16]&3) << 11)
+	dst[10] = (uint32(vals[16]&252) >> 2) | (uint32(vals[17]&127) << 6)
+	dst[11] = (uint32(vals[17]&128) >> 7) | (uint32(vals[18]&255) << 1) | (uint32(vals[19]&15) << 9)
+	dst[12] = (uint32(vals[19]&240) >> 4) | (uint32(vals[20]&255) << 4) | (uint32(vals[21]&1) << 12)
+	dst[13] = (uint32(vals[21]&254) >> 1) | (uint32(vals[22]&63) << 7)
+	dst[14] = (uint32(vals[22]&192) >> 6) | (uint32(vals[23]&255) << 2) | (uint32(vals[24]&7) << 10)
+	dst[15] = (uint32(vals[24]&248) >> 3) | (uint32(vals[25]&255) << 5)
+	dst[16] = (uint32(vals[26]&255) >> 0) | (uint32(vals[27]&31) << 8)
+	dst[17] = (uint32(vals[27]&224) >> 5) | (uint32(vals[28]&255) << 3) | (uint32(vals[29]&3) << 11)
+	dst[18] = (uint32(vals[29]&252) >> 2) | (uint32(vals[30]&127) << 6)
+	dst[19] = (uint32(vals[30]&128) >> 7) | (uint32(vals[31]&255) << 1) | (uint32(vals[32]&15) << 9)
+	dst[20] = (uint32(vals[32]&240) >> 4) | (uint32(vals[33]&255) << 4) | (uint32(vals[34]&1) << 12)
+	dst[21] = (uint32(vals[34]&254) >> 1) | (uint32(vals[35]&63) << 7)
+	dst[22] = (uint32(vals[35]&192) >> 6) | (uint32(vals[36]&255) << 2) | (uint32(vals[37]&7) << 10)
+	dst[23] = (uint32(vals[37]&248) >> 3) | (uint32(vals[38]&255) << 5)
+	dst[24] = (uint32(vals[39]&255) >> 0) | (uint32(vals[40]&31) << 8)
+	dst[25] = (uint32(vals[40]&224) >> 5) | (uint32(vals[41]&255) << 3) | (uint32(vals[42]&3) << 11)
+	dst[26] = (uint32(vals[42]&252) >> 2) | (uint32(vals[43]&127) << 6)
+	dst[27] = (uint32(vals[43]&128) >> 7) | (uint32(vals[44]&255) << 1) | (uint32(vals[45]&15) << 9)
+	dst[28] = (uint32(vals[45]&240) >> 4) | (uint32(vals[46]&255) << 4) | (uint32(vals[47]&1) << 12)
+	dst[29] = (uint32(vals[47]&254) >> 1) | (uint32(vals[48]&63) << 7)
+	dst[30] = (uint32(vals[48]&192) >> 6) | (uint32(vals[49]&255) << 2) | (uint32(vals[50]&7) << 10)
+	dst[31] = (uint32(vals[50]&248) >> 3) | (uint32(vals[51]&255) << 5)
+}
+
+func unpack14(dst []uint32, vals []byte) {
+	_ = vals[13]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&63) << 8)
+	dst[1] = (uint32(vals[1]&192) >> 6) | (uint32(vals[2]&255) << 2) | (uint32(vals[3]&15) << 10)
+	dst[2] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&255) << 4) | (uint32(vals[5]&3) << 12)
+	dst[3] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&255) << 6)
+	dst[4] = (uint32(vals[7]&255) >> 0) | (uint32(vals[8]&63) << 8)
+	dst[5] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&255) << 2) | (uint32(vals[10]&15) << 10)
+	dst[6] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&3) << 12)
+	dst[7] = (uint32(vals[12]&252) >> 2) | (uint32(vals[13]&255) << 6)
+}
+
+func unpack32x14(dst []uint32, vals []byte) {
+	_ = vals[55]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&63) << 8)
+	dst[1] = (uint32(vals[1]&192) >> 6) | (uint32(vals[2]&255) << 2) | (uint32(vals[3]&15) << 10)
+	dst[2] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&255) << 4) | (uint32(vals[5]&3) << 12)
+	dst[3] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&255) << 6)
+	dst[4] = (uint32(vals[7]&255) >> 0) | (uint32(vals[8]&63) << 8)
+	dst[5] = (uint32(vals[8]&192) >> 6) | (uint32(vals[9]&255) << 2) | (uint32(vals[10]&15) << 10)
+	dst[6] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&3) << 12)
+	dst[7] = (uint32(vals[12]&252) >> 2) | (uint32(vals[13]&255) << 6)
+	dst[8] = (uint32(vals[14]&255) >> 0) | (uint32(vals[15]&63) << 8)
+	dst[9] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&255) << 2) | (uint32(vals[17]&15) << 10)
+	dst[10] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&255) << 4) | (uint32(vals[19]&3) << 12)
+	dst[11] = (uint32(vals[19]&252) >> 2) | (uint32(vals[20]&255) << 6)
+	dst[12] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&63) << 8)
+	dst[13] = (uint32(vals[22]&192) >> 6) | (uint32(vals[23]&255) << 2) | (uint32(vals[24]&15) << 10)
+	dst[14] = (uint32(vals[24]&240) >> 4) | (uint32(vals[25]&255) << 4) | (uint32(vals[26]&3) << 12)
+	dst[15] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&255) << 6)
+	dst[16] = (uint32(vals[28]&255) >> 0) | (uint32(vals[29]&63) << 8)
+	dst[17] = (uint32(vals[29]&192) >> 6) | (uint32(vals[30]&255) << 2) | (uint32(vals[31]&15) << 10)
+	dst[18] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&255) << 4) | (uint32(vals[33]&3) << 12)
+	dst[19] = (uint32(vals[33]&252) >> 2) | (uint32(vals[34]&255) << 6)
+	dst[20] = (uint32(vals[35]&255) >> 0) | (uint32(vals[36]&63) << 8)
+	dst[21] = (uint32(vals[36]&192) >> 6) | (uint32(vals[37]&255) << 2) | (uint32(vals[38]&15) << 10)
+	dst[22] = (uint32(vals[38]&240) >> 4) | (uint32(vals[39]&255) << 4) | (uint32(vals[40]&3) << 12)
+	dst[23] = (uint32(vals[40]&252) >> 2) | (uint32(vals[41]&255) << 6)
+	dst[24] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&63) << 8)
+	dst[25] = (uint32(vals[43]&192) >> 6) | (uint32(vals[44]&255) << 2) | (uint32(vals[45]&15) << 10)
+	dst[26] = (uint32(vals[45]&240) >> 4) | (uint32(vals[46]&255) << 4) | (uint32(vals[47]&3) << 12)
+	dst[27] = (uint32(vals[47]&252) >> 2) | (uint32(vals[48]&255) << 6)
+	dst[28] = (uint32(vals[49]&255) >> 0) | (uint32(vals[50]&63) << 8)
+	dst[29] = (uint32(vals[50]&192) >> 6) | (uint32(vals[51]&255) << 2) | (uint32(vals[52]&15) << 10)
+	dst[30] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&3) << 12)
+	dst[31] = (uint32(vals[54]&252) >> 2) | (uint32(vals[55]&255) << 6)
+}
+
+func unpack15(dst []uint32, vals []byte) {
+	_ = vals[14]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&127) << 8)
+	dst[1] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&255) << 1) | (uint32(vals[3]&63) << 9)
+	dst[2] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2) | (uint32(vals[5]&31) << 10)
+	dst[3] = (uint32(vals[5]&224) >> 5) | (uint32(vals[6]&255) << 3) | (uint32(vals[7]&15) << 11)
+	dst[4] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&7) << 12)
+	dst[5] = (uint32(vals[9]&248) >> 3) | (uint32(vals[10]&255) << 5) | (uint32(vals[11]&3) << 13)
+	dst[6] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&1) << 14)
+	dst[7] = (uint32(vals[13]&254) >> 1) | (uint32(vals[14]&255) << 7)
+}
+
+func unpack32x15(dst []uint32, vals []byte) {
+	_ = vals[59]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&127) << 8)
+	dst[1] = (uint32(vals[1]&128) >> 7) | (uint32(vals[2]&255) << 1) | (uint32(vals[3]&63) << 9)
+	dst[2] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2) | (uint32(vals[5]&31) << 10)
+	dst[3] = (uint32(vals[5]&224) >> 5) | (uint32(vals[6]&255) << 3) | (uint32(vals[7]&15) << 11)
+	dst[4] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&7) << 12)
+	dst[5] = (uint32(vals[9]&248) >> 3) | (uint32(vals[10]&255) << 5) | (uint32(vals[11]&3) << 13)
+	dst[6] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&1) << 14)
+	dst[7] = (uint32(vals[13]&254) >> 1) | (uint32(vals[14]&255) << 7)
+	dst[8] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&127) << 8)
+	dst[9] = (uint32(vals[16]&128) >> 7) | (uint32(vals[17]&255) << 1) | (uint32(vals[18]&63) << 9)
+	dst[10] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2) | (uint32(vals[20]&31) << 10)
+	dst[11] = (uint32(vals[20]&224) >> 5) | (uint32(vals[21]&255) << 3) | (uint32(vals[22]&15) << 11)
+	dst[12] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4) | (uint32(vals[24]&7) << 12)
+	dst[13] = (uint32(vals[24]&248) >> 3) | (uint32(vals[25]&255) << 5) | (uint32(vals[26]&3) << 13)
+	dst[14] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&255) << 6) | (uint32(vals[28]&1) << 14)
+	dst[15] = (uint32(vals[28]&254) >> 1) | (uint32(vals[29]&255) << 7)
+	dst[16] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&127) << 8)
+	dst[17] = (uint32(vals[31]&128) >> 7) | (uint32(vals[32]&255) << 1) | (uint32(vals[33]&63) << 9)
+	dst[18] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&255) << 2) | (uint32(vals[35]&31) << 10)
+	dst[19] = (uint32(vals[35]&224) >> 5) | (uint32(vals[36]&255) << 3) | (uint32(vals[37]&15) << 11)
+	dst[20] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&255) << 4) | (uint32(vals[39]&7) << 12)
+	dst[21] = (uint32(vals[39]&248) >> 3) | (uint32(vals[40]&255) << 5) | (uint32(vals[41]&3) << 13)
+	dst[22] = (uint32(vals[41]&252) >> 2) | (uint32(vals[42]&255) << 6) | (uint32(vals[43]&1) << 14)
+	dst[23] = (uint32(vals[43]&254) >> 1) | (uint32(vals[44]&255) << 7)
+	dst[24] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&127) << 8)
+	dst[25] = (uint32(vals[46]&128) >> 7) | (uint32(vals[47]&255) << 1) | (uint32(vals[48]&63) << 9)
+	dst[26] = (uint32(vals[48]&192) >> 6) | (uint32(vals[49]&255) << 2) | (uint32(vals[50]&31) << 10)
+	dst[27] = (uint32(vals[50]&224) >> 5) | (uint32(vals[51]&255) << 3) | (uint32(vals[52]&15) << 11)
+	dst[28] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&7) << 12)
+	dst[29] = (uint32(vals[54]&248) >> 3) | (uint32(vals[55]&255) << 5) | (uint32(vals[56]&3) << 13)
+	dst[30] = (uint32(vals[56]&252) >> 2) | (uint32(vals[57]&255) << 6) | (uint32(vals[58]&1) << 14)
+	dst[31] = (uint32(vals[58]&254) >> 1) | (uint32(vals[59]&255) << 7)
+}
+
+func unpack16(dst []uint32, vals []byte) {
+	_ = vals[15]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8)
+	dst[1] = (uint32(vals[2]&255) >> 0) | (uint32(vals[3]&255) << 8)
+	dst[2] = (uint32(vals[4]&255) >> 0) | (uint32(vals[5]&255) << 8)
+	dst[3] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&255) << 8)
+	dst[4] = (uint32(vals[8]&255) >> 0) | (uint32(vals[9]&255) << 8)
+	dst[5] = (uint32(vals[10]&255) >> 0) | (uint32(vals[11]&255) << 8)
+	dst[6] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8)
+	dst[7] = (uint32(vals[14]&255) >> 0) | (uint32(vals[15]&255) << 8)
+}
+
+func unpack32x16(dst []uint32, vals []byte) {
+	_ = vals[63]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8)
+	dst[1] = (uint32(vals[2]&255) >> 0) | (uint32(vals[3]&255) << 8)
+	dst[2] = (uint32(vals[4]&255) >> 0) | (uint32(vals[5]&255) << 8)
+	dst[3] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&255) << 8)
+	dst[4] = (uint32(vals[8]&255) >> 0) | (uint32(vals[9]&255) << 8)
+	dst[5] = (uint32(vals[10]&255) >> 0) | (uint32(vals[11]&255) << 8)
+	dst[6] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8)
+	dst[7] = (uint32(vals[14]&255) >> 0) | (uint32(vals[15]&255) << 8)
+	dst[8] = (uint32(vals[16]&255) >> 0) | (uint32(vals[17]&255) << 8)
+	dst[9] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&255) << 8)
+	dst[10] = (uint32(vals[20]&255) >> 0) | (uint32(vals[21]&255) << 8)
+	dst[11] = (uint32(vals[22]&255) >> 0) | (uint32(vals[23]&255) << 8)
+	dst[12] = (uint32(vals[24]&255) >> 0) | (uint32(vals[25]&255) << 8)
+	dst[13] = (uint32(vals[26]&255) >> 0) | (uint32(vals[27]&255) << 8)
+	dst[14] = (uint32(vals[28]&255) >> 0) | (uint32(vals[29]&255) << 8)
+	dst[15] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&255) << 8)
+	dst[16] = (uint32(vals[32]&255) >> 0) | (uint32(vals[33]&255) << 8)
+	dst[17] = (uint32(vals[34]&255) >> 0) | (uint32(vals[35]&255) << 8)
+	dst[18] = (uint32(vals[36]&255) >> 0) | (uint32(vals[37]&255) << 8)
+	dst[19] = (uint32(vals[38]&255) >> 0) | (uint32(vals[39]&255) << 8)
+	dst[20] = (uint32(vals[40]&255) >> 0) | (uint32(vals[41]&255) << 8)
+	dst[21] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&255) << 8)
+	dst[22] = (uint32(vals[44]&255) >> 0) | (uint32(vals[45]&255) << 8)
+	dst[23] = (uint32(vals[46]&255) >> 0) | (uint32(vals[47]&255) << 8)
+	dst[24] = (uint32(vals[48]&255) >> 0) | (uint32(vals[49]&255) << 8)
+	dst[25] = (uint32(vals[50]&255) >> 0) | (uint32(vals[51]&255) << 8)
+	dst[26] = (uint32(vals[52]&255) >> 0) | (uint32(vals[53]&255) << 8)
+	dst[27] = (uint32(vals[54]&255) >> 0) | (uint32(vals[55]&255) << 8)
+	dst[28] = (uint32(vals[56]&255) >> 0) | (uint32(vals[57]&255) << 8)
+	dst[29] = (uint32(vals[58]&255) >> 0) | (uint32(vals[59]&255) << 8)
+	dst[30] = (uint32(vals[60]&255) >> 0) | (uint32(vals[61]&255) << 8)
+	dst[31] = (uint32(vals[62]&255) >> 0) | (uint32(vals[63]&255) << 8)
+}
+
+func unpack17(dst []uint32, vals []byte) {
+	_ = vals[16]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&1) << 16)
+	dst[1] = (uint32(vals[2]&254) >> 1) | (uint32(vals[3]&255) << 7) | (uint32(vals[4]&3) << 15)
+	dst[2] = (uint32(vals[4]&252) >> 2) | (uint32(vals[5]&255) << 6) | (uint32(vals[6]&7) << 14)
+	dst[3] = (uint32(vals[6]&248) >> 3) | (uint32(vals[7]&255) << 5) | (uint32(vals[8]&15) << 13)
+	dst[4] = (uint32(vals[8]&240) >> 4) | (uint32(vals[9]&255) << 4) | (uint32(vals[10]&31) << 12)
+	dst[5] = (uint32(vals[10]&224) >> 5) | (uint32(vals[11]&255) << 3) | (uint32(vals[12]&63) << 11)
+	dst[6] = (uint32(vals[12]&192) >> 6) | (uint32(vals[13]&255) << 2) | (uint32(vals[14]&127) << 10)
+	dst[7] = (uint32(vals[14]&128) >> 7) | (uint32(vals[15]&255) << 1) | (uint32(vals[16]&255) << 9)
+}
+
+func unpack32x17(dst []uint32, vals []byte) {
+	_ = vals[67]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&1) << 16)
+	dst[1] = (uint32(vals[2]&254) >> 1) | (uint32(vals[3]&255) << 7) | (uint32(vals[4]&3) << 15)
+	dst[2] = (uint32(vals[4]&252) >> 2) | (uint32(vals[5]&255) << 6) | (uint32(vals[6]&7) << 14)
+	dst[3] = (uint32(vals[6]&248) >> 3) | (uint32(vals[7]&255) << 5) | (uint32(vals[8]&15) << 13)
+	dst[4] = (uint32(vals[8]&240) >> 4) | (uint32(vals[9]&255) << 4) | (uint32(vals[10]&31) << 12)
+	dst[5] = (uint32(vals[10]&224) >> 5) | (uint32(vals[11]&255) << 3) | (uint32(vals[12]&63) << 11)
+	dst[6] = (uint32(vals[12]&192) >> 6) | (uint32(vals[13]&255) << 2) | (uint32(vals[14]&127) << 10)
+	dst[7] = (uint32(vals[14]&128) >> 7) | (uint32(vals[15]&255) << 1) | (uint32(vals[16]&255) << 9)
+	dst[8] = (uint32(vals[17]&255) >> 0) | (uint32(vals[18]&255) << 8) | (uint32(vals[19]&1) << 16)
+	dst[9] = (uint32(vals[19]&254) >> 1) | (uint32(vals[20]&255) << 7) | (uint32(vals[21]&3) << 15)
+	dst[10] = (uint32(vals[21]&252) >> 2) | (uint32(vals[22]&255) << 6) | (uint32(vals[23]&7) << 14)
+	dst[11] = (uint32(vals[23]&248) >> 3) | (uint32(vals[24]&255) << 5) | (uint32(vals[25]&15) << 13)
+	dst[12] = (uint32(vals[25]&240) >> 4) | (uint32(vals[26]&255) << 4) | (uint32(vals[27]&31) << 12)
+	dst[13] = (uint32(vals[27]&224) >> 5) | (uint32(vals[28]&255) << 3) | (uint32(vals[29]&63) << 11)
+	dst[14] = (uint32(vals[29]&192) >> 6) | (uint32(vals[30]&255) << 2) | (uint32(vals[31]&127) << 10)
+	dst[15] = (uint32(vals[31]&128) >> 7) | (uint32(vals[32]&255) << 1) | (uint32(vals[33]&255) << 9)
+	dst[16] = (uint32(vals[34]&255) >> 0) | (uint32(vals[35]&255) << 8) | (uint32(vals[36]&1) << 16)
+	dst[17] = (uint32(vals[36]&254) >> 1) | (uint32(vals[37]&255) << 7) | (uint32(vals[38]&3) << 15)
+	dst[18] = (uint32(vals[38]&252) >> 2) | (uint32(vals[39]&255) << 6) | (uint32(vals[40]&7) << 14)
+	dst[19] = (uint32(vals[40]&248) >> 3) | (uint32(vals[41]&255) << 5) | (uint32(vals[42]&15) << 13)
+	dst[20] = (uint32(vals[42]&240) >> 4) | (uint32(vals[43]&255) << 4) | (uint32(vals[44]&31) << 12)
+	dst[21] = (uint32(vals[44]&224) >> 5) | (uint32(vals[45]&255) << 3) | (uint32(vals[46]&63) << 11)
+	dst[22] = (uint32(vals[46]&192) >> 6) | (uint32(vals[47]&255) << 2) | (uint32(vals[48]&127) << 10)
+	dst[23] = (uint32(vals[48]&128) >> 7) | (uint32(vals[49]&255) << 1) | (uint32(vals[50]&255) << 9)
+	dst[24] = (uint32(vals[51]&255) >> 0) | (uint32(vals[52]&255) << 8) | (uint32(vals[53]&1) << 16)
+	dst[25] = (uint32(vals[53]&254) >> 1) | (uint32(vals[54]&255) << 7) | (uint32(vals[55]&3) << 15)
+	dst[26] = (uint32(vals[55]&252) >> 2) | (uint32(vals[56]&255) << 6) | (uint32(vals[57]&7) << 14)
+	dst[27] = (uint32(vals[57]&248) >> 3) | (uint32(vals[58]&255) << 5) | (uint32(vals[59]&15) << 13)
+	dst[28] = (uint32(vals[59]&240) >> 4) | (uint32(vals[60]&255) << 4) | (uint32(vals[61]&31) << 12)
+	dst[29] = (uint32(vals[61]&224) >> 5) | (uint32(vals[62]&255) << 3) | (uint32(vals[63]&63) << 11)
+	dst[30] = (uint32(vals[63]&192) >> 6) | (uint32(vals[64]&255) << 2) | (uint32(vals[65]&127) << 10)
+	dst[31] = (uint32(vals[65]&128) >> 7) | (uint32(vals[66]&255) << 1) | (uint32(vals[67]&255) << 9)
+}
+
+func unpack18(dst []uint32, vals []byte) {
+	_ = vals[17]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&3) << 16)
+	dst[1] = (uint32(vals[2]&252) >> 2) | (uint32(vals[3]&255) << 6) | (uint32(vals[4]&15) << 14)
+	dst[2] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&255) << 4) | (uint32(vals[6]&63) << 12)
+	dst[3] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&255) << 2) | (uint32(vals[8]&255) << 10)
+	dst[4] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&255) << 8) | (uint32(vals[11]&3) << 16)
+	dst[5] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&15) << 14)
+	dst[6] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&255) << 4) | (uint32(vals[15]&63) << 12)
+	dst[7] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&255) << 2) | (uint32(vals[17]&255) << 10)
+}
+
+func unpack32x18(dst []uint32, vals []byte) {
+	_ = vals[71]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&3) << 16)
+	dst[1] = (uint32(vals[2]&252) >> 2) | (uint32(vals[3]&255) << 6) | (uint32(vals[4]&15) << 14)
+	dst[2] = (uint32(vals[4]&240) >> 4) | (uint32(vals[5]&255) << 4) | (uint32(vals[6]&63) << 12)
+	dst[3] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&255) << 2) | (uint32(vals[8]&255) << 10)
+	dst[4] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&255) << 8) | (uint32(vals[11]&3) << 16)
+	dst[5] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&15) << 14)
+	dst[6] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&255) << 4) | (uint32(vals[15]&63) << 12)
+	dst[7] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&255) << 2) | (uint32(vals[17]&255) << 10)
+	dst[8] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&255) << 8) | (uint32(vals[20]&3) << 16)
+	dst[9] = (uint32(vals[20]&252) >> 2) | (uint32(vals[21]&255) << 6) | (uint32(vals[22]&15) << 14)
+	dst[10] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4) | (uint32(vals[24]&63) << 12)
+	dst[11] = (uint32(vals[24]&192) >> 6) | (uint32(vals[25]&255) << 2) | (uint32(vals[26]&255) << 10)
+	dst[12] = (uint32(vals[27]&255) >> 0) | (uint32(vals[28]&255) << 8) | (uint32(vals[29]&3) << 16)
+	dst[13] = (uint32(vals[29]&252) >> 2) | (uint32(vals[30]&255) << 6) | (uint32(vals[31]&15) << 14)
+	dst[14] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&255) << 4) | (uint32(vals[33]&63) << 12)
+	dst[15] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&255) << 2) | (uint32(vals[35]&255) << 10)
+	dst[16] = (uint32(vals[36]&255) >> 0) | (uint32(vals[37]&255) << 8) | (uint32(vals[38]&3) << 16)
+	dst[17] = (uint32(vals[38]&252) >> 2) | (uint32(vals[39]&255) << 6) | (uint32(vals[40]&15) << 14)
+	dst[18] = (uint32(vals[40]&240) >> 4) | (uint32(vals[41]&255) << 4) | (uint32(vals[42]&63) << 12)
+	dst[19] = (uint32(vals[42]&192) >> 6) | (uint32(vals[43]&255) << 2) | (uint32(vals[44]&255) << 10)
+	dst[20] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&255) << 8) | (uint32(vals[47]&3) << 16)
+	dst[21] = (uint32(vals[47]&252) >> 2) | (uint32(vals[48]&255) << 6) | (uint32(vals[49]&15) << 14)
+	dst[22] = (uint32(vals[49]&240) >> 4) | (uint32(vals[50]&255) << 4) | (uint32(vals[51]&63) << 12)
+	dst[23] = (uint32(vals[51]&192) >> 6) | (uint32(vals[52]&255) << 2) | (uint32(vals[53]&255) << 10)
+	dst[24] = (uint32(vals[54]&255) >> 0) | (uint32(vals[55]&255) << 8) | (uint32(vals[56]&3) << 16)
+	dst[25] = (uint32(vals[56]&252) >> 2) | (uint32(vals[57]&255) << 6) | (uint32(vals[58]&15) << 14)
+	dst[26] = (uint32(vals[58]&240) >> 4) | (uint32(vals[59]&255) << 4) | (uint32(vals[60]&63) << 12)
+	dst[27] = (uint32(vals[60]&192) >> 6) | (uint32(vals[61]&255) << 2) | (uint32(vals[62]&255) << 10)
+	dst[28] = (uint32(vals[63]&255) >> 0) | (uint32(vals[64]&255) << 8) | (uint32(vals[65]&3) << 16)
+	dst[29] = (uint32(vals[65]&252) >> 2) | (uint32(vals[66]&255) << 6) | (uint32(vals[67]&15) << 14)
+	dst[30] = (uint32(vals[67]&240) >> 4) | (uint32(vals[68]&255) << 4) | (uint32(vals[69]&63) << 12)
+	dst[31] = (uint32(vals[69]&192) >> 6) | (uint32(vals[70]&255) << 2) | (uint32(vals[71]&255) << 10)
+}
+
+func unpack19(dst []uint32, vals []byte) {
+	_ = vals[18]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&7) << 16)
+	dst[1] = (uint32(vals[2]&248) >> 3) | (uint32(vals[3]&255) << 5) | (uint32(vals[4]&63) << 13)
+	dst[2] = (uint32(vals[4]&192) >> 6) | (uint32(vals[5]&255) << 2) | (uint32(vals[6]&255) << 10) | (uint32(vals[7]&1) << 18)
+	dst[3] = (uint32(vals[7]&254) >> 1) | (uint32(vals[8]&255) << 7) | (uint32(vals[9]&15) << 15)
+	dst[4] = (uint32(vals[9]&240) >> 4) | (uint32(vals[10]&255) << 4) | (uint32(vals[11]&127) << 12)
+	dst[5] = (uint32(vals[11]&128) >> 7) | (uint32(vals[12]&255) << 1) | (uint32(vals[13]&255) << 9) | (uint32(vals[14]&3) << 17)
+	dst[6] = (uint32(vals[14]&252) >> 2) | (uint32(vals[15]&255) << 6) | (uint32(vals[16]&31) << 14)
+	dst[7] = (uint32(vals[16]&224) >> 5) | (uint32(vals[17]&255) << 3) | (uint32(vals[18]&255) << 11)
+}
+
+func unpack32x19(dst []uint32, vals []byte) {
+	_ = vals[75]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&7) << 16)
+	dst[1] = (uint32(vals[2]&248) >> 3) | (uint32(vals[3]&255) << 5) | (uint32(vals[4]&63) << 13)
+	dst[2] = (uint32(vals[4]&192) >> 6) | (uint32(vals[5]&255) << 2) | (uint32(vals[6]&255) << 10) | (uint32(vals[7]&1) << 18)
+	dst[3] = (uint32(vals[7]&254) >> 1) | (uint32(vals[8]&255) << 7) | (uint32(vals[9]&15) << 15)
+	dst[4] = (uint32(vals[9]&240) >> 4) | (uint32(vals[10]&255) << 4) | (uint32(vals[11]&127) << 12)
+	dst[5] = (uint32(vals[11]&128) >> 7) | (uint32(vals[12]&255) << 1) | (uint32(vals[13]&255) << 9) | (uint32(vals[14]&3) << 17)
+	dst[6] = (uint32(vals[14]&252) >> 2) | (uint32(vals[15]&255) << 6) | (uint32(vals[16]&31) << 14)
+	dst[7] = (uint32(vals[16]&224) >> 5) | (uint32(vals[17]&255) << 3) | (uint32(vals[18]&255) << 11)
+	dst[8] = (uint32(vals[19]&255) >> 0) | (uint32(vals[20]&255) << 8) | (uint32(vals[21]&7) << 16)
+	dst[9] = (uint32(vals[21]&248) >> 3) | (uint32(vals[22]&255) << 5) | (uint32(vals[23]&63) << 13)
+	dst[10] = (uint32(vals[23]&192) >> 6) | (uint32(vals[24]&255) << 2) | (uint32(vals[25]&255) << 10) | (uint32(vals[26]&1) << 18)
+	dst[11] = (uint32(vals[26]&254) >> 1) | (uint32(vals[27]&255) << 7) | (uint32(vals[28]&15) << 15)
+	dst[12] = (uint32(vals[28]&240) >> 4) | (uint32(vals[29]&255) << 4) | (uint32(vals[30]&127) << 12)
+	dst[13] = (uint32(vals[30]&128) >> 7) | (uint32(vals[31]&255) << 1) | (uint32(vals[32]&255) << 9) | (uint32(vals[33]&3) << 17)
+	dst[14] = (uint32(vals[33]&252) >> 2) | (uint32(vals[34]&255) << 6) | (uint32(vals[35]&31) << 14)
+	dst[15] = (uint32(vals[35]&224) >> 5) | (uint32(vals[36]&255) << 3) | (uint32(vals[37]&255) << 11)
+	dst[16] = (uint32(vals[38]&255) >> 0) | (uint32(vals[39]&255) << 8) | (uint32(vals[40]&7) << 16)
+	dst[17] = (uint32(vals[40]&248) >> 3) | (uint32(vals[41]&255) << 5) | (uint32(vals[42]&63) << 13)
+	dst[18] = (uint32(vals[42]&192) >> 6) | (uint32(vals[43]&255) << 2) | (uint32(vals[44]&255) << 10) | (uint32(vals[45]&1) << 18)
+	dst[19] = (uint32(vals[45]&254) >> 1) | (uint32(vals[46]&255) << 7) | (uint32(vals[47]&15) << 15)
+	dst[20] = (uint32(vals[47]&240) >> 4) | (uint32(vals[48]&255) << 4) | (uint32(vals[49]&127) << 12)
+	dst[21] = (uint32(vals[49]&128) >> 7) | (uint32(vals[50]&255) << 1) | (uint32(vals[51]&255) << 9) | (uint32(vals[52]&3) << 17)
+	dst[22] = (uint32(vals[52]&252) >> 2) | (uint32(vals[53]&255) << 6) | (uint32(vals[54]&31) << 14)
+	dst[23] = (uint32(vals[54]&224) >> 5) | (uint32(vals[55]&255) << 3) | (uint32(vals[56]&255) << 11)
+	dst[24] = (uint32(vals[57]&255) >> 0) | (uint32(vals[58]&255) << 8) | (uint32(vals[59]&7) << 16)
+	dst[25] = (uint32(vals[59]&248) >> 3) | (uint32(vals[60]&255) << 5) | (uint32(vals[61]&63) << 13)
+	dst[26] = (uint32(vals[61]&192) >> 6) | (uint32(vals[62]&255) << 2) | (uint32(vals[63]&255) << 10) | (uint32(vals[64]&1) << 18)
+	dst[27] = (uint32(vals[64]&254) >> 1) | (uint32(vals[65]&255) << 7) | (uint32(vals[66]&15) << 15)
+	dst[28] = (uint32(vals[66]&240) >> 4) | (uint32(vals[67]&255) << 4) | (uint32(vals[68]&127) << 12)
+	dst[29] = (uint32(vals[68]&128) >> 7) | (uint32(vals[69]&255) << 1) | (uint32(vals[70]&255) << 9) | (uint32(vals[71]&3) << 17)
+	dst[30] = (uint32(vals[71]&252) >> 2) | (uint32(vals[72]&255) << 6) | (uint32(vals[73]&31) << 14)
+	dst[31] = (uint32(vals[73]&224) >> 5) | (uint32(vals[74]&255) << 3) | (uint32(vals[75]&255) << 11)
+}
+
+func unpack20(dst []uint32, vals []byte) {
+	_ = vals[19]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&15) << 16)
+	dst[1] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&255) << 4) | (uint32(vals[4]&255) << 12)
+	dst[2] = (uint32(vals[5]&255) >> 0) | (uint32(vals[6]&255) << 8) | (uint32(vals[7]&15) << 16)
+	dst[3] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&255) << 12)
+	dst[4] = (uint32(vals[10]&255) >> 0) | (uint32(vals[11]&255) << 8) | (uint32(vals[12]&15) << 16)
+	dst[5] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&255) << 4) | (uint32(vals[14]&255) << 12)
+	dst[6] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&15) << 16)
+	dst[7] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&255) << 4) | (uint32(vals[19]&255) << 12)
+}
+
+func unpack32x20(dst []uint32, vals []byte) {
+	_ = vals[79]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&15) << 16)
+	dst[1] = (uint32(vals[2]&240) >> 4) | (uint32(vals[3]&255) << 4) | (uint32(vals[4]&255) << 12)
+	dst[2] = (uint32(vals[5]&255) >> 0) | (uint32(vals[6]&255) << 8) | (uint32(vals[7]&15) << 16)
+	dst[3] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&255) << 12)
+	dst[4] = (uint32(vals[10]&255) >> 0) | (uint32(vals[11]&255) << 8) | (uint32(vals[12]&15) << 16)
+	dst[5] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&255) << 4) | (uint32(vals[14]&255) << 12)
+	dst[6] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&15) << 16)
+	dst[7] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&255) << 4) | (uint32(vals[19]&255) << 12)
+	dst[8] = (uint32(vals[20]&255) >> 0) | (uint32(vals[21]&255) << 8) | (uint32(vals[22]&15) << 16)
+	dst[9] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4) | (uint32(vals[24]&255) << 12)
+	dst[10] = (uint32(vals[25]&255) >> 0) | (uint32(vals[26]&255) << 8) | (uint32(vals[27]&15) << 16)
+	dst[11] = (uint32(vals[27]&240) >> 4) | (uint32(vals[28]&255) << 4) | (uint32(vals[29]&255) << 12)
+	dst[12] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&255) << 8) | (uint32(vals[32]&15) << 16)
+	dst[13] = (uint32(vals[32]&240) >> 4) | (uint32(vals[33]&255) << 4) | (uint32(vals[34]&255) << 12)
+	dst[14] = (uint32(vals[35]&255) >> 0) | (uint32(vals[36]&255) << 8) | (uint32(vals[37]&15) << 16)
+	dst[15] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&255) << 4) | (uint32(vals[39]&255) << 12)
+	dst[16] = (uint32(vals[40]&255) >> 0) | (uint32(vals[41]&255) << 8) | (uint32(vals[42]&15) << 16)
+	dst[17] = (uint32(vals[42]&240) >> 4) | (uint32(vals[43]&255) << 4) | (uint32(vals[44]&255) << 12)
+	dst[18] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&255) << 8) | (uint32(vals[47]&15) << 16)
+	dst[19] = (uint32(vals[47]&240) >> 4) | (uint32(vals[48]&255) << 4) | (uint32(vals[49]&255) << 12)
+	dst[20] = (uint32(vals[50]&255) >> 0) | (uint32(vals[51]&255) << 8) | (uint32(vals[52]&15) << 16)
+	dst[21] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&255) << 12)
+	dst[22] = (uint32(vals[55]&255) >> 0) | (uint32(vals[56]&255) << 8) | (uint32(vals[57]&15) << 16)
+	dst[23] = (uint32(vals[57]&240) >> 4) | (uint32(vals[58]&255) << 4) | (uint32(vals[59]&255) << 12)
+	dst[24] = (uint32(vals[60]&255) >> 0) | (uint32(vals[61]&255) << 8) | (uint32(vals[62]&15) << 16)
+	dst[25] = (uint32(vals[62]&240) >> 4) | (uint32(vals[63]&255) << 4) | (uint32(vals[64]&255) << 12)
+	dst[26] = (uint32(vals[65]&255) >> 0) | (uint32(vals[66]&255) << 8) | (uint32(vals[67]&15) << 16)
+	dst[27] = (uint32(vals[67]&240) >> 4) | (uint32(vals[68]&255) << 4) | (uint32(vals[69]&255) << 12)
+	dst[28] = (uint32(vals[70]&255) >> 0) | (uint32(vals[71]&255) << 8) | (uint32(vals[72]&15) << 16)
+	dst[29] = (uint32(vals[72]&240) >> 4) | (uint32(vals[73]&255) << 4) | (uint32(vals[74]&255) << 12)
+	dst[30] = (uint32(vals[75]&255) >> 0) | (uint32(vals[76]&255) << 8) | (uint32(vals[77]&15) << 16)
+	dst[31] = (uint32(vals[77]&240) >> 4) | (uint32(vals[78]&255) << 4) | (uint32(vals[79]&255) << 12)
+}
+
+func unpack21(dst []uint32, vals []byte) {
+	_ = vals[20]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&31) << 16)
+	dst[1] = (uint32(vals[2]&224) >> 5) | (uint32(vals[3]&255) << 3) | (uint32(vals[4]&255) << 11) | (uint32(vals[5]&3) << 19)
+	dst[2] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&255) << 6) | (uint32(vals[7]&127) << 14)
+	dst[3] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&255) << 1) | (uint32(vals[9]&255) << 9) | (uint32(vals[10]&15) << 17)
+	dst[4] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&255) << 12) | (uint32(vals[13]&1) << 20)
+	dst[5] = (uint32(vals[13]&254) >> 1) | (uint32(vals[14]&255) << 7) | (uint32(vals[15]&63) << 15)
+	dst[6] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&255) << 2) | (uint32(vals[17]&255) << 10) | (uint32(vals[18]&7) << 18)
+	dst[7] = (uint32(vals[18]&248) >> 3) | (uint32(vals[19]&255) << 5) | (uint32(vals[20]&255) << 13)
+}
+
+func unpack32x21(dst []uint32, vals []byte) {
+	_ = vals[83]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&31) << 16)
+	dst[1] = (uint32(vals[2]&224) >> 5) | (uint32(vals[3]&255) << 3) | (uint32(vals[4]&255) << 11) | (uint32(vals[5]&3) << 19)
+	dst[2] = (uint32(vals[5]&252) >> 2) | (uint32(vals[6]&255) << 6) | (uint32(vals[7]&127) << 14)
+	dst[3] = (uint32(vals[7]&128) >> 7) | (uint32(vals[8]&255) << 1) | (uint32(vals[9]&255) << 9) | (uint32(vals[10]&15) << 17)
+	dst[4] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&255) << 12) | (uint32(vals[13]&1) << 20)
+	dst[5] = (uint32(vals[13]&254) >> 1) | (uint32(vals[14]&255) << 7) | (uint32(vals[15]&63) << 15)
+	dst[6] = (uint32(vals[15]&192) >> 6) | (uint32(vals[16]&255) << 2) | (uint32(vals[17]&255) << 10) | (uint32(vals[18]&7) << 18)
+	dst[7] = (uint32(vals[18]&248) >> 3) | (uint32(vals[19]&255) << 5) | (uint32(vals[20]&255) << 13)
+	dst[8] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&255) << 8) | (uint32(vals[23]&31) << 16)
+	dst[9] = (uint32(vals[23]&224) >> 5) | (uint32(vals[24]&255) << 3) | (uint32(vals[25]&255) << 11) | (uint32(vals[26]&3) << 19)
+	dst[10] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&255) << 6) | (uint32(vals[28]&127) << 14)
+	dst[11] = (uint32(vals[28]&128) >> 7) | (uint32(vals[29]&255) << 1) | (uint32(vals[30]&255) << 9) | (uint32(vals[31]&15) << 17)
+	dst[12] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&255) << 4) | (uint32(vals[33]&255) << 12) | (uint32(vals[34]&1) << 20)
+	dst[13] = (uint32(vals[34]&254) >> 1) | (uint32(vals[35]&255) << 7) | (uint32(vals[36]&63) << 15)
+	dst[14] = (uint32(vals[36]&192) >> 6) | (uint32(vals[37]&255) << 2) | (uint32(vals[38]&255) << 10) | (uint32(vals[39]&7) << 18)
+	dst[15] = (uint32(vals[39]&248) >> 3) | (uint32(vals[40]&255) << 5) | (uint32(vals[41]&255) << 13)
+	dst[16] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&255) << 8) | (uint32(vals[44]&31) << 16)
+	dst[17] = (uint32(vals[44]&224) >> 5) | (uint32(vals[45]&255) << 3) | (uint32(vals[46]&255) << 11) | (uint32(vals[47]&3) << 19)
+	dst[18] = (uint32(vals[47]&252) >> 2) | (uint32(vals[48]&255) << 6) | (uint32(vals[49]&127) << 14)
+	dst[19] = (uint32(vals[49]&128) >> 7) | (uint32(vals[50]&255) << 1) | (uint32(vals[51]&255) << 9) | (uint32(vals[52]&15) << 17)
+	dst[20] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&255) << 12) | (uint32(vals[55]&1) << 20)
+	dst[21] = (uint32(vals[55]&254) >> 1) | (uint32(vals[56]&255) << 7) | (uint32(vals[57]&63) << 15)
+	dst[22] = (uint32(vals[57]&192) >> 6) | (uint32(vals[58]&255) << 2) | (uint32(vals[59]&255) << 10) | (uint32(vals[60]&7) << 18)
+	dst[23] = (uint32(vals[60]&248) >> 3) | (uint32(vals[61]&255) << 5) | (uint32(vals[62]&255) << 13)
+	dst[24] = (uint32(vals[63]&255) >> 0) | (uint32(vals[64]&255) << 8) | (uint32(vals[65]&31) << 16)
+	dst[25] = (uint32(vals[65]&224) >> 5) | (uint32(vals[66]&255) << 3) | (uint32(vals[67]&255) << 11) | (uint32(vals[68]&3) << 19)
+	dst[26] = (uint32(vals[68]&252) >> 2) | (uint32(vals[69]&255) << 6) | (uint32(vals[70]&127) << 14)
+	dst[27] = (uint32(vals[70]&128) >> 7) | (uint32(vals[71]&255) << 1) | (uint32(vals[72]&255) << 9) | (uint32(vals[73]&15) << 17)
+	dst[28] = (uint32(vals[73]&240) >> 4) | (uint32(vals[74]&255) << 4) | (uint32(vals[75]&255) << 12) | (uint32(vals[76]&1) << 20)
+	dst[29] = (uint32(vals[76]&254) >> 1) | (uint32(vals[77]&255) << 7) | (uint32(vals[78]&63) << 15)
+	dst[30] = (uint32(vals[78]&192) >> 6) | (uint32(vals[79]&255) << 2) | (uint32(vals[80]&255) << 10) | (uint32(vals[81]&7) << 18)
+	dst[31] = (uint32(vals[81]&248) >> 3) | (uint32(vals[82]&255) << 5) | (uint32(vals[83]&255) << 13)
+}
+
+func unpack22(dst []uint32, vals []byte) {
+	_ = vals[21]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&63) << 16)
+	dst[1] = (uint32(vals[2]&192) >> 6) | (uint32(vals[3]&255) << 2) | (uint32(vals[4]&255) << 10) | (uint32(vals[5]&15) << 18)
+	dst[2] = (uint32(vals[5]&240) >> 4) | (uint32(vals[6]&255) << 4) | (uint32(vals[7]&255) << 12) | (uint32(vals[8]&3) << 20)
+	dst[3] = (uint32(vals[8]&252) >> 2) | (uint32(vals[9]&255) << 6) | (uint32(vals[10]&255) << 14)
+	dst[4] = (uint32(vals[11]&255) >> 0) | (uint32(vals[12]&255) << 8) | (uint32(vals[13]&63) << 16)
+	dst[5] = (uint32(vals[13]&192) >> 6) | (uint32(vals[14]&255) << 2) | (uint32(vals[15]&255) << 10) | (uint32(vals[16]&15) << 18)
+	dst[6] = (uint32(vals[16]&240) >> 4) | (uint32(vals[17]&255) << 4) | (uint32(vals[18]&255) << 12) | (uint32(vals[19]&3) << 20)
+	dst[7] = (uint32(vals[19]&252) >> 2) | (uint32(vals[20]&255) << 6) | (uint32(vals[21]&255) << 14)
+}
+
+func unpack32x22(dst []uint32, vals []byte) {
+	_ = vals[87]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&63) << 16)
+	dst[1] = (uint32(vals[2]&192) >> 6) | (uint32(vals[3]&255) << 2) | (uint32(vals[4]&255) << 10) | (uint32(vals[5]&15) << 18)
+	dst[2] = (uint32(vals[5]&240) >> 4) | (uint32(vals[6]&255) << 4) | (uint32(vals[7]&255) << 12) | (uint32(vals[8]&3) << 20)
+	dst[3] = (uint32(vals[8]&252) >> 2) | (uint32(vals[9]&255) << 6) | (uint32(vals[10]&255) << 14)
+	dst[4] = (uint32(vals[11]&255) >> 0) | (uint32(vals[12]&255) << 8) | (uint32(vals[13]&63) << 16)
+	dst[5] = (uint32(vals[13]&192) >> 6) | (uint32(vals[14]&255) << 2) | (uint32(vals[15]&255) << 10) | (uint32(vals[16]&15) << 18)
+	dst[6] = (uint32(vals[16]&240) >> 4) | (uint32(vals[17]&255) << 4) | (uint32(vals[18]&255) << 12) | (uint32(vals[19]&3) << 20)
+	dst[7] = (uint32(vals[19]&252) >> 2) | (uint32(vals[20]&255) << 6) | (uint32(vals[21]&255) << 14)
+	dst[8] = (uint32(vals[22]&255) >> 0) | (uint32(vals[23]&255) << 8) | (uint32(vals[24]&63) << 16)
+	dst[9] = (uint32(vals[24]&192) >> 6) | (uint32(vals[25]&255) << 2) | (uint32(vals[26]&255) << 10) | (uint32(vals[27]&15) << 18)
+	dst[10] = (uint32(vals[27]&240) >> 4) | (uint32(vals[28]&255) << 4) | (uint32(vals[29]&255) << 12) | (uint32(vals[30]&3) << 20)
+	dst[11] = (uint32(vals[30]&252) >> 2) | (uint32(vals[31]&255) << 6) | (uint32(vals[32]&255) << 14)
+	dst[12] = (uint32(vals[33]&255) >> 0) | (uint32(vals[34]&255) << 8) | (uint32(vals[35]&63) << 16)
+	dst[13] = (uint32(vals[35]&192) >> 6) | (uint32(vals[36]&255) << 2) | (uint32(vals[37]&255) << 10) | (uint32(vals[38]&15) << 18)
+	dst[14] = (uint32(vals[38]&240) >> 4) | (uint32(vals[39]&255) << 4) | (uint32(vals[40]&255) << 12) | (uint32(vals[41]&3) << 20)
+	dst[15] = (uint32(vals[41]&252) >> 2) | (uint32(vals[42]&255) << 6) | (uint32(vals[43]&255) << 14)
+	dst[16] = (uint32(vals[44]&255) >> 0) | (uint32(vals[45]&255) << 8) | (uint32(vals[46]&63) << 16)
+	dst[17] = (uint32(vals[46]&192) >> 6) | (uint32(vals[47]&255) << 2) | (uint32(vals[48]&255) << 10) | (uint32(vals[49]&15) << 18)
+	dst[18] = (uint32(vals[49]&240) >> 4) | (uint32(vals[50]&255) << 4) | (uint32(vals[51]&255) << 12) | (uint32(vals[52]&3) << 20)
+	dst[19] = (uint32(vals[52]&252) >> 2) | (uint32(vals[53]&255) << 6) | (uint32(vals[54]&255) << 14)
+	dst[20] = (uint32(vals[55]&255) >> 0) | (uint32(vals[56]&255) << 8) | (uint32(vals[57]&63) << 16)
+	dst[21] = (uint32(vals[57]&192) >> 6) | (uint32(vals[58]&255) << 2) | (uint32(vals[59]&255) << 10) | (uint32(vals[60]&15) << 18)
+	dst[22] = (uint32(vals[60]&240) >> 4) | (uint32(vals[61]&255) << 4) | (uint32(vals[62]&255) << 12) | (uint32(vals[63]&3) << 20)
+	dst[23] = (uint32(vals[63]&252) >> 2) | (uint32(vals[64]&255) << 6) | (uint32(vals[65]&255) << 14)
+	dst[24] = (uint32(vals[66]&255) >> 0) | (uint32(vals[67]&255) << 8) | (uint32(vals[68]&63) << 16)
+	dst[25] = (uint32(vals[68]&192) >> 6) | (uint32(vals[69]&255) << 2) | (uint32(vals[70]&255) << 10) | (uint32(vals[71]&15) << 18)
+	dst[26] = (uint32(vals[71]&240) >> 4) | (uint32(vals[72]&255) << 4) | (uint32(vals[73]&255) << 12) | (uint32(vals[74]&3) << 20)
+	dst[27] = (uint32(vals[74]&252) >> 2) | (uint32(vals[75]&255) << 6) | (uint32(vals[76]&255) << 14)
+	dst[28] = (uint32(vals[77]&255) >> 0) | (uint32(vals[78]&255) << 8) | (uint32(vals[79]&63) << 16)
+	dst[29] = (uint32(vals[79]&192) >> 6) | (uint32(vals[80]&255) << 2) | (uint32(vals[81]&255) << 10) | (uint32(vals[82]&15) << 18)
+	dst[30] = (uint32(vals[82]&240) >> 4) | (uint32(vals[83]&255) << 4) | (uint32(vals[84]&255) << 12) | (uint32(vals[85]&3) << 20)
+	dst[31] = (uint32(vals[85]&252) >> 2) | (uint32(vals[86]&255) << 6) | (uint32(vals[87]&255) << 14)
+}
+
+func unpack23(dst []uint32, vals []byte) {
+	_ = vals[22]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&127) << 16)
+	dst[1] = (uint32(vals[2]&128) >> 7) | (uint32(vals[3]&255) << 1) | (uint32(vals[4]&255) << 9) | (uint32(vals[5]&63) << 17)
+	dst[2] = (uint32(vals[5]&192) >> 6) | (uint32(vals[6]&255) << 2) | (uint32(vals[7]&255) << 10) | (uint32(vals[8]&31) << 18)
+	dst[3] = (uint32(vals[8]&224) >> 5) | (uint32(vals[9]&255) << 3) | (uint32(vals[10]&255) << 11) | (uint32(vals[11]&15) << 19)
+	dst[4] = (uint32(vals[11]&240) >> 4) | (uint32(vals[12]&255) << 4) | (uint32(vals[13]&255) << 12) | (uint32(vals[14]&7) << 20)
+	dst[5] = (uint32(vals[14]&248) >> 3) | (uint32(vals[15]&255) << 5) | (uint32(vals[16]&255) << 13) | (uint32(vals[17]&3) << 21)
+	dst[6] = (uint32(vals[17]&252) >> 2) | (uint32(vals[18]&255) << 6) | (uint32(vals[19]&255) << 14) | (uint32(vals[20]&1) << 22)
+	dst[7] = (uint32(vals[20]&254) >> 1) | (uint32(vals[21]&255) << 7) | (uint32(vals[22]&255) << 15)
+}
+
+func unpack32x23(dst []uint32, vals []byte) {
+	_ = vals[91]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&127) << 16)
+	dst[1] = (uint32(vals[2]&128) >> 7) | (uint32(vals[3]&255) << 1) | (uint32(vals[4]&255) << 9) | (uint32(vals[5]&63) << 17)
+	dst[2] = (uint32(vals[5]&192) >> 6) | (uint32(vals[6]&255) << 2) | (uint32(vals[7]&255) << 10) | (uint32(vals[8]&31) << 18)
+	dst[3] = (uint32(vals[8]&224) >> 5) | (uint32(vals[9]&255) << 3) | (uint32(vals[10]&255) << 11) | (uint32(vals[11]&15) << 19)
+	dst[4] = (uint32(vals[11]&240) >> 4) | (uint32(vals[12]&255) << 4) | (uint32(vals[13]&255) << 12) | (uint32(vals[14]&7) << 20)
+	dst[5] = (uint32(vals[14]&248) >> 3) | (uint32(vals[15]&255) << 5) | (uint32(vals[16]&255) << 13) | (uint32(vals[17]&3) << 21)
+	dst[6] = (uint32(vals[17]&252) >> 2) | (uint32(vals[18]&255) << 6) | (uint32(vals[19]&255) << 14) | (uint32(vals[20]&1) << 22)
+	dst[7] = (uint32(vals[20]&254) >> 1) | (uint32(vals[21]&255) << 7) | (uint32(vals[22]&255) << 15)
+	dst[8] = (uint32(vals[23]&255) >> 0) | (uint32(vals[24]&255) << 8) | (uint32(vals[25]&127) << 16)
+	dst[9] = (uint32(vals[25]&128) >> 7) | (uint32(vals[26]&255) << 1) | (uint32(vals[27]&255) << 9) | (uint32(vals[28]&63) << 17)
+	dst[10] = (uint32(vals[28]&192) >> 6) | (uint32(vals[29]&255) << 2) | (uint32(vals[30]&255) << 10) | (uint32(vals[31]&31) << 18)
+	dst[11] = (uint32(vals[31]&224) >> 5) | (uint32(vals[32]&255) << 3) | (uint32(vals[33]&255) << 11) | (uint32(vals[34]&15) << 19)
+	dst[12] = (uint32(vals[34]&240) >> 4) | (uint32(vals[35]&255) << 4) | (uint32(vals[36]&255) << 12) | (uint32(vals[37]&7) << 20)
+	dst[13] = (uint32(vals[37]&248) >> 3) | (uint32(vals[38]&255) << 5) | (uint32(vals[39]&255) << 13) | (uint32(vals[40]&3) << 21)
+	dst[14] = (uint32(vals[40]&252) >> 2) | (uint32(vals[41]&255) << 6) | (uint32(vals[42]&255) << 14) | (uint32(vals[43]&1) << 22)
+	dst[15] = (uint32(vals[43]&254) >> 1) | (uint32(vals[44]&255) << 7) | (uint32(vals[45]&255) << 15)
+	dst[16] = (uint32(vals[46]&255) >> 0) | (uint32(vals[47]&255) << 8) | (uint32(vals[48]&127) << 16)
+	dst[17] = (uint32(vals[48]&128) >> 7) | (uint32(vals[49]&255) << 1) | (uint32(vals[50]&255) << 9) | (uint32(vals[51]&63) << 17)
+	dst[18] = (uint32(vals[51]&192) >> 6) | (uint32(vals[52]&255) << 2) | (uint32(vals[53]&255) << 10) | (uint32(vals[54]&31) << 18)
+	dst[19] = (uint32(vals[54]&224) >> 5) | (uint32(vals[55]&255) << 3) | (uint32(vals[56]&255) << 11) | (uint32(vals[57]&15) << 19)
+	dst[20] = (uint32(vals[57]&240) >> 4) | (uint32(vals[58]&255) << 4) | (uint32(vals[59]&255) << 12) | (uint32(vals[60]&7) << 20)
+	dst[21] = (uint32(vals[60]&248) >> 3) | (uint32(vals[61]&255) << 5) | (uint32(vals[62]&255) << 13) | (uint32(vals[63]&3) << 21)
+	dst[22] = (uint32(vals[63]&252) >> 2) | (uint32(vals[64]&255) << 6) | (uint32(vals[65]&255) << 14) | (uint32(vals[66]&1) << 22)
+	dst[23] = (uint32(vals[66]&254) >> 1) | (uint32(vals[67]&255) << 7) | (uint32(vals[68]&255) << 15)
+	dst[24] = (uint32(vals[69]&255) >> 0) | (uint32(vals[70]&255) << 8) | (uint32(vals[71]&127) << 16)
+	dst[25] = (uint32(vals[71]&128) >> 7) | (uint32(vals[72]&255) << 1) | (uint32(vals[73]&255) << 9) | (uint32(vals[74]&63) << 17)
+	dst[26] = (uint32(vals[74]&192) >> 6) | (uint32(vals[75]&255) << 2) | (uint32(vals[76]&255) << 10) | (uint32(vals[77]&31) << 18)
+	dst[27] = (uint32(vals[77]&224) >> 5) | (uint32(vals[78]&255) << 3) | (uint32(vals[79]&255) << 11) | (uint32(vals[80]&15) << 19)
+	dst[28] = (uint32(vals[80]&240) >> 4) | (uint32(vals[81]&255) << 4) | (uint32(vals[82]&255) << 12) | (uint32(vals[83]&7) << 20)
+	dst[29] = (uint32(vals[83]&248) >> 3) | (uint32(vals[84]&255) << 5) | (uint32(vals[85]&255) << 13) | (uint32(vals[86]&3) << 21)
+	dst[30] = (uint32(vals[86]&252) >> 2) | (uint32(vals[87]&255) << 6) | (uint32(vals[88]&255) << 14) | (uint32(vals[89]&1) << 22)
+	dst[31] = (uint32(vals[89]&254) >> 1) | (uint32(vals[90]&255) << 7) | (uint32(vals[91]&255) << 15)
+}
+
+func unpack24(dst []uint32, vals []byte) {
+	_ = vals[23]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16)
+	dst[1] = (uint32(vals[3]&255) >> 0) | (uint32(vals[4]&255) << 8) | (uint32(vals[5]&255) << 16)
+	dst[2] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&255) << 8) | (uint32(vals[8]&255) << 16)
+	dst[3] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&255) << 8) | (uint32(vals[11]&255) << 16)
+	dst[4] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8) | (uint32(vals[14]&255) << 16)
+	dst[5] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&255) << 16)
+	dst[6] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&255) << 8) | (uint32(vals[20]&255) << 16)
+	dst[7] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&255) << 8) | (uint32(vals[23]&255) << 16)
+}
+
+func unpack32x24(dst []uint32, vals []byte) {
+	_ = vals[95]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16)
+	dst[1] = (uint32(vals[3]&255) >> 0) | (uint32(vals[4]&255) << 8) | (uint32(vals[5]&255) << 16)
+	dst[2] = (uint32(vals[6]&255) >> 0) | (uint32(vals[7]&255) << 8) | (uint32(vals[8]&255) << 16)
+	dst[3] = (uint32(vals[9]&255) >> 0) | (uint32(vals[10]&255) << 8) | (uint32(vals[11]&255) << 16)
+	dst[4] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8) | (uint32(vals[14]&255) << 16)
+	dst[5] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&255) << 16)
+	dst[6] = (uint32(vals[18]&255) >> 0) | (uint32(vals[19]&255) << 8) | (uint32(vals[20]&255) << 16)
+	dst[7] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&255) << 8) | (uint32(vals[23]&255) << 16)
+	dst[8] = (uint32(vals[24]&255) >> 0) | (uint32(vals[25]&255) << 8) | (uint32(vals[26]&255) << 16)
+	dst[9] = (uint32(vals[27]&255) >> 0) | (uint32(vals[28]&255) << 8) | (uint32(vals[29]&255) << 16)
+	dst[10] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&255) << 8) | (uint32(vals[32]&255) << 16)
+	dst[11] = (uint32(vals[33]&255) >> 0) | (uint32(vals[34]&255) << 8) | (uint32(vals[35]&255) << 16)
+	dst[12] = (uint32(vals[36]&255) >> 0) | (uint32(vals[37]&255) << 8) | (uint32(vals[38]&255) << 16)
+	dst[13] = (uint32(vals[39]&255) >> 0) | (uint32(vals[40]&255) << 8) | (uint32(vals[41]&255) << 16)
+	dst[14] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&255) << 8) | (uint32(vals[44]&255) << 16)
+	dst[15] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&255) << 8) | (uint32(vals[47]&255) << 16)
+	dst[16] = (uint32(vals[48]&255) >> 0) | (uint32(vals[49]&255) << 8) | (uint32(vals[50]&255) << 16)
+	dst[17] = (uint32(vals[51]&255) >> 0) | (uint32(vals[52]&255) << 8) | (uint32(vals[53]&255) << 16)
+	dst[18] = (uint32(vals[54]&255) >> 0) | (uint32(vals[55]&255) << 8) | (uint32(vals[56]&255) << 16)
+	dst[19] = (uint32(vals[57]&255) >> 0) | (uint32(vals[58]&255) << 8) | (uint32(vals[59]&255) << 16)
+	dst[20] = (uint32(vals[60]&255) >> 0) | (uint32(vals[61]&255) << 8) | (uint32(vals[62]&255) << 16)
+	dst[21] = (uint32(vals[63]&255) >> 0) | (uint32(vals[64]&255) << 8) | (uint32(vals[65]&255) << 16)
+	dst[22] = (uint32(vals[66]&255) >> 0) | (uint32(vals[67]&255) << 8) | (uint32(vals[68]&255) << 16)
+	dst[23] = (uint32(vals[69]&255) >> 0) | (uint32(vals[70]&255) << 8) | (uint32(vals[71]&255) << 16)
+	dst[24] = (uint32(vals[72]&255) >> 0) | (uint32(vals[73]&255) << 8) | (uint32(vals[74]&255) << 16)
+	dst[25] = (uint32(vals[75]&255) >> 0) | (uint32(vals[76]&255) << 8) | (uint32(vals[77]&255) << 16)
+	dst[26] = (uint32(vals[78]&255) >> 0) | (uint32(vals[79]&255) << 8) | (uint32(vals[80]&255) << 16)
+	dst[27] = (uint32(vals[81]&255) >> 0) | (uint32(vals[82]&255) << 8) | (uint32(vals[83]&255) << 16)
+	dst[28] = (uint32(vals[84]&255) >> 0) | (uint32(vals[85]&255) << 8) | (uint32(vals[86]&255) << 16)
+	dst[29] = (uint32(vals[87]&255) >> 0) | (uint32(vals[88]&255) << 8) | (uint32(vals[89]&255) << 16)
+	dst[30] = (uint32(vals[90]&255) >> 0) | (uint32(vals[91]&255) << 8) | (uint32(vals[92]&255) << 16)
+	dst[31] = (uint32(vals[93]&255) >> 0) | (uint32(vals[94]&255) << 8) | (uint32(vals[95]&255) << 16)
+}
+
+func unpack25(dst []uint32, vals []byte) {
+	_ = vals[24]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&1) << 24)
+	dst[1] = (uint32(vals[3]&254) >> 1) | (uint32(vals[4]&255) << 7) | (uint32(vals[5]&255) << 15) | (uint32(vals[6]&3) << 23)
+	dst[2] = (uint32(vals[6]&252) >> 2) | (uint32(vals[7]&255) << 6) | (uint32(vals[8]&255) << 14) | (uint32(vals[9]&7) << 22)
+	dst[3] = (uint32(vals[9]&248) >> 3) | (uint32(vals[10]&255) << 5) | (uint32(vals[11]&255) << 13) | (uint32(vals[12]&15) << 21)
+	dst[4] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&255) << 4) | (uint32(vals[14]&255) << 12) | (uint32(vals[15]&31) << 20)
+	dst[5] = (uint32(vals[15]&224) >> 5) | (uint32(vals[16]&255) << 3) | (uint32(vals[17]&255) << 11) | (uint32(vals[18]&63) << 19)
+	dst[6] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2) | (uint32(vals[20]&255) << 10) | (uint32(vals[21]&127) << 18)
+	dst[7] = (uint32(vals[21]&128) >> 7) | (uint32(vals[22]&255) << 1) | (uint32(vals[23]&255) << 9) | (uint32(vals[24]&255) << 17)
+}
+
+func unpack32x25(dst []uint32, vals []byte) {
+	_ = vals[99]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&1) << 24)
+	dst[1] = (uint32(vals[3]&254) >> 1) | (uint32(vals[4]&255) << 7) | (uint32(vals[5]&255) << 15) | (uint32(vals[6]&3) << 23)
+	dst[2] = (uint32(vals[6]&252) >> 2) | (uint32(vals[7]&255) << 6) | (uint32(vals[8]&255) << 14) | (uint32(vals[9]&7) << 22)
+	dst[3] = (uint32(vals[9]&248) >> 3) | (uint32(vals[10]&255) << 5) | (uint32(vals[11]&255) << 13) | (uint32(vals[12]&15) << 21)
+	dst[4] = (uint32(vals[12]&240) >> 4) | (uint32(vals[13]&255) << 4) | (uint32(vals[14]&255) << 12) | (uint32(vals[15]&31) << 20)
+	dst[5] = (uint32(vals[15]&224) >> 5) | (uint32(vals[16]&255) << 3) | (uint32(vals[17]&255) << 11) | (uint32(vals[18]&63) << 19)
+	dst[6] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2) | (uint32(vals[20]&255) << 10) | (uint32(vals[21]&127) << 18)
+	dst[7] = (uint32(vals[21]&128) >> 7) | (uint32(vals[22]&255) << 1) | (uint32(vals[23]&255) << 9) | (uint32(vals[24]&255) << 17)
+	dst[8] = (uint32(vals[25]&255) >> 0) | (uint32(vals[26]&255) << 8) | (uint32(vals[27]&255) << 16) | (uint32(vals[28]&1) << 24)
+	dst[9] = (uint32(vals[28]&254) >> 1) | (uint32(vals[29]&255) << 7) | (uint32(vals[30]&255) << 15) | (uint32(vals[31]&3) << 23)
+	dst[10] = (uint32(vals[31]&252) >> 2) | (uint32(vals[32]&255) << 6) | (uint32(vals[33]&255) << 14) | (uint32(vals[34]&7) << 22)
+	dst[11] = (uint32(vals[34]&248) >> 3) | (uint32(vals[35]&255) << 5) | (uint32(vals[36]&255) << 13) | (uint32(vals[37]&15) << 21)
+	dst[12] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&255) << 4) | (uint32(vals[39]&255) << 12) | (uint32(vals[40]&31) << 20)
+	dst[13] = (uint32(vals[40]&224) >> 5) | (uint32(vals[41]&255) << 3) | (uint32(vals[42]&255) << 11) | (uint32(vals[43]&63) << 19)
+	dst[14] = (uint32(vals[43]&192) >> 6) | (uint32(vals[44]&255) << 2) | (uint32(vals[45]&255) << 10) | (uint32(vals[46]&127) << 18)
+	dst[15] = (uint32(vals[46]&128) >> 7) | (uint32(vals[47]&255) << 1) | (uint32(vals[48]&255) << 9) | (uint32(vals[49]&255) << 17)
+	dst[16] = (uint32(vals[50]&255) >> 0) | (uint32(vals[51]&255) << 8) | (uint32(vals[52]&255) << 16) | (uint32(vals[53]&1) << 24)
+	dst[17] = (uint32(vals[53]&254) >> 1) | (uint32(vals[54]&255) << 7) | (uint32(vals[55]&255) << 15) | (uint32(vals[56]&3) << 23)
+	dst[18] = (uint32(vals[56]&252) >> 2) | (uint32(vals[57]&255) << 6) | (uint32(vals[58]&255) << 14) | (uint32(vals[59]&7) << 22)
+	dst[19] = (uint32(vals[59]&248) >> 3) | (uint32(vals[60]&255) << 5) | (uint32(vals[61]&255) << 13) | (uint32(vals[62]&15) << 21)
+	dst[20] = (uint32(vals[62]&240) >> 4) | (uint32(vals[63]&255) << 4) | (uint32(vals[64]&255) << 12) | (uint32(vals[65]&31) << 20)
+	dst[21] = (uint32(vals[65]&224) >> 5) | (uint32(vals[66]&255) << 3) | (uint32(vals[67]&255) << 11) | (uint32(vals[68]&63) << 19)
+	dst[22] = (uint32(vals[68]&192) >> 6) | (uint32(vals[69]&255) << 2) | (uint32(vals[70]&255) << 10) | (uint32(vals[71]&127) << 18)
+	dst[23] = (uint32(vals[71]&128) >> 7) | (uint32(vals[72]&255) << 1) | (uint32(vals[73]&255) << 9) | (uint32(vals[74]&255) << 17)
+	dst[24] = (uint32(vals[75]&255) >> 0) | (uint32(vals[76]&255) << 8) | (uint32(vals[77]&255) << 16) | (uint32(vals[78]&1) << 24)
+	dst[25] = (uint32(vals[78]&254) >> 1) | (uint32(vals[79]&255) << 7) | (uint32(vals[80]&255) << 15) | (uint32(vals[81]&3) << 23)
+	dst[26] = (uint32(vals[81]&252) >> 2) | (uint32(vals[82]&255) << 6) | (uint32(vals[83]&255) << 14) | (uint32(vals[84]&7) << 22)
+	dst[27] = (uint32(vals[84]&248) >> 3) | (uint32(vals[85]&255) << 5) | (uint32(vals[86]&255) << 13) | (uint32(vals[87]&15) << 21)
+	dst[28] = (uint32(vals[87]&240) >> 4) | (uint32(vals[88]&255) << 4) | (uint32(vals[89]&255) << 12) | (uint32(vals[90]&31) << 20)
+	dst[29] = (uint32(vals[90]&224) >> 5) | (uint32(vals[91]&255) << 3) | (uint32(vals[92]&255) << 11) | (uint32(vals[93]&63) << 19)
+	dst[30] = (uint32(vals[93]&192) >> 6) | (uint32(vals[94]&255) << 2) | (uint32(vals[95]&255) << 10) | (uint32(vals[96]&127) << 18)
+	dst[31] = (uint32(vals[96]&128) >> 7) | (uint32(vals[97]&255) << 1) | (uint32(vals[98]&255) << 9) | (uint32(vals[99]&255) << 17)
+}
+
+func unpack26(dst []uint32, vals []byte) {
+	_ = vals[25]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&3) << 24)
+	dst[1] = (uint32(vals[3]&252) >> 2) | (uint32(vals[4]&255) << 6) | (uint32(vals[5]&255) << 14) | (uint32(vals[6]&15) << 22)
+	dst[2] = (uint32(vals[6]&240) >> 4) | (uint32(vals[7]&255) << 4) | (uint32(vals[8]&255) << 12) | (uint32(vals[9]&63) << 20)
+	dst[3] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&255) << 2) | (uint32(vals[11]&255) << 10) | (uint32(vals[12]&255) << 18)
+	dst[4] = (uint32(vals[13]&255) >> 0) | (uint32(vals[14]&255) << 8) | (uint32(vals[15]&255) << 16) | (uint32(vals[16]&3) << 24)
+	dst[5] = (uint32(vals[16]&252) >> 2) | (uint32(vals[17]&255) << 6) | (uint32(vals[18]&255) << 14) | (uint32(vals[19]&15) << 22)
+	dst[6] = (uint32(vals[19]&240) >> 4) | (uint32(vals[20]&255) << 4) | (uint32(vals[21]&255) << 12) | (uint32(vals[22]&63) << 20)
+	dst[7] = (uint32(vals[22]&192) >> 6) | (uint32(vals[23]&255) << 2) | (uint32(vals[24]&255) << 10) | (uint32(vals[25]&255) << 18)
+}
+
+func unpack32x26(dst []uint32, vals []byte) {
+	_ = vals[103]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&3) << 24)
+	dst[1] = (uint32(vals[3]&252) >> 2) | (uint32(vals[4]&255) << 6) | (uint32(vals[5]&255) << 14) | (uint32(vals[6]&15) << 22)
+	dst[2] = (uint32(vals[6]&240) >> 4) | (uint32(vals[7]&255) << 4) | (uint32(vals[8]&255) << 12) | (uint32(vals[9]&63) << 20)
+	dst[3] = (uint32(vals[9]&192) >> 6) | (uint32(vals[10]&255) << 2) | (uint32(vals[11]&255) << 10) | (uint32(vals[12]&255) << 18)
+	dst[4] = (uint32(vals[13]&255) >> 0) | (uint32(vals[14]&255) << 8) | (uint32(vals[15]&255) << 16) | (uint32(vals[16]&3) << 24)
+	dst[5] = (uint32(vals[16]&252) >> 2) | (uint32(vals[17]&255) << 6) | (uint32(vals[18]&255) << 14) | (uint32(vals[19]&15) << 22)
+	dst[6] = (uint32(vals[19]&240) >> 4) | (uint32(vals[20]&255) << 4) | (uint32(vals[21]&255) << 12) | (uint32(vals[22]&63) << 20)
+	dst[7] = (uint32(vals[22]&192) >> 6) | (uint32(vals[23]&255) << 2) | (uint32(vals[24]&255) << 10) | (uint32(vals[25]&255) << 18)
+	dst[8] = (uint32(vals[26]&255) >> 0) | (uint32(vals[27]&255) << 8) | (uint32(vals[28]&255) << 16) | (uint32(vals[29]&3) << 24)
+	dst[9] = (uint32(vals[29]&252) >> 2) | (uint32(vals[30]&255) << 6) | (uint32(vals[31]&255) << 14) | (uint32(vals[32]&15) << 22)
+	dst[10] = (uint32(vals[32]&240) >> 4) | (uint32(vals[33]&255) << 4) | (uint32(vals[34]&255) << 12) | (uint32(vals[35]&63) << 20)
+	dst[11] = (uint32(vals[35]&192) >> 6) | (uint32(vals[36]&255) << 2) | (uint32(vals[37]&255) << 10) | (uint32(vals[38]&255) << 18)
+	dst[12] = (uint32(vals[39]&255) >> 0) | (uint32(vals[40]&255) << 8) | (uint32(vals[41]&255) << 16) | (uint32(vals[42]&3) << 24)
+	dst[13] = (uint32(vals[42]&252) >> 2) | (uint32(vals[43]&255) << 6) | (uint32(vals[44]&255) << 14) | (uint32(vals[45]&15) << 22)
+	dst[14] = (uint32(vals[45]&240) >> 4) | (uint32(vals[46]&255) << 4) | (uint32(vals[47]&255) << 12) | (uint32(vals[48]&63) << 20)
+	dst[15] = (uint32(vals[48]&192) >> 6) | (uint32(vals[49]&255) << 2) | (uint32(vals[50]&255) << 10) | (uint32(vals[51]&255) << 18)
+	dst[16] = (uint32(vals[52]&255) >> 0) | (uint32(vals[53]&255) << 8) | (uint32(vals[54]&255) << 16) | (uint32(vals[55]&3) << 24)
+	dst[17] = (uint32(vals[55]&252) >> 2) | (uint32(vals[56]&255) << 6) | (uint32(vals[57]&255) << 14) | (uint32(vals[58]&15) << 22)
+	dst[18] = (uint32(vals[58]&240) >> 4) | (uint32(vals[59]&255) << 4) | (uint32(vals[60]&255) << 12) | (uint32(vals[61]&63) << 20)
+	dst[19] = (uint32(vals[61]&192) >> 6) | (uint32(vals[62]&255) << 2) | (uint32(vals[63]&255) << 10) | (uint32(vals[64]&255) << 18)
+	dst[20] = (uint32(vals[65]&255) >> 0) | (uint32(vals[66]&255) << 8) | (uint32(vals[67]&255) << 16) | (uint32(vals[68]&3) << 24)
+	dst[21] = (uint32(vals[68]&252) >> 2) | (uint32(vals[69]&255) << 6) | (uint32(vals[70]&255) << 14) | (uint32(vals[71]&15) << 22)
+	dst[22] = (uint32(vals[71]&240) >> 4) | (uint32(vals[72]&255) << 4) | (uint32(vals[73]&255) << 12) | (uint32(vals[74]&63) << 20)
+	dst[23] = (uint32(vals[74]&192) >> 6) | (uint32(vals[75]&255) << 2) | (uint32(vals[76]&255) << 10) | (uint32(vals[77]&255) << 18)
+	dst[24] = (uint32(vals[78]&255) >> 0) | (uint32(vals[79]&255) << 8) | (uint32(vals[80]&255) << 16) | (uint32(vals[81]&3) << 24)
+	dst[25] = (uint32(vals[81]&252) >> 2) | (uint32(vals[82]&255) << 6) | (uint32(vals[83]&255) << 14) | (uint32(vals[84]&15) << 22)
+	dst[26] = (uint32(vals[84]&240) >> 4) | (uint32(vals[85]&255) << 4) | (uint32(vals[86]&255) << 12) | (uint32(vals[87]&63) << 20)
+	dst[27] = (uint32(vals[87]&192) >> 6) | (uint32(vals[88]&255) << 2) | (uint32(vals[89]&255) << 10) | (uint32(vals[90]&255) << 18)
+	dst[28] = (uint32(vals[91]&255) >> 0) | (uint32(vals[92]&255) << 8) | (uint32(vals[93]&255) << 16) | (uint32(vals[94]&3) << 24)
+	dst[29] = (uint32(vals[94]&252) >> 2) | (uint32(vals[95]&255) << 6) | (uint32(vals[96]&255) << 14) | (uint32(vals[97]&15) << 22)
+	dst[30] = (uint32(vals[97]&240) >> 4) | (uint32(vals[98]&255) << 4) | (uint32(vals[99]&255) << 12) | (uint32(vals[100]&63) << 20)
+	dst[31] = (uint32(vals[100]&192) >> 6) | (uint32(vals[101]&255) << 2) | (uint32(vals[102]&255) << 10) | (uint32(vals[103]&255) << 18)
+}
+
+func unpack27(dst []uint32, vals []byte) {
+	_ = vals[26]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&7) << 24)
+	dst[1] = (uint32(vals[3]&248) >> 3) | (uint32(vals[4]&255) << 5) | (uint32(vals[5]&255) << 13) | (uint32(vals[6]&63) << 21)
+	dst[2] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&255) << 2) | (uint32(vals[8]&255) << 10) | (uint32(vals[9]&255) << 18) | (uint32(vals[10]&1) << 26)
+	dst[3] = (uint32(vals[10]&254) >> 1) | (uint32(vals[11]&255) << 7) | (uint32(vals[12]&255) << 15) | (uint32(vals[13]&15) << 23)
+	dst[4] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&255) << 4) | (uint32(vals[15]&255) << 12) | (uint32(vals[16]&127) << 20)
+	dst[5] = (uint32(vals[16]&128) >> 7) | (uint32(vals[17]&255) << 1) | (uint32(vals[18]&255) << 9) | (uint32(vals[19]&255) << 17) | (uint32(vals[20]&3) << 25)
+	dst[6] = (uint32(vals[20]&252) >> 2) | (uint32(vals[21]&255) << 6) | (uint32(vals[22]&255) << 14) | (uint32(vals[23]&31) << 22)
+	dst[7] = (uint32(vals[23]&224) >> 5) | (uint32(vals[24]&255) << 3) | (uint32(vals[25]&255) << 11) | (uint32(vals[26]&255) << 19)
+}
+
+func unpack32x27(dst []uint32, vals []byte) {
+	_ = vals[107]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&7) << 24)
+	dst[1] = (uint32(vals[3]&248) >> 3) | (uint32(vals[4]&255) << 5) | (uint32(vals[5]&255) << 13) | (uint32(vals[6]&63) << 21)
+	dst[2] = (uint32(vals[6]&192) >> 6) | (uint32(vals[7]&255) << 2) | (uint32(vals[8]&255) << 10) | (uint32(vals[9]&255) << 18) | (uint32(vals[10]&1) << 26)
+	dst[3] = (uint32(vals[10]&254) >> 1) | (uint32(vals[11]&255) << 7) | (uint32(vals[12]&255) << 15) | (uint32(vals[13]&15) << 23)
+	dst[4] = (uint32(vals[13]&240) >> 4) | (uint32(vals[14]&255) << 4) | (uint32(vals[15]&255) << 12) | (uint32(vals[16]&127) << 20)
+	dst[5] = (uint32(vals[16]&128) >> 7) | (uint32(vals[17]&255) << 1) | (uint32(vals[18]&255) << 9) | (uint32(vals[19]&255) << 17) | (uint32(vals[20]&3) << 25)
+	dst[6] = (uint32(vals[20]&252) >> 2) | (uint32(vals[21]&255) << 6) | (uint32(vals[22]&255) << 14) | (uint32(vals[23]&31) << 22)
+	dst[7] = (uint32(vals[23]&224) >> 5) | (uint32(vals[24]&255) << 3) | (uint32(vals[25]&255) << 11) | (uint32(vals[26]&255) << 19)
+	dst[8] = (uint32(vals[27]&255) >> 0) | (uint32(vals[28]&255) << 8) | (uint32(vals[29]&255) << 16) | (uint32(vals[30]&7) << 24)
+	dst[9] = (uint32(vals[30]&248) >> 3) | (uint32(vals[31]&255) << 5) | (uint32(vals[32]&255) << 13) | (uint32(vals[33]&63) << 21)
+	dst[10] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&255) << 2) | (uint32(vals[35]&255) << 10) | (uint32(vals[36]&255) << 18) | (uint32(vals[37]&1) << 26)
+	dst[11] = (uint32(vals[37]&254) >> 1) | (uint32(vals[38]&255) << 7) | (uint32(vals[39]&255) << 15) | (uint32(vals[40]&15) << 23)
+	dst[12] = (uint32(vals[40]&240) >> 4) | (uint32(vals[41]&255) << 4) | (uint32(vals[42]&255) << 12) | (uint32(vals[43]&127) << 20)
+	dst[13] = (uint32(vals[43]&128) >> 7) | (uint32(vals[44]&255) << 1) | (uint32(vals[45]&255) << 9) | (uint32(vals[46]&255) << 17) | (uint32(vals[47]&3) << 25)
+	dst[14] = (uint32(vals[47]&252) >> 2) | (uint32(vals[48]&255) << 6) | (uint32(vals[49]&255) << 14) | (uint32(vals[50]&31) << 22)
+	dst[15] = (uint32(vals[50]&224) >> 5) | (uint32(vals[51]&255) << 3) | (uint32(vals[52]&255) << 11) | (uint32(vals[53]&255) << 19)
+	dst[16] = (uint32(vals[54]&255) >> 0) | (uint32(vals[55]&255) << 8) | (uint32(vals[56]&255) << 16) | (uint32(vals[57]&7) << 24)
+	dst[17] = (uint32(vals[57]&248) >> 3) | (uint32(vals[58]&255) << 5) | (uint32(vals[59]&255) << 13) | (uint32(vals[60]&63) << 21)
+	dst[18] = (uint32(vals[60]&192) >> 6) | (uint32(vals[61]&255) << 2) | (uint32(vals[62]&255) << 10) | (uint32(vals[63]&255) << 18) | (uint32(vals[64]&1) << 26)
+	dst[19] = (uint32(vals[64]&254) >> 1) | (uint32(vals[65]&255) << 7) | (uint32(vals[66]&255) << 15) | (uint32(vals[67]&15) << 23)
+	dst[20] = (uint32(vals[67]&240) >> 4) | (uint32(vals[68]&255) << 4) | (uint32(vals[69]&255) << 12) | (uint32(vals[70]&127) << 20)
+	dst[21] = (uint32(vals[70]&128) >> 7) | (uint32(vals[71]&255) << 1) | (uint32(vals[72]&255) << 9) | (uint32(vals[73]&255) << 17) | (uint32(vals[74]&3) << 25)
+	dst[22] = (uint32(vals[74]&252) >> 2) | (uint32(vals[75]&255) << 6) | (uint32(vals[76]&255) << 14) | (uint32(vals[77]&31) << 22)
+	dst[23] = (uint32(vals[77]&224) >> 5) | (uint32(vals[78]&255) << 3) | (uint32(vals[79]&255) << 11) | (uint32(vals[80]&255) << 19)
+	dst[24] = (uint32(vals[81]&255) >> 0) | (uint32(vals[82]&255) << 8) | (uint32(vals[83]&255) << 16) | (uint32(vals[84]&7) << 24)
+	dst[25] = (uint32(vals[84]&248) >> 3) | (uint32(vals[85]&255) << 5) | (uint32(vals[86]&255) << 13) | (uint32(vals[87]&63) << 21)
+	dst[26] = (uint32(vals[87]&192) >> 6) | (uint32(vals[88]&255) << 2) | (uint32(vals[89]&255) << 10) | (uint32(vals[90]&255) << 18) | (uint32(vals[91]&1) << 26)
+	dst[27] = (uint32(vals[91]&254) >> 1) | (uint32(vals[92]&255) << 7) | (uint32(vals[93]&255) << 15) | (uint32(vals[94]&15) << 23)
+	dst[28] = (uint32(vals[94]&240) >> 4) | (uint32(vals[95]&255) << 4) | (uint32(vals[96]&255) << 12) | (uint32(vals[97]&127) << 20)
+	dst[29] = (uint32(vals[97]&128) >> 7) | (uint32(vals[98]&255) << 1) | (uint32(vals[99]&255) << 9) | (uint32(vals[100]&255) << 17) | (uint32(vals[101]&3) << 25)
+	dst[30] = (uint32(vals[101]&252) >> 2) | (uint32(vals[102]&255) << 6) | (uint32(vals[103]&255) << 14) | (uint32(vals[104]&31) << 22)
+	dst[31] = (uint32(vals[104]&224) >> 5) | (uint32(vals[105]&255) << 3) | (uint32(vals[106]&255) << 11) | (uint32(vals[107]&255) << 19)
+}
+
+func unpack28(dst []uint32, vals []byte) {
+	_ = vals[27]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&15) << 24)
+	dst[1] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&255) << 4) | (uint32(vals[5]&255) << 12) | (uint32(vals[6]&255) << 20)
+	dst[2] = (uint32(vals[7]&255) >> 0) | (uint32(vals[8]&255) << 8) | (uint32(vals[9]&255) << 16) | (uint32(vals[10]&15) << 24)
+	dst[3] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&255) << 12) | (uint32(vals[13]&255) << 20)
+	dst[4] = (uint32(vals[14]&255) >> 0) | (uint32(vals[15]&255) << 8) | (uint32(vals[16]&255) << 16) | (uint32(vals[17]&15) << 24)
+	dst[5] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&255) << 4) | (uint32(vals[19]&255) << 12) | (uint32(vals[20]&255) << 20)
+	dst[6] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&255) << 8) | (uint32(vals[23]&255) << 16) | (uint32(vals[24]&15) << 24)
+	dst[7] = (uint32(vals[24]&240) >> 4) | (uint32(vals[25]&255) << 4) | (uint32(vals[26]&255) << 12) | (uint32(vals[27]&255) << 20)
+}
+
+func unpack32x28(dst []uint32, vals []byte) {
+	_ = vals[111]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&15) << 24)
+	dst[1] = (uint32(vals[3]&240) >> 4) | (uint32(vals[4]&255) << 4) | (uint32(vals[5]&255) << 12) | (uint32(vals[6]&255) << 20)
+	dst[2] = (uint32(vals[7]&255) >> 0) | (uint32(vals[8]&255) << 8) | (uint32(vals[9]&255) << 16) | (uint32(vals[10]&15) << 24)
+	dst[3] = (uint32(vals[10]&240) >> 4) | (uint32(vals[11]&255) << 4) | (uint32(vals[12]&255) << 12) | (uint32(vals[13]&255) << 20)
+	dst[4] = (uint32(vals[14]&255) >> 0) | (uint32(vals[15]&255) << 8) | (uint32(vals[16]&255) << 16) | (uint32(vals[17]&15) << 24)
+	dst[5] = (uint32(vals[17]&240) >> 4) | (uint32(vals[18]&255) << 4) | (uint32(vals[19]&255) << 12) | (uint32(vals[20]&255) << 20)
+	dst[6] = (uint32(vals[21]&255) >> 0) | (uint32(vals[22]&255) << 8) | (uint32(vals[23]&255) << 16) | (uint32(vals[24]&15) << 24)
+	dst[7] = (uint32(vals[24]&240) >> 4) | (uint32(vals[25]&255) << 4) | (uint32(vals[26]&255) << 12) | (uint32(vals[27]&255) << 20)
+	dst[8] = (uint32(vals[28]&255) >> 0) | (uint32(vals[29]&255) << 8) | (uint32(vals[30]&255) << 16) | (uint32(vals[31]&15) << 24)
+	dst[9] = (uint32(vals[31]&240) >> 4) | (uint32(vals[32]&255) << 4) | (uint32(vals[33]&255) << 12) | (uint32(vals[34]&255) << 20)
+	dst[10] = (uint32(vals[35]&255) >> 0) | (uint32(vals[36]&255) << 8) | (uint32(vals[37]&255) << 16) | (uint32(vals[38]&15) << 24)
+	dst[11] = (uint32(vals[38]&240) >> 4) | (uint32(vals[39]&255) << 4) | (uint32(vals[40]&255) << 12) | (uint32(vals[41]&255) << 20)
+	dst[12] = (uint32(vals[42]&255) >> 0) | (uint32(vals[43]&255) << 8) | (uint32(vals[44]&255) << 16) | (uint32(vals[45]&15) << 24)
+	dst[13] = (uint32(vals[45]&240) >> 4) | (uint32(vals[46]&255) << 4) | (uint32(vals[47]&255) << 12) | (uint32(vals[48]&255) << 20)
+	dst[14] = (uint32(vals[49]&255) >> 0) | (uint32(vals[50]&255) << 8) | (uint32(vals[51]&255) << 16) | (uint32(vals[52]&15) << 24)
+	dst[15] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&255) << 12) | (uint32(vals[55]&255) << 20)
+	dst[16] = (uint32(vals[56]&255) >> 0) | (uint32(vals[57]&255) << 8) | (uint32(vals[58]&255) << 16) | (uint32(vals[59]&15) << 24)
+	dst[17] = (uint32(vals[59]&240) >> 4) | (uint32(vals[60]&255) << 4) | (uint32(vals[61]&255) << 12) | (uint32(vals[62]&255) << 20)
+	dst[18] = (uint32(vals[63]&255) >> 0) | (uint32(vals[64]&255) << 8) | (uint32(vals[65]&255) << 16) | (uint32(vals[66]&15) << 24)
+	dst[19] = (uint32(vals[66]&240) >> 4) | (uint32(vals[67]&255) << 4) | (uint32(vals[68]&255) << 12) | (uint32(vals[69]&255) << 20)
+	dst[20] = (uint32(vals[70]&255) >> 0) | (uint32(vals[71]&255) << 8) | (uint32(vals[72]&255) << 16) | (uint32(vals[73]&15) << 24)
+	dst[21] = (uint32(vals[73]&240) >> 4) | (uint32(vals[74]&255) << 4) | (uint32(vals[75]&255) << 12) | (uint32(vals[76]&255) << 20)
+	dst[22] = (uint32(vals[77]&255) >> 0) | (uint32(vals[78]&255) << 8) | (uint32(vals[79]&255) << 16) | (uint32(vals[80]&15) << 24)
+	dst[23] = (uint32(vals[80]&240) >> 4) | (uint32(vals[81]&255) << 4) | (uint32(vals[82]&255) << 12) | (uint32(vals[83]&255) << 20)
+	dst[24] = (uint32(vals[84]&255) >> 0) | (uint32(vals[85]&255) << 8) | (uint32(vals[86]&255) << 16) | (uint32(vals[87]&15) << 24)
+	dst[25] = (uint32(vals[87]&240) >> 4) | (uint32(vals[88]&255) << 4) | (uint32(vals[89]&255) << 12) | (uint32(vals[90]&255) << 20)
+	dst[26] = (uint32(vals[91]&255) >> 0) | (uint32(vals[92]&255) << 8) | (uint32(vals[93]&255) << 16) | (uint32(vals[94]&15) << 24)
+	dst[27] = (uint32(vals[94]&240) >> 4) | (uint32(vals[95]&255) << 4) | (uint32(vals[96]&255) << 12) | (uint32(vals[97]&255) << 20)
+	dst[28] = (uint32(vals[98]&255) >> 0) | (uint32(vals[99]&255) << 8) | (uint32(vals[100]&255) << 16) | (uint32(vals[101]&15) << 24)
+	dst[29] = (uint32(vals[101]&240) >> 4) | (uint32(vals[102]&255) << 4) | (uint32(vals[103]&255) << 12) | (uint32(vals[104]&255) << 20)
+	dst[30] = (uint32(vals[105]&255) >> 0) | (uint32(vals[106]&255) << 8) | (uint32(vals[107]&255) << 16) | (uint32(vals[108]&15) << 24)
+	dst[31] = (uint32(vals[108]&240) >> 4) | (uint32(vals[109]&255) << 4) | (uint32(vals[110]&255) << 12) | (uint32(vals[111]&255) << 20)
+}
+
+func unpack29(dst []uint32, vals []byte) {
+	_ = vals[28]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&31) << 24)
+	dst[1] = (uint32(vals[3]&224) >> 5) | (uint32(vals[4]&255) << 3) | (uint32(vals[5]&255) << 11) | (uint32(vals[6]&255) << 19) | (uint32(vals[7]&3) << 27)
+	dst[2] = (uint32(vals[7]&252) >> 2) | (uint32(vals[8]&255) << 6) | (uint32(vals[9]&255) << 14) | (uint32(vals[10]&127) << 22)
+	dst[3] = (uint32(vals[10]&128) >> 7) | (uint32(vals[11]&255) << 1) | (uint32(vals[12]&255) << 9) | (uint32(vals[13]&255) << 17) | (uint32(vals[14]&15) << 25)
+	dst[4] = (uint32(vals[14]&240) >> 4) | (uint32(vals[15]&255) << 4) | (uint32(vals[16]&255) << 12) | (uint32(vals[17]&255) << 20) | (uint32(vals[18]&1) << 28)
+	dst[5] = (uint32(vals[18]&254) >> 1) | (uint32(vals[19]&255) << 7) | (uint32(vals[20]&255) << 15) | (uint32(vals[21]&63) << 23)
+	dst[6] = (uint32(vals[21]&192) >> 6) | (uint32(vals[22]&255) << 2) | (uint32(vals[23]&255) << 10) | (uint32(vals[24]&255) << 18) | (uint32(vals[25]&7) << 26)
+	dst[7] = (uint32(vals[25]&248) >> 3) | (uint32(vals[26]&255) << 5) | (uint32(vals[27]&255) << 13) | (uint32(vals[28]&255) << 21)
+}
+
+func unpack32x29(dst []uint32, vals []byte) {
+	_ = vals[115]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&31) << 24)
+	dst[1] = (uint32(vals[3]&224) >> 5) | (uint32(vals[4]&255) << 3) | (uint32(vals[5]&255) << 11) | (uint32(vals[6]&255) << 19) | (uint32(vals[7]&3) << 27)
+	dst[2] = (uint32(vals[7]&252) >> 2) | (uint32(vals[8]&255) << 6) | (uint32(vals[9]&255) << 14) | (uint32(vals[10]&127) << 22)
+	dst[3] = (uint32(vals[10]&128) >> 7) | (uint32(vals[11]&255) << 1) | (uint32(vals[12]&255) << 9) | (uint32(vals[13]&255) << 17) | (uint32(vals[14]&15) << 25)
+	dst[4] = (uint32(vals[14]&240) >> 4) | (uint32(vals[15]&255) << 4) | (uint32(vals[16]&255) << 12) | (uint32(vals[17]&255) << 20) | (uint32(vals[18]&1) << 28)
+	dst[5] = (uint32(vals[18]&254) >> 1) | (uint32(vals[19]&255) << 7) | (uint32(vals[20]&255) << 15) | (uint32(vals[21]&63) << 23)
+	dst[6] = (uint32(vals[21]&192) >> 6) | (uint32(vals[22]&255) << 2) | (uint32(vals[23]&255) << 10) | (uint32(vals[24]&255) << 18) | (uint32(vals[25]&7) << 26)
+	dst[7] = (uint32(vals[25]&248) >> 3) | (uint32(vals[26]&255) << 5) | (uint32(vals[27]&255) << 13) | (uint32(vals[28]&255) << 21)
+	dst[8] = (uint32(vals[29]&255) >> 0) | (uint32(vals[30]&255) << 8) | (uint32(vals[31]&255) << 16) | (uint32(vals[32]&31) << 24)
+	dst[9] = (uint32(vals[32]&224) >> 5) | (uint32(vals[33]&255) << 3) | (uint32(vals[34]&255) << 11) | (uint32(vals[35]&255) << 19) | (uint32(vals[36]&3) << 27)
+	dst[10] = (uint32(vals[36]&252) >> 2) | (uint32(vals[37]&255) << 6) | (uint32(vals[38]&255) << 14) | (uint32(vals[39]&127) << 22)
+	dst[11] = (uint32(vals[39]&128) >> 7) | (uint32(vals[40]&255) << 1) | (uint32(vals[41]&255) << 9) | (uint32(vals[42]&255) << 17) | (uint32(vals[43]&15) << 25)
+	dst[12] = (uint32(vals[43]&240) >> 4) | (uint32(vals[44]&255) << 4) | (uint32(vals[45]&255) << 12) | (uint32(vals[46]&255) << 20) | (uint32(vals[47]&1) << 28)
+	dst[13] = (uint32(vals[47]&254) >> 1) | (uint32(vals[48]&255) << 7) | (uint32(vals[49]&255) << 15) | (uint32(vals[50]&63) << 23)
+	dst[14] = (uint32(vals[50]&192) >> 6) | (uint32(vals[51]&255) << 2) | (uint32(vals[52]&255) << 10) | (uint32(vals[53]&255) << 18) | (uint32(vals[54]&7) << 26)
+	dst[15] = (uint32(vals[54]&248) >> 3) | (uint32(vals[55]&255) << 5) | (uint32(vals[56]&255) << 13) | (uint32(vals[57]&255) << 21)
+	dst[16] = (uint32(vals[58]&255) >> 0) | (uint32(vals[59]&255) << 8) | (uint32(vals[60]&255) << 16) | (uint32(vals[61]&31) << 24)
+	dst[17] = (uint32(vals[61]&224) >> 5) | (uint32(vals[62]&255) << 3) | (uint32(vals[63]&255) << 11) | (uint32(vals[64]&255) << 19) | (uint32(vals[65]&3) << 27)
+	dst[18] = (uint32(vals[65]&252) >> 2) | (uint32(vals[66]&255) << 6) | (uint32(vals[67]&255) << 14) | (uint32(vals[68]&127) << 22)
+	dst[19] = (uint32(vals[68]&128) >> 7) | (uint32(vals[69]&255) << 1) | (uint32(vals[70]&255) << 9) | (uint32(vals[71]&255) << 17) | (uint32(vals[72]&15) << 25)
+	dst[20] = (uint32(vals[72]&240) >> 4) | (uint32(vals[73]&255) << 4) | (uint32(vals[74]&255) << 12) | (uint32(vals[75]&255) << 20) | (uint32(vals[76]&1) << 28)
+	dst[21] = (uint32(vals[76]&254) >> 1) | (uint32(vals[77]&255) << 7) | (uint32(vals[78]&255) << 15) | (uint32(vals[79]&63) << 23)
+	dst[22] = (uint32(vals[79]&192) >> 6) | (uint32(vals[80]&255) << 2) | (uint32(vals[81]&255) << 10) | (uint32(vals[82]&255) << 18) | (uint32(vals[83]&7) << 26)
+	dst[23] = (uint32(vals[83]&248) >> 3) | (uint32(vals[84]&255) << 5) | (uint32(vals[85]&255) << 13) | (uint32(vals[86]&255) << 21)
+	dst[24] = (uint32(vals[87]&255) >> 0) | (uint32(vals[88]&255) << 8) | (uint32(vals[89]&255) << 16) | (uint32(vals[90]&31) << 24)
+	dst[25] = (uint32(vals[90]&224) >> 5) | (uint32(vals[91]&255) << 3) | (uint32(vals[92]&255) << 11) | (uint32(vals[93]&255) << 19) | (uint32(vals[94]&3) << 27)
+	dst[26] = (uint32(vals[94]&252) >> 2) | (uint32(vals[95]&255) << 6) | (uint32(vals[96]&255) << 14) | (uint32(vals[97]&127) << 22)
+	dst[27] = (uint32(vals[97]&128) >> 7) | (uint32(vals[98]&255) << 1) | (uint32(vals[99]&255) << 9) | (uint32(vals[100]&255) << 17) | (uint32(vals[101]&15) << 25)
+	dst[28] = (uint32(vals[101]&240) >> 4) | (uint32(vals[102]&255) << 4) | (uint32(vals[103]&255) << 12) | (uint32(vals[104]&255) << 20) | (uint32(vals[105]&1) << 28)
+	dst[29] = (uint32(vals[105]&254) >> 1) | (uint32(vals[106]&255) << 7) | (uint32(vals[107]&255) << 15) | (uint32(vals[108]&63) << 23)
+	dst[30] = (uint32(vals[108]&192) >> 6) | (uint32(vals[109]&255) << 2) | (uint32(vals[110]&255) << 10) | (uint32(vals[111]&255) << 18) | (uint32(vals[112]&7) << 26)
+	dst[31] = (uint32(vals[112]&248) >> 3) | (uint32(vals[113]&255) << 5) | (uint32(vals[114]&255) << 13) | (uint32(vals[115]&255) << 21)
+}
+
+func unpack30(dst []uint32, vals []byte) {
+	_ = vals[29]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&63) << 24)
+	dst[1] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2) | (uint32(vals[5]&255) << 10) | (uint32(vals[6]&255) << 18) | (uint32(vals[7]&15) << 26)
+	dst[2] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&255) << 12) | (uint32(vals[10]&255) << 20) | (uint32(vals[11]&3) << 28)
+	dst[3] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&255) << 14) | (uint32(vals[14]&255) << 22)
+	dst[4] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&255) << 16) | (uint32(vals[18]&63) << 24)
+	dst[5] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2) | (uint32(vals[20]&255) << 10) | (uint32(vals[21]&255) << 18) | (uint32(vals[22]&15) << 26)
+	dst[6] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4) | (uint32(vals[24]&255) << 12) | (uint32(vals[25]&255) << 20) | (uint32(vals[26]&3) << 28)
+	dst[7] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&255) << 6) | (uint32(vals[28]&255) << 14) | (uint32(vals[29]&255) << 22)
+}
+
+func unpack32x30(dst []uint32, vals []byte) {
+	_ = vals[119]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&63) << 24)
+	dst[1] = (uint32(vals[3]&192) >> 6) | (uint32(vals[4]&255) << 2) | (uint32(vals[5]&255) << 10) | (uint32(vals[6]&255) << 18) | (uint32(vals[7]&15) << 26)
+	dst[2] = (uint32(vals[7]&240) >> 4) | (uint32(vals[8]&255) << 4) | (uint32(vals[9]&255) << 12) | (uint32(vals[10]&255) << 20) | (uint32(vals[11]&3) << 28)
+	dst[3] = (uint32(vals[11]&252) >> 2) | (uint32(vals[12]&255) << 6) | (uint32(vals[13]&255) << 14) | (uint32(vals[14]&255) << 22)
+	dst[4] = (uint32(vals[15]&255) >> 0) | (uint32(vals[16]&255) << 8) | (uint32(vals[17]&255) << 16) | (uint32(vals[18]&63) << 24)
+	dst[5] = (uint32(vals[18]&192) >> 6) | (uint32(vals[19]&255) << 2) | (uint32(vals[20]&255) << 10) | (uint32(vals[21]&255) << 18) | (uint32(vals[22]&15) << 26)
+	dst[6] = (uint32(vals[22]&240) >> 4) | (uint32(vals[23]&255) << 4) | (uint32(vals[24]&255) << 12) | (uint32(vals[25]&255) << 20) | (uint32(vals[26]&3) << 28)
+	dst[7] = (uint32(vals[26]&252) >> 2) | (uint32(vals[27]&255) << 6) | (uint32(vals[28]&255) << 14) | (uint32(vals[29]&255) << 22)
+	dst[8] = (uint32(vals[30]&255) >> 0) | (uint32(vals[31]&255) << 8) | (uint32(vals[32]&255) << 16) | (uint32(vals[33]&63) << 24)
+	dst[9] = (uint32(vals[33]&192) >> 6) | (uint32(vals[34]&255) << 2) | (uint32(vals[35]&255) << 10) | (uint32(vals[36]&255) << 18) | (uint32(vals[37]&15) << 26)
+	dst[10] = (uint32(vals[37]&240) >> 4) | (uint32(vals[38]&255) << 4) | (uint32(vals[39]&255) << 12) | (uint32(vals[40]&255) << 20) | (uint32(vals[41]&3) << 28)
+	dst[11] = (uint32(vals[41]&252) >> 2) | (uint32(vals[42]&255) << 6) | (uint32(vals[43]&255) << 14) | (uint32(vals[44]&255) << 22)
+	dst[12] = (uint32(vals[45]&255) >> 0) | (uint32(vals[46]&255) << 8) | (uint32(vals[47]&255) << 16) | (uint32(vals[48]&63) << 24)
+	dst[13] = (uint32(vals[48]&192) >> 6) | (uint32(vals[49]&255) << 2) | (uint32(vals[50]&255) << 10) | (uint32(vals[51]&255) << 18) | (uint32(vals[52]&15) << 26)
+	dst[14] = (uint32(vals[52]&240) >> 4) | (uint32(vals[53]&255) << 4) | (uint32(vals[54]&255) << 12) | (uint32(vals[55]&255) << 20) | (uint32(vals[56]&3) << 28)
+	dst[15] = (uint32(vals[56]&252) >> 2) | (uint32(vals[57]&255) << 6) | (uint32(vals[58]&255) << 14) | (uint32(vals[59]&255) << 22)
+	dst[16] = (uint32(vals[60]&255) >> 0) | (uint32(vals[61]&255) << 8) | (uint32(vals[62]&255) << 16) | (uint32(vals[63]&63) << 24)
+	dst[17] = (uint32(vals[63]&192) >> 6) | (uint32(vals[64]&255) << 2) | (uint32(vals[65]&255) << 10) | (uint32(vals[66]&255) << 18) | (uint32(vals[67]&15) << 26)
+	dst[18] = (uint32(vals[67]&240) >> 4) | (uint32(vals[68]&255) << 4) | (uint32(vals[69]&255) << 12) | (uint32(vals[70]&255) << 20) | (uint32(vals[71]&3) << 28)
+	dst[19] = (uint32(vals[71]&252) >> 2) | (uint32(vals[72]&255) << 6) | (uint32(vals[73]&255) << 14) | (uint32(vals[74]&255) << 22)
+	dst[20] = (uint32(vals[75]&255) >> 0) | (uint32(vals[76]&255) << 8) | (uint32(vals[77]&255) << 16) | (uint32(vals[78]&63) << 24)
+	dst[21] = (uint32(vals[78]&192) >> 6) | (uint32(vals[79]&255) << 2) | (uint32(vals[80]&255) << 10) | (uint32(vals[81]&255) << 18) | (uint32(vals[82]&15) << 26)
+	dst[22] = (uint32(vals[82]&240) >> 4) | (uint32(vals[83]&255) << 4) | (uint32(vals[84]&255) << 12) | (uint32(vals[85]&255) << 20) | (uint32(vals[86]&3) << 28)
+	dst[23] = (uint32(vals[86]&252) >> 2) | (uint32(vals[87]&255) << 6) | (uint32(vals[88]&255) << 14) | (uint32(vals[89]&255) << 22)
+	dst[24] = (uint32(vals[90]&255) >> 0) | (uint32(vals[91]&255) << 8) | (uint32(vals[92]&255) << 16) | (uint32(vals[93]&63) << 24)
+	dst[25] = (uint32(vals[93]&192) >> 6) | (uint32(vals[94]&255) << 2) | (uint32(vals[95]&255) << 10) | (uint32(vals[96]&255) << 18) | (uint32(vals[97]&15) << 26)
+	dst[26] = (uint32(vals[97]&240) >> 4) | (uint32(vals[98]&255) << 4) | (uint32(vals[99]&255) << 12) | (uint32(vals[100]&255) << 20) | (uint32(vals[101]&3) << 28)
+	dst[27] = (uint32(vals[101]&252) >> 2) | (uint32(vals[102]&255) << 6) | (uint32(vals[103]&255) << 14) | (uint32(vals[104]&255) << 22)
+	dst[28] = (uint32(vals[105]&255) >> 0) | (uint32(vals[106]&255) << 8) | (uint32(vals[107]&255) << 16) | (uint32(vals[108]&63) << 24)
+	dst[29] = (uint32(vals[108]&192) >> 6) | (uint32(vals[109]&255) << 2) | (uint32(vals[110]&255) << 10) | (uint32(vals[111]&255) << 18) | (uint32(vals[112]&15) << 26)
+	dst[30] = (uint32(vals[112]&240) >> 4) | (uint32(vals[113]&255) << 4) | (uint32(vals[114]&255) << 12) | (uint32(vals[115]&255) << 20) | (uint32(vals[116]&3) << 28)
+	dst[31] = (uint32(vals[116]&252) >> 2) | (uint32(vals[117]&255) << 6) | (uint32(vals[118]&255) << 14) | (uint32(vals[119]&255) << 22)
+}
+
+func unpack31(dst []uint32, vals []byte) {
+	_ = vals[30]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&127) << 24)
+	dst[1] = (uint32(vals[3]&128) >> 7) | (uint32(vals[4]&255) << 1) | (uint32(vals[5]&255) << 9) | (uint32(vals[6]&255) << 17) | (uint32(vals[7]&63) << 25)
+	dst[2] = (uint32(vals[7]&192) >> 6) | (uint32(vals[8]&255) << 2) | (uint32(vals[9]&255) << 10) | (uint32(vals[10]&255) << 18) | (uint32(vals[11]&31) << 26)
+	dst[3] = (uint32(vals[11]&224) >> 5) | (uint32(vals[12]&255) << 3) | (uint32(vals[13]&255) << 11) | (uint32(vals[14]&255) << 19) | (uint32(vals[15]&15) << 27)
+	dst[4] = (uint32(vals[15]&240) >> 4) | (uint32(vals[16]&255) << 4) | (uint32(vals[17]&255) << 12) | (uint32(vals[18]&255) << 20) | (uint32(vals[19]&7) << 28)
+	dst[5] = (uint32(vals[19]&248) >> 3) | (uint32(vals[20]&255) << 5) | (uint32(vals[21]&255) << 13) | (uint32(vals[22]&255) << 21) | (uint32(vals[23]&3) << 29)
+	dst[6] = (uint32(vals[23]&252) >> 2) | (uint32(vals[24]&255) << 6) | (uint32(vals[25]&255) << 14) | (uint32(vals[26]&255) << 22) | (uint32(vals[27]&1) << 30)
+	dst[7] = (uint32(vals[27]&254) >> 1) | (uint32(vals[28]&255) << 7) | (uint32(vals[29]&255) << 15) | (uint32(vals[30]&255) << 23)
+}
+
+func unpack32x31(dst []uint32, vals []byte) {
+	_ = vals[123]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&127) << 24)
+	dst[1] = (uint32(vals[3]&128) >> 7) | (uint32(vals[4]&255) << 1) | (uint32(vals[5]&255) << 9) | (uint32(vals[6]&255) << 17) | (uint32(vals[7]&63) << 25)
+	dst[2] = (uint32(vals[7]&192) >> 6) | (uint32(vals[8]&255) << 2) | (uint32(vals[9]&255) << 10) | (uint32(vals[10]&255) << 18) | (uint32(vals[11]&31) << 26)
+	dst[3] = (uint32(vals[11]&224) >> 5) | (uint32(vals[12]&255) << 3) | (uint32(vals[13]&255) << 11) | (uint32(vals[14]&255) << 19) | (uint32(vals[15]&15) << 27)
+	dst[4] = (uint32(vals[15]&240) >> 4) | (uint32(vals[16]&255) << 4) | (uint32(vals[17]&255) << 12) | (uint32(vals[18]&255) << 20) | (uint32(vals[19]&7) << 28)
+	dst[5] = (uint32(vals[19]&248) >> 3) | (uint32(vals[20]&255) << 5) | (uint32(vals[21]&255) << 13) | (uint32(vals[22]&255) << 21) | (uint32(vals[23]&3) << 29)
+	dst[6] = (uint32(vals[23]&252) >> 2) | (uint32(vals[24]&255) << 6) | (uint32(vals[25]&255) << 14) | (uint32(vals[26]&255) << 22) | (uint32(vals[27]&1) << 30)
+	dst[7] = (uint32(vals[27]&254) >> 1) | (uint32(vals[28]&255) << 7) | (uint32(vals[29]&255) << 15) | (uint32(vals[30]&255) << 23)
+	dst[8] = (uint32(vals[31]&255) >> 0) | (uint32(vals[32]&255) << 8) | (uint32(vals[33]&255) << 16) | (uint32(vals[34]&127) << 24)
+	dst[9] = (uint32(vals[34]&128) >> 7) | (uint32(vals[35]&255) << 1) | (uint32(vals[36]&255) << 9) | (uint32(vals[37]&255) << 17) | (uint32(vals[38]&63) << 25)
+	dst[10] = (uint32(vals[38]&192) >> 6) | (uint32(vals[39]&255) << 2) | (uint32(vals[40]&255) << 10) | (uint32(vals[41]&255) << 18) | (uint32(vals[42]&31) << 26)
+	dst[11] = (uint32(vals[42]&224) >> 5) | (uint32(vals[43]&255) << 3) | (uint32(vals[44]&255) << 11) | (uint32(vals[45]&255) << 19) | (uint32(vals[46]&15) << 27)
+	dst[12] = (uint32(vals[46]&240) >> 4) | (uint32(vals[47]&255) << 4) | (uint32(vals[48]&255) << 12) | (uint32(vals[49]&255) << 20) | (uint32(vals[50]&7) << 28)
+	dst[13] = (uint32(vals[50]&248) >> 3) | (uint32(vals[51]&255) << 5) | (uint32(vals[52]&255) << 13) | (uint32(vals[53]&255) << 21) | (uint32(vals[54]&3) << 29)
+	dst[14] = (uint32(vals[54]&252) >> 2) | (uint32(vals[55]&255) << 6) | (uint32(vals[56]&255) << 14) | (uint32(vals[57]&255) << 22) | (uint32(vals[58]&1) << 30)
+	dst[15] = (uint32(vals[58]&254) >> 1) | (uint32(vals[59]&255) << 7) | (uint32(vals[60]&255) << 15) | (uint32(vals[61]&255) << 23)
+	dst[16] = (uint32(vals[62]&255) >> 0) | (uint32(vals[63]&255) << 8) | (uint32(vals[64]&255) << 16) | (uint32(vals[65]&127) << 24)
+	dst[17] = (uint32(vals[65]&128) >> 7) | (uint32(vals[66]&255) << 1) | (uint32(vals[67]&255) << 9) | (uint32(vals[68]&255) << 17) | (uint32(vals[69]&63) << 25)
+	dst[18] = (uint32(vals[69]&192) >> 6) | (uint32(vals[70]&255) << 2) | (uint32(vals[71]&255) << 10) | (uint32(vals[72]&255) << 18) | (uint32(vals[73]&31) << 26)
+	dst[19] = (uint32(vals[73]&224) >> 5) | (uint32(vals[74]&255) << 3) | (uint32(vals[75]&255) << 11) | (uint32(vals[76]&255) << 19) | (uint32(vals[77]&15) << 27)
+	dst[20] = (uint32(vals[77]&240) >> 4) | (uint32(vals[78]&255) << 4) | (uint32(vals[79]&255) << 12) | (uint32(vals[80]&255) << 20) | (uint32(vals[81]&7) << 28)
+	dst[21] = (uint32(vals[81]&248) >> 3) | (uint32(vals[82]&255) << 5) | (uint32(vals[83]&255) << 13) | (uint32(vals[84]&255) << 21) | (uint32(vals[85]&3) << 29)
+	dst[22] = (uint32(vals[85]&252) >> 2) | (uint32(vals[86]&255) << 6) | (uint32(vals[87]&255) << 14) | (uint32(vals[88]&255) << 22) | (uint32(vals[89]&1) << 30)
+	dst[23] = (uint32(vals[89]&254) >> 1) | (uint32(vals[90]&255) << 7) | (uint32(vals[91]&255) << 15) | (uint32(vals[92]&255) << 23)
+	dst[24] = (uint32(vals[93]&255) >> 0) | (uint32(vals[94]&255) << 8) | (uint32(vals[95]&255) << 16) | (uint32(vals[96]&127) << 24)
+	dst[25] = (uint32(vals[96]&128) >> 7) | (uint32(vals[97]&255) << 1) | (uint32(vals[98]&255) << 9) | (uint32(vals[99]&255) << 17) | (uint32(vals[100]&63) << 25)
+	dst[26] = (uint32(vals[100]&192) >> 6) | (uint32(vals[101]&255) << 2) | (uint32(vals[102]&255) << 10) | (uint32(vals[103]&255) << 18) | (uint32(vals[104]&31) << 26)
+	dst[27] = (uint32(vals[104]&224) >> 5) | (uint32(vals[105]&255) << 3) | (uint32(vals[106]&255) << 11) | (uint32(vals[107]&255) << 19) | (uint32(vals[108]&15) << 27)
+	dst[28] = (uint32(vals[108]&240) >> 4) | (uint32(vals[109]&255) << 4) | (uint32(vals[110]&255) << 12) | (uint32(vals[111]&255) << 20) | (uint32(vals[112]&7) << 28)
+	dst[29] = (uint32(vals[112]&248) >> 3) | (uint32(vals[113]&255) << 5) | (uint32(vals[114]&255) << 13) | (uint32(vals[115]&255) << 21) | (uint32(vals[116]&3) << 29)
+	dst[30] = (uint32(vals[116]&252) >> 2) | (uint32(vals[117]&255) << 6) | (uint32(vals[118]&255) << 14) | (uint32(vals[119]&255) << 22) | (uint32(vals[120]&1) << 30)
+	dst[31] = (uint32(vals[120]&254) >> 1) | (uint32(vals[121]&255) << 7) | (uint32(vals[122]&255) << 15) | (uint32(vals[123]&255) << 23)
+}
+
+func unpack32(dst []uint32, vals []byte) {
+	_ = vals[31]
+	_ = dst[7]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&255) << 24)
+	dst[1] = (uint32(vals[4]&255) >> 0) | (uint32(vals[5]&255) << 8) | (uint32(vals[6]&255) << 16) | (uint32(vals[7]&255) << 24)
+	dst[2] = (uint32(vals[8]&255) >> 0) | (uint32(vals[9]&255) << 8) | (uint32(vals[10]&255) << 16) | (uint32(vals[11]&255) << 24)
+	dst[3] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8) | (uint32(vals[14]&255) << 16) | (uint32(vals[15]&255) << 24)
+	dst[4] = (uint32(vals[16]&255) >> 0) | (uint32(vals[17]&255) << 8) | (uint32(vals[18]&255) << 16) | (uint32(vals[19]&255) << 24)
+	dst[5] = (uint32(vals[20]&255) >> 0) | (uint32(vals[21]&255) << 8) | (uint32(vals[22]&255) << 16) | (uint32(vals[23]&255) << 24)
+	dst[6] = (uint32(vals[24]&255) >> 0) | (uint32(vals[25]&255) << 8) | (uint32(vals[26]&255) << 16) | (uint32(vals[27]&255) << 24)
+	dst[7] = (uint32(vals[28]&255) >> 0) | (uint32(vals[29]&255) << 8) | (uint32(vals[30]&255) << 16) | (uint32(vals[31]&255) << 24)
+}
+
+func unpack32x32(dst []uint32, vals []byte) {
+	_ = vals[127]
+	_ = dst[31]
+	dst[0] = (uint32(vals[0]&255) >> 0) | (uint32(vals[1]&255) << 8) | (uint32(vals[2]&255) << 16) | (uint32(vals[3]&255) << 24)
+	dst[1] = (uint32(vals[4]&255) >> 0) | (uint32(vals[5]&255) << 8) | (uint32(vals[6]&255) << 16) | (uint32(vals[7]&255) << 24)
+	dst[2] = (uint32(vals[8]&255) >> 0) | (uint32(vals[9]&255) << 8) | (uint32(vals[10]&255) << 16) | (uint32(vals[11]&255) << 24)
+	dst[3] = (uint32(vals[12]&255) >> 0) | (uint32(vals[13]&255) << 8) | (uint32(vals[14]&255) << 16) | (uint32(vals[15]&255) << 24)
+	dst[4] = (uint32(vals[16]&255) >> 0) | (uint32(vals[17]&255) << 8) | (uint32(vals[18]&255) << 16) | (uint32(vals[19]&255) << 24)
+	dst[5] = (uint32(vals[20]&255) >> 0) | (uint32(vals[21]&255) << 8) | (uint32(vals[22]&255) << 16) | (uint32(vals[23]&255) << 24)
+	dst[6] = (uint32(vals[24]&255) >> 0) | (uint32(vals[25]&255) << 8) | (uint32(vals[26]&255) << 16) | (uint32(vals[27]&255) << 24)
+	dst[7] = (uint32(vals[28]&255) >> 0) | (uint32(vals[29]&255) << 8) | (uint32(vals[30]&255) << 16) | (uint32(vals[31]&255) << 24)
+	dst[8] = (uint32(vals[32]&255) >> 0) | (uint32(vals[33]&255) << 8) | (uint32(vals[34]&255) << 16) | (uint32(vals[35]&255) << 24)
+	dst[9] = (uint32(vals[36]&255) >> 0) | (uint32(vals[37]&255) << 8) | (uint32(vals[38]&255) << 16) | (uint32(vals[39]&255) << 24)
+	dst[10] = (uint32(vals[40]&255) >> 0) | (uint32(vals[41]&255) << 8) | (uint32(vals[42]&255) << 16) | (uint32(vals[43]&255) << 24)
+	dst[11] = (uint32(vals[44]&255) >> 0) | (uint32(vals[45]&255) << 8) | (uint32(vals[46]&255) << 16) | (uint32(vals[47]&255) << 24)
+	dst[12] = (uint32(vals[48]&255) >> 0) | (uint32(vals[49]&255) << 8) | (uint32(vals[50]&255) << 16) | (uint32(vals[51]&255) << 24)
+	dst[13] = (uint32(vals[52]&255) >> 0) | (uint32(vals[53]&255) << 8) | (uint32(vals[54]&255) << 16) | (uint32(vals[55]&255) << 24)
+	dst[14] = (uint32(vals[56]&255) >> 0) | (uint32(vals[57]&255) << 8) | (uint32(vals[58]&255) << 16) | (uint32(vals[59]&255) << 24)
+	dst[15] = (uint32(vals[60]&255) >> 0) | (uint32(vals[61]&255) << 8) | (uint32(vals[62]&255) << 16) | (uint32(vals[63]&255) << 24)
+	dst[16] = (uint32(vals[64]&255) >> 0) | (uint32(vals[65]&255) << 8) | (uint32(vals[66]&255) << 16) | (uint32(vals[67]&255) << 24)
+	dst[17] = (uint32(vals[68]&255) >> 0) | (uint32(vals[69]&255) << 8) | (uint32(vals[70]&255) << 16) | (uint32(vals[71]&255) << 24)
+	dst[18] = (uint32(vals[72]&255) >> 0) | (uint32(vals[73]&255) << 8) | (uint32(vals[74]&255) << 16) | (uint32(vals[75]&255) << 24)
+	dst[19] = (uint32(vals[76]&255) >> 0) | (uint32(vals[77]&255) << 8) | (uint32(vals[78]&255) << 16) | (uint32(vals[79]&255) << 24)
+	dst[20] = (uint32(vals[80]&255) >> 0) | (uint32(vals[81]&255) << 8) | (uint32(vals[82]&255) << 16) | (uint32(vals[83]&255) << 24)
+	dst[21] = (uint32(vals[84]&255) >> 0) | (uint32(vals[85]&255) << 8) | (uint32(vals[86]&255) << 16) | (uint32(vals[87]&255) << 24)
+	dst[22] = (uint32(vals[88]&255) >> 0) | (uint32(vals[89]&255) << 8) | (uint32(vals[90]&255) << 16) | (uint32(vals[91]&255) << 24)
+	dst[23] = (uint32(vals[92]&255) >> 0) | (uint32(vals[93]&255) << 8) | (uint32(vals[94]&255) << 16) | (uint32(vals[95]&255) << 24)
+	dst[24] = (uint32(vals[96]&255) >> 0) | (uint32(vals[97]&255) << 8) | (uint32(vals[98]&255) << 16) | (uint32(vals[99]&255) << 24)
+	dst[25] = (uint32(vals[100]&255) >> 0) | (uint32(vals[101]&255) << 8) | (uint32(vals[102]&255) << 16) | (uint32(vals[103]&255) << 24)
+	dst[26] = (uint32(vals[104]&255) >> 0) | (uint32(vals[105]&255) << 8) | (uint32(vals[106]&255) << 16) | (uint32(vals[107]&255) << 24)
+	dst[27] = (uint32(vals[108]&255) >> 0) | (uint32(vals[109]&255) << 8) | (uint32(vals[110]&255) << 16) | (uint32(vals[111]&255) << 24)
+	dst[28] = (uint32(vals[112]&255) >> 0) | (uint32(vals[113]&255) << 8) | (uint32(vals[114]&255) << 16) | (uint32(vals[115]&255) << 24)
+	dst[29] = (uint32(vals[116]&255) >> 0) | (uint32(vals[117]&255) << 8) | (uint32(vals[118]&255) << 16) | (uint32(vals[119]&255) << 24)
+	dst[30] = (uint32(vals[120]&255) >> 0) | (uint32(vals[121]&255) << 8) | (uint32(vals[122]&255) << 16) | (uint32(vals[123]&255) << 24)
+	dst[31] = (uint32(vals[124]&255) >> 0) | (uint32(vals[125]&255) << 8) | (uint32(vals[126]&255) << 16) | (uint32(vals[127]&255) << 24)
 }
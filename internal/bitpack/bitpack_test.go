@@ -12,7 +12,7 @@ import (
 type testCase struct {
 	name  string
 	width int
-	ints  []uint8
+	ints  []uint32
 	bytes []byte
 }
 
@@ -21,25 +21,52 @@ func TestPackAndUnpack(t *testing.T) {
 		{
 			name:  "width 1",
 			width: 1,
-			ints:  []uint8{0, 1, 1, 0, 0, 1, 1, 1},
+			ints:  []uint32{0, 1, 1, 0, 0, 1, 1, 1},
 			bytes: getBytes("11100110"),
 		},
 		{
 			name:  "width 2",
 			width: 2,
-			ints:  []uint8{0, 1, 2, 0, 0, 1, 2, 2},
+			ints:  []uint32{0, 1, 2, 0, 0, 1, 2, 2},
 			bytes: getBytes("00100100", "10100100"),
 		},
 		{
 			name:  "width 3 from apache documentation",
 			width: 3,
-			ints:  []uint8{0, 1, 2, 3, 4, 5, 6, 7},
+			ints:  []uint32{0, 1, 2, 3, 4, 5, 6, 7},
 			bytes: getBytes("10001000", "11000110", "11111010"),
 		},
 		{
 			name:  "width 4",
 			width: 4,
-			ints:  []uint8{0, 2, 4, 7, 14, 15, 1, 0},
+			ints:  []uint32{0, 2, 4, 7, 14, 15, 1, 0},
+		},
+		{
+			name:  "width 8",
+			width: 8,
+			ints:  []uint32{0, 1, 2, 127, 128, 200, 254, 255},
+			bytes: []byte{0, 1, 2, 127, 128, 200, 254, 255},
+		},
+		{
+			name:  "width 9",
+			width: 9,
+			ints:  []uint32{511, 0, 256, 1, 2, 300, 4, 255},
+		},
+		{
+			name:  "width 16",
+			width: 16,
+			ints:  []uint32{1, 256, 65535, 0, 2, 3, 4, 5},
+			bytes: []byte{1, 0, 0, 1, 255, 255, 0, 0, 2, 0, 3, 0, 4, 0, 5, 0},
+		},
+		{
+			name:  "width 27",
+			width: 27,
+			ints:  []uint32{1<<27 - 1, 0, 1 << 26, 12345678, 1, 2, 3, 1<<27 - 2},
+		},
+		{
+			name:  "width 32",
+			width: 32,
+			ints:  []uint32{1<<32 - 1, 0, 1 << 31, 12345678, 1, 2, 3, 4},
 		},
 	}
 
@@ -55,6 +82,16 @@ func TestPackAndUnpack(t *testing.T) {
 	}
 }
 
+func TestAllWidths(t *testing.T) {
+	for width := 1; width <= 32; width++ {
+		max := uint32(1<<uint(width) - 1)
+		ints := []uint32{max, 0, max / 2, 1, max - 1, max / 3, 0, max}
+		b := bitpack.Pack(width, ints)
+		assert.Len(t, b, width)
+		assert.Equal(t, ints, bitpack.Unpack(width, b), fmt.Sprintf("width %d", width))
+	}
+}
+
 func getBytes(vals ...string) []byte {
 	out := make([]byte, len(vals))
 	for i, s := range vals {
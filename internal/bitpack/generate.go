@@ -1,3 +1,3 @@
 package bitpack
 
-//go:generate bitpackgen -package bitpack -maxwidth 4
+//go:generate bitpackgen -package bitpack -maxwidth 32
@@ -2,9 +2,12 @@ package dremel_test
 
 import (
 	"bytes"
+	"io/ioutil"
 	"log"
+	"os"
 	"testing"
 
+	"github.com/parsyl/parquet"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -111,6 +114,84 @@ func TestDremel(t *testing.T) {
 	assert.Equal(t, dremelDocs, out)
 }
 
+// TestBaseline reads testdata/baseline.parquet, which has the dremel
+// example written by an older version of this package.  Its schema has
+// a field id of 0 for every column and the wrong number of children for
+// names, which is nested more than one level deep.
+func TestBaseline(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/baseline.parquet")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, opts := range [][]func(*ParquetReader){nil, {StrictSchema}} {
+		pr, err := NewParquetReader(bytes.NewReader(b), opts...)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var out []Document
+		for pr.Next() {
+			var d Document
+			pr.Scan(&d)
+			out = append(out, d)
+		}
+		assert.NoError(t, pr.Error())
+		assert.Equal(t, dremelDocs, out)
+	}
+}
+
+// TestAppendBaseline appends to a copy of testdata/baseline.parquet.
+func TestAppendBaseline(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/baseline.parquet")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	f, err := ioutil.TempFile("", "parquet")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = f.Write(b)
+	assert.NoError(t, err)
+
+	pw, err := NewParquetAppender(f)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, doc := range dremelDocs {
+		pw.Add(doc)
+	}
+	assert.NoError(t, pw.Write())
+	assert.NoError(t, pw.Close())
+
+	// the new footer has the schema that is written now
+	footer, err := parquet.ReadMetaData(f)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = parquet.SchemaFields(footer.Schema)
+	assert.NoError(t, err)
+
+	pr, err := NewParquetReader(f, StrictSchema)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var out []Document
+	for pr.Next() {
+		var d Document
+		pr.Scan(&d)
+		out = append(out, d)
+	}
+	assert.NoError(t, pr.Error())
+	assert.Equal(t, append(dremelDocs, dremelDocs...), out)
+}
+
 type Link struct {
 	Backward []int64 `parquet:"backward"`
 	Forward  []int64 `parquet:"forward"`
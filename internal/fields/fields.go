@@ -20,6 +20,25 @@ type Field struct {
 	FieldType       string
 	ParquetType     string
 	Category        string
+	// FieldIDs holds the field id (the id tag option) of each of
+	// ColumnNames, -1 where there isn't one.  It is nil if there
+	// aren't any.
+	FieldIDs []int
+	// Compression is the codec of the column (the compression tag
+	// option).  The writer's codec is used if it is "".
+	Compression string
+	// NoStats is set by the stats=false tag option.
+	NoStats bool
+	// Dictionary is set by the encoding=dict tag option.
+	Dictionary bool
+	// Bloom is set by the bloom=true tag option.
+	Bloom bool
+	// TimeUnit is the unit (millis, micros or nanos) of a
+	// time.Time column, set by the unit tag option.
+	TimeUnit string
+	// Local is set by the utc=false tag option of a time.Time
+	// column (its timestamps aren't adjusted to UTC).
+	Local bool
 }
 
 type input struct {
@@ -276,6 +295,17 @@ func (f Field) Path() string {
 	return strings.Join(out, ", ")
 }
 
+// IDs is called by parquetgen's templates to generate the
+// field ids of a column (for example: -1, 7).  It is empty
+// if the column doesn't have any.
+func (f Field) IDs() string {
+	out := make([]string, len(f.FieldIDs))
+	for i, id := range f.FieldIDs {
+		out[i] = fmt.Sprintf("%d", id)
+	}
+	return strings.Join(out, ", ")
+}
+
 // start calculates which nested field is
 // being written to based on the definition
 // level and which parent fields have already
@@ -388,12 +418,22 @@ func (f field) Primitive() bool {
 }
 
 var primitiveTypes = map[string]bool{
-	"bool":    true,
-	"int32":   true,
-	"uint32":  true,
-	"int64":   true,
-	"uint64":  true,
-	"float32": true,
-	"float64": true,
-	"string":  true,
+	"bool":      true,
+	"int32":     true,
+	"uint32":    true,
+	"int64":     true,
+	"uint64":    true,
+	"float32":   true,
+	"float64":   true,
+	"string":    true,
+	"time.Time": true,
+}
+
+// Ptr is called by parquetgen's go templates to get the name of the
+// function that returns a pointer to a primitive (for example: pint32).
+func (f field) Ptr() string {
+	if f.Type == "time.Time" {
+		return "ptime"
+	}
+	return "p" + f.Type
 }
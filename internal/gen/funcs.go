@@ -22,12 +22,24 @@ var (
 			return cases.Camel(strings.Replace(strings.Replace(s, "*", "", 1), "[]", "", 1))
 		},
 		"dedupe": dedupe,
+		"isFloat": func(s string) bool {
+			return strings.Contains(s, "float")
+		},
 		"compressionFunc": func(f fields.Field) string {
+			switch f.Compression {
+			case "snappy":
+				return fieldOpt(f, "Snappy")
+			case "zstd":
+				return fieldOpt(f, "Zstd")
+			case "uncompressed":
+				return fieldOpt(f, "Uncompressed")
+			}
 			if strings.Contains(f.FieldType, "Optional") {
-				return "optionalFieldCompression"
+				return "optionalFieldCompression(compression)"
 			}
-			return "fieldCompression"
+			return "fieldCompression(compression)"
 		},
+		"fieldOpt": fieldOpt,
 		"funcName": func(f fields.Field) string {
 			return strings.Join(f.FieldNames, "")
 		},
@@ -43,19 +55,29 @@ var (
 		},
 		"imports": func(fields []fields.Field) []string {
 			var out []string
-			var intFound, stringFound bool
 			for _, f := range fields {
-				if !intFound && strings.Contains(f.TypeName, "int") {
-					intFound = true
+				if strings.Contains(f.TypeName, "float") {
 					out = append(out, `"math"`)
-				}
-				if !stringFound && strings.Contains(f.TypeName, "string") {
-					stringFound = true
-					out = append(out, `"sort"`)
+					break
 				}
 			}
+			if hasTime(fields) {
+				out = append(out, `"time"`)
+			}
 			return out
 		},
+		"hasTime": hasTime,
+		"timeUnit": func(f fields.Field) string {
+			switch f.TimeUnit {
+			case "millis":
+				return "parquet.Millis"
+			case "nanos":
+				return "parquet.Nanos"
+			case "int96":
+				return "parquet.Int96"
+			}
+			return "parquet.Micros"
+		},
 		"maxType": func(f fields.Field) string {
 			var out string
 			switch f.TypeName {
@@ -87,3 +109,22 @@ var (
 		},
 	}
 )
+
+// fieldOpt returns the name of one of the parquet.RequiredField or
+// parquet.OptionalField options (for example parquet.OptionalFieldIDs).
+func fieldOpt(f fields.Field, name string) string {
+	if !f.Required() {
+		return "parquet.OptionalField" + name
+	}
+	return "parquet.RequiredField" + name
+}
+
+// hasTime reports whether any of fields is a time.Time.
+func hasTime(fields []fields.Field) bool {
+	for _, f := range fields {
+		if strings.Contains(f.TypeName, "time.Time") {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"strings"
 	"text/template"
 
 	"github.com/parsyl/parquet"
@@ -23,6 +24,7 @@ var (
 		"FLOAT":      "float32",
 		"DOUBLE":     "float64",
 		"BYTE_ARRAY": "string",
+		"INT96":      "time.Time",
 	}
 )
 
@@ -69,6 +71,10 @@ func FromStruct(pth, outPth, typ, pkg, imp string, ignore bool) {
 		boolOptionalStatsTpl,
 		stringStatsTpl,
 		stringOptionalStatsTpl,
+		timeTpl,
+		timeOptionalTpl,
+		timeStatsTpl,
+		timeOptionalStatsTpl,
 	} {
 		var err error
 		tmpl, err = tmpl.Parse(t)
@@ -126,6 +132,7 @@ func FromParquet(parq, pth, outPth, typ, pkg, imp string, ignore bool) {
 		Package: pkg,
 		Structs: structs.Struct(typ, footer.Schema),
 	}
+	n.Time = strings.Contains(n.Structs, "time.Time")
 
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, n)
@@ -204,6 +211,8 @@ type newStruct struct {
 	Package string
 	Structs string
 	Fields  []fields.Field
+	// Time is true if any of the structs have a time.Time field.
+	Time bool
 }
 
 type fieldType struct {
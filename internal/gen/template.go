@@ -1,6 +1,6 @@
 package gen
 
-var newFieldTpl = `{{define "newField"}}New{{.FieldType}}({{readFuncName .}}, {{writeFuncName .}}, []string{ {{.Path}} }{{if not .Required}}, []int{ {{joinTypes .RepetitionTypes}} }{{end}}, {{compressionFunc .}}(compression)),{{end}}`
+var newFieldTpl = `{{define "newField"}}New{{.FieldType}}({{readFuncName .}}, {{writeFuncName .}}, []string{ {{.Path}} }{{if not .Required}}, []int{ {{joinTypes .RepetitionTypes}} }{{end}}{{if .TimeUnit}}, {{timeUnit .}}, {{not .Local}}{{end}}, {{compressionFunc .}}{{if .FieldIDs}}, {{fieldOpt . "IDs"}}({{.IDs}}){{end}}{{if .NoStats}}, {{fieldOpt . "NoStats"}}{{end}}{{if .Dictionary}}, {{fieldOpt . "Dictionary"}}{{end}}{{if .Bloom}}, {{fieldOpt . "Bloom"}}{{end}}),{{end}}`
 
 var tpl = `package {{.Package}}
 
@@ -10,8 +10,9 @@ import (
 	"fmt"
 	"io"
 	"bytes"
+	"sort"
 	"strings"
-	"encoding/binary"
+	"sync"
 
 	"github.com/parsyl/parquet"
 	sch "github.com/parsyl/parquet/schema"
@@ -25,6 +26,7 @@ type compression int
 const (
 	compressionUncompressed compression = 0
 	compressionSnappy       compression = 1
+	compressionZstd         compression = 2
 	compressionUnknown      compression = -1
 )
 
@@ -44,6 +46,20 @@ type ParquetWriter struct {
 	meta *parquet.Metadata
 	w    io.Writer
 	compression compression
+
+	// workers is the number of goroutines that encode and
+	// compress column chunks during Write.
+	workers int
+
+	encryption *parquet.Encryption
+	checksums  bool
+	statsSize  int
+
+	// sorting is the order (see SortBy) of the rows of each row
+	// group.  The rows are kept in rows until Write sorts them.
+	sorting    []parquet.SortingColumn
+	sortFields []Field
+	rows       []{{.Type}}
 }
 
 func Fields(compression compression) []Field {
@@ -64,6 +80,8 @@ func fieldCompression(c compression) func(*parquet.RequiredField) {
 		return parquet.RequiredFieldUncompressed
 	case compressionSnappy:
 		return parquet.RequiredFieldSnappy
+	case compressionZstd:
+		return parquet.RequiredFieldZstd
 	default:
 		return parquet.RequiredFieldUncompressed
 	}
@@ -75,6 +93,8 @@ func optionalFieldCompression(c compression) func(*parquet.OptionalField) {
 		return parquet.OptionalFieldUncompressed
 	case compressionSnappy:
 		return parquet.OptionalFieldSnappy
+	case compressionZstd:
+		return parquet.OptionalFieldZstd
 	default:
 		return parquet.OptionalFieldUncompressed
 	}
@@ -84,11 +104,43 @@ func NewParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*Parquet
 	return newParquetWriter(w, append(opts, begin)...)
 }
 
+// NewParquetAppender creates a ParquetWriter that adds row groups to the
+// end of an existing parquet file that has the same schema.  The new row
+// groups are written where the file's footer was, and Close writes a footer
+// that has both the existing and the new row groups.  rw must have a
+// Truncate method (*os.File does), which removes the old footer.
+func NewParquetAppender(rw io.ReadWriteSeeker, opts ...func(*ParquetWriter) error) (*ParquetWriter, error) {
+	t, ok := rw.(interface{ Truncate(int64) error })
+	if !ok {
+		return nil, fmt.Errorf("NewParquetAppender requires an io.ReadWriteSeeker that also has a Truncate method")
+	}
+
+	p, err := newParquetWriter(rw, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := p.meta.Append(rw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.Truncate(offset); err != nil {
+		return nil, err
+	}
+
+	if _, err := rw.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func newParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*ParquetWriter, error) {
 	p := &ParquetWriter{
 		max:         1000,
 		w:           w,
 		compression: compressionSnappy,
+		workers:     1,
 	}
 
 	for _, opt := range opts {
@@ -105,6 +157,28 @@ func newParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*Parquet
 			schema[i] = f.Schema()
 		}
 		p.meta = parquet.New(schema...)
+		if p.encryption != nil {
+			if err := p.meta.Encrypt(p.encryption); err != nil {
+				return nil, err
+			}
+		}
+		if p.checksums {
+			p.meta.WriteChecksums()
+		}
+		if p.statsSize > 0 {
+			p.meta.TruncateStats(p.statsSize)
+		}
+	}
+
+	if len(p.sorting) > 0 {
+		if err := p.meta.SortingColumns(p.sorting); err != nil {
+			return nil, err
+		}
+
+		fields := getFields(p.fields)
+		for _, col := range p.sorting {
+			p.sortFields = append(p.sortFields, fields[col.Column])
+		}
 	}
 
 	return p, nil
@@ -118,8 +192,56 @@ func MaxPageSize(m int) func(*ParquetWriter) error {
 	}
 }
 
+// Concurrency is the number of goroutines used to encode and compress
+// the columns of a row group.  Each column chunk is buffered in memory
+// and then written to the io.Writer in schema order.
+func Concurrency(n int) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		if n < 1 {
+			return fmt.Errorf("concurrency must be at least 1, got %d", n)
+		}
+		p.workers = n
+		return nil
+	}
+}
+
+// Encrypt writes the file with parquet modular encryption.
+func Encrypt(e *parquet.Encryption) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.encryption = e
+		return nil
+	}
+}
+
+// WriteChecksums stores the CRC32 of each page in its page header.
+func WriteChecksums(p *ParquetWriter) error {
+	p.checksums = true
+	return nil
+}
+
+// TruncateStats limits the min and max statistics of string columns
+// to n bytes.  Longer values are shortened to bounds that are marked
+// as not exact (see parquet.Metadata.TruncateStats).
+func TruncateStats(n int) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.statsSize = n
+		return nil
+	}
+}
+
+// SortBy sorts the rows of each row group by cols (the first column,
+// then the second, and so on) when Write is called and records the
+// order in the row group's SortingColumns.  The rows that are added
+// are held in memory until Write.
+func SortBy(cols ...parquet.SortingColumn) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.sorting = cols
+		return nil
+	}
+}
+
 func begin(p *ParquetWriter) error {
-	_, err := p.w.Write([]byte("PAR1"))
+	_, err := p.w.Write(parquet.Magic(p.encryption))
 	return err
 }
 
@@ -140,6 +262,11 @@ func Snappy(p *ParquetWriter) error {
 	return nil
 }
 
+func Zstd(p *ParquetWriter) error {
+	p.compression = compressionZstd
+	return nil
+}
+
 func withCompression(c compression) func(*ParquetWriter) error {
 	return func(p *ParquetWriter) error {
 		p.compression = c
@@ -148,13 +275,17 @@ func withCompression(c compression) func(*ParquetWriter) error {
 }
 
 func (p *ParquetWriter) Write() error {
-	for i, f := range p.fields {
-		if err := f.Write(p.w, p.meta); err != nil {
+	if len(p.sorting) > 0 {
+		p.sortRows()
+	}
+
+	if p.workers > 1 {
+		if err := p.writeConcurrent(); err != nil {
 			return err
 		}
-
-		for child := p.child; child != nil; child = child.child {
-			if err := child.fields[i].Write(p.w, p.meta); err != nil {
+	} else {
+		for i := range p.fields {
+			if err := p.writeColumn(p.w, i); err != nil {
 				return err
 			}
 		}
@@ -172,23 +303,130 @@ func (p *ParquetWriter) Write() error {
 	return nil
 }
 
+// writeColumn writes the i'th column of this writer and all of its
+// children (the pages of the row group) to w.  The pages of dictionary
+// encoded columns are written by WriteDictionary after the dictionary.
+func (p *ParquetWriter) writeColumn(w io.Writer, i int) error {
+	if err := p.fields[i].Write(w, p.meta); err != nil {
+		return err
+	}
+
+	for child := p.child; child != nil; child = child.child {
+		if err := child.fields[i].Write(w, p.meta); err != nil {
+			return err
+		}
+	}
+	return p.meta.WriteDictionary(w, p.fields[i].Schema().Path)
+}
+
+// writeConcurrent encodes each column into its own buffer using
+// at most p.workers goroutines, then writes the buffers in order.
+func (p *ParquetWriter) writeConcurrent() error {
+	bufs := make([]bytes.Buffer, len(p.fields))
+	errs := make([]error, len(p.fields))
+	sem := make(chan struct{}, p.workers)
+	var wg sync.WaitGroup
+	for i := range p.fields {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = p.writeColumn(&bufs[i], i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range bufs {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if _, err := p.w.Write(bufs[i].Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p *ParquetWriter) Close() error {
 	if err := p.meta.Footer(p.w); err != nil {
 		return err
 	}
 
-	_, err := p.w.Write([]byte("PAR1"))
+	_, err := p.w.Write(parquet.Magic(p.encryption))
 	return err
 }
 
+// Recover copies the complete row groups of a file that was written
+// by a ParquetWriter that was never closed to w and adds a new footer.
+// It returns the number of rows that were recovered (see parquet.Recover).
+func Recover(r io.ReaderAt, size int64, w io.Writer) (int64, error) {
+	ff := Fields(compressionUnknown)
+	schema := make([]parquet.Field, len(ff))
+	for i, f := range ff {
+		schema[i] = f.Schema()
+	}
+	return parquet.Recover(r, size, w, schema...)
+}
+
+// sortRows sorts the rows that were added since the
+// last Write (see SortBy) and adds them to the columns.
+func (p *ParquetWriter) sortRows() {
+	sort.SliceStable(p.rows, func(i, j int) bool {
+		return p.less(p.rows[i], p.rows[j])
+	})
+
+	for _, rec := range p.rows {
+		p.add(rec)
+	}
+	p.rows = p.rows[:0]
+}
+
+// less reports whether a comes before b in the SortBy order.
+func (p *ParquetWriter) less(a, b {{.Type}}) bool {
+	for i, f := range p.sortFields {
+		col := p.sorting[i]
+		aNull, bNull := f.isNull(a), f.isNull(b)
+		switch {
+		case aNull && bNull:
+			continue
+		case aNull:
+			return col.NullsFirst
+		case bNull:
+			return !col.NullsFirst
+		}
+
+		c := f.compare(a, b)
+		if c == 0 {
+			continue
+		}
+		if col.Descending {
+			return c > 0
+		}
+		return c < 0
+	}
+	return false
+}
+
 func (p *ParquetWriter) Add(rec {{.Type}}) {
+	if len(p.sorting) > 0 {
+		p.rows = append(p.rows, rec)
+		return
+	}
+
+	p.add(rec)
+}
+
+func (p *ParquetWriter) add(rec {{.Type}}) {
 	if p.len == p.max {
 		if p.child == nil {
 			// an error can't happen here
 			p.child, _ = newParquetWriter(p.w, MaxPageSize(p.max), withMeta(p.meta), withCompression(p.compression))
 		}
 
-		p.child.Add(rec)
+		p.child.add(rec)
 		return
 	}
 
@@ -208,6 +446,12 @@ type Field interface {
 	Read(r io.ReadSeeker, pg parquet.Page) error
 	Name() string
 	Levels() ([]uint8, []uint8)
+
+	// isNull and compare are used to sort rows (see SortBy).
+	// compare returns -1, 0 or 1 and is only called if neither
+	// row is null.
+	isNull(r {{.Type}}) bool
+	compare(a, b {{.Type}}) int
 }
 
 func getFields(ff []Field) map[string]Field {
@@ -228,6 +472,10 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 		opt(pr)
 	}
 
+	if pr.err != nil {
+		return nil, pr.err
+	}
+
 	schema := make([]parquet.Field, len(ff))
 	for i, f := range ff {
 		pr.fieldNames = append(pr.fieldNames, f.Name())
@@ -235,9 +483,30 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 	}
 
 	meta := parquet.New(schema...)
+	if pr.decryption != nil {
+		meta.Decrypt(pr.decryption)
+	}
+	if pr.verify {
+		meta.VerifyChecksums()
+	}
+	if pr.limits != nil {
+		meta.SetLimits(*pr.limits)
+	}
+	if pr.zeroCopy {
+		meta.ZeroCopyStrings()
+	}
+	if pr.fieldIDs {
+		meta.MatchFieldIDs()
+	}
+
 	if err := meta.ReadFooter(r); err != nil {
 		return nil, err
 	}
+
+	if err := meta.ValidateSchema(pr.strict); err != nil {
+		return nil, err
+	}
+
 	pr.rows = meta.Rows()
 	var err error
 	pr.pages, err = meta.Pages()
@@ -252,15 +521,97 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 	}
 	pr.meta = meta
 
+	if pr.workers > 1 {
+		ra, ok := r.(io.ReaderAt)
+		if !ok {
+			return nil, fmt.Errorf("ReadConcurrency requires an io.ReadSeeker that also implements io.ReaderAt")
+		}
+		pr.ra = ra
+		pr.sem = make(chan struct{}, pr.workers)
+		pr.done = make(chan struct{})
+	}
+
 	return pr, pr.readRowGroup()
 }
 
+// NewParquetReaderAt creates a ParquetReader from an io.ReaderAt that
+// holds size bytes.  The reader keeps its own position, so several
+// readers (in several goroutines) can share one *os.File, memory-mapped
+// region, or any other io.ReaderAt.
+func NewParquetReaderAt(r io.ReaderAt, size int64, opts ...func(*ParquetReader)) (*ParquetReader, error) {
+	return NewParquetReader(io.NewSectionReader(r, 0, size), opts...)
+}
+
 func readerIndex(i int) func(*ParquetReader) {
 	return func(p *ParquetReader) {
 		p.index = i
 	}
 }
 
+// ReadConcurrency decodes up to n row groups ahead of the one being
+// scanned, reading their columns with at most n goroutines.  Rows are
+// still returned by Next and Scan in file order.  The io.ReadSeeker
+// passed to NewParquetReader must also be an io.ReaderAt (*os.File and
+// *bytes.Reader both are).  n must be at least 1.
+func ReadConcurrency(n int) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		if n < 1 {
+			p.err = fmt.Errorf("read concurrency must be at least 1, got %d", n)
+			return
+		}
+		p.workers = n
+	}
+}
+
+// Limits replaces parquet.DefaultLimits, which bound the memory that
+// is allocated while reading each page and the footer.
+func Limits(l parquet.Limits) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		p.limits = &l
+	}
+}
+
+// VerifyChecksums checks the CRC32 of each page that has one.  A
+// mismatch is returned as a *parquet.ChecksumError.
+func VerifyChecksums(p *ParquetReader) {
+	p.verify = true
+}
+
+// ZeroCopyStrings makes the strings that are scanned point into the
+// decompressed data of their column chunk instead of being copied.  The
+// reader never reuses that data, but a string that is kept after it is
+// scanned keeps the whole column chunk in memory, so copy any that are
+// kept for long.
+func ZeroCopyStrings(p *ParquetReader) {
+	p.zeroCopy = true
+}
+
+// StrictSchema makes the reader return an error that wraps
+// parquet.ErrSchemaMismatch when a row group has a column that
+// {{.Type}} doesn't or is missing one of {{.Type}}'s columns.  Without
+// it, columns that {{.Type}} doesn't have are skipped and columns that
+// the file doesn't have are read as zero values (optional fields are
+// left nil), so that files written with an older or newer {{.Type}}
+// can still be read.
+func StrictSchema(p *ParquetReader) {
+	p.strict = true
+}
+
+// MatchFieldIDs matches the columns of the file to the fields of
+// {{.Type}} by their field ids (the id option of the parquet tag)
+// instead of by name, so columns that were renamed can still be read.
+// Fields without an id are matched by name.
+func MatchFieldIDs(p *ParquetReader) {
+	p.fieldIDs = true
+}
+
+// Decrypt reads a file that was written with parquet modular encryption.
+func Decrypt(d *parquet.Decryption) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		p.decryption = d
+	}
+}
+
 // ParquetReader reads one page from a row group.
 type ParquetReader struct {
 	fields         map[string]Field
@@ -276,6 +627,30 @@ type ParquetReader struct {
 
 	r         io.ReadSeeker
 	rowGroups []parquet.RowGroup
+
+	// workers, ra, sem, done and pending are only
+	// used when reading with ReadConcurrency.  done
+	// is closed when a row group can't be read.
+	workers int
+	ra      io.ReaderAt
+	sem     chan struct{}
+	done    chan struct{}
+	pending []chan rowGroup
+
+	decryption *parquet.Decryption
+	verify     bool
+	limits     *parquet.Limits
+	zeroCopy   bool
+	strict     bool
+	fieldIDs   bool
+}
+
+// rowGroup is the result of decoding a row group
+// in the background.
+type rowGroup struct {
+	fields map[string]Field
+	rows   int64
+	err    error
 }
 
 type Levels struct {
@@ -306,36 +681,168 @@ func (p *ParquetReader) Error() error {
 func (p *ParquetReader) readRowGroup() error {
 	p.rowGroupCursor = 0
 
+	if p.workers > 1 {
+		return p.readRowGroupConcurrent()
+	}
+
 	if len(p.rowGroups) == 0 {
 		p.rowGroupCount = 0
 		return nil
 	}
 
 	rg := p.rowGroups[0]
-	p.fields = getFields(Fields(compressionUnknown))
+	fields, cols, pgs, err := p.columns(rg)
+	if err != nil {
+		return err
+	}
+
+	p.fields = fields
 	p.rowGroupCount = rg.Rows
 	p.rowGroupCursor = 0
+	for i, f := range cols {
+		if _, err := p.r.Seek(pgs[i].Offset, io.SeekStart); err != nil {
+			return err
+		}
+
+		if err := f.Read(p.r, pgs[i]); err != nil {
+			return fmt.Errorf("unable to read field %s, err: %w", f.Name(), err)
+		}
+	}
+	p.rowGroups = p.rowGroups[1:]
+	return nil
+}
+
+// columns returns the fields for a row group along with the fields
+// that have a column in it and the pages of those columns.  The
+// fields that don't have a column are filled with zero values.
+func (p *ParquetReader) columns(rg parquet.RowGroup) (map[string]Field, []Field, []parquet.Page, error) {
+	fields := getFields(Fields(compressionUnknown))
+	found := make(map[string]bool, len(fields))
+	var cols []Field
+	var pgs []parquet.Page
 	for _, col := range rg.Columns() {
 		name := strings.Join(col.MetaData.PathInSchema, ".")
-		f, ok := p.fields[name]
-		if !ok {
-			return fmt.Errorf("unknown field: %s", name)
-		}
 		pages := p.pages[name]
 		if len(pages) <= p.index {
 			break
 		}
+		p.pages[name] = pages[1:]
 
-		pg := pages[0]
-		if err := f.Read(p.r, pg); err != nil {
-			return fmt.Errorf("unable to read field %s, err: %s", f.Name(), err)
+		f, ok := fields[p.meta.FieldName(name)]
+		if !ok {
+			if p.strict {
+				return nil, nil, nil, fmt.Errorf("%w: unknown field: %s", parquet.ErrSchemaMismatch, name)
+			}
+			continue
 		}
-		p.pages[name] = p.pages[name][1:]
+
+		found[f.Name()] = true
+		cols = append(cols, f)
+		pgs = append(pgs, pages[0])
 	}
-	p.rowGroups = p.rowGroups[1:]
+
+	var zero {{.Type}}
+	for _, name := range p.fieldNames {
+		if found[name] {
+			continue
+		}
+
+		if p.strict {
+			return nil, nil, nil, fmt.Errorf("%w: missing field: %s", parquet.ErrSchemaMismatch, name)
+		}
+
+		f := fields[name]
+		for i := int64(0); i < rg.Rows; i++ {
+			f.Add(zero)
+		}
+	}
+	return fields, cols, pgs, nil
+}
+
+func (p *ParquetReader) readRowGroupConcurrent() error {
+	// the row groups after one that failed aren't read
+	if p.err != nil {
+		return p.err
+	}
+
+	for len(p.pending) < p.workers && len(p.rowGroups) > 0 {
+		p.pending = append(p.pending, p.decodeRowGroup())
+	}
+
+	if len(p.pending) == 0 {
+		p.rowGroupCount = 0
+		return nil
+	}
+
+	rg := <-p.pending[0]
+	p.pending = p.pending[1:]
+	if rg.err != nil {
+		// stop decoding the row groups after this one
+		close(p.done)
+		p.pending = nil
+		return rg.err
+	}
+
+	p.fields = rg.fields
+	p.rowGroupCount = rg.rows
 	return nil
 }
 
+// decodeRowGroup starts reading the columns of the next row group in
+// the background and returns a channel that receives the result.  No
+// more columns are started once one of them fails or p.done is closed.
+func (p *ParquetReader) decodeRowGroup() chan rowGroup {
+	out := make(chan rowGroup, 1)
+	rg := p.rowGroups[0]
+	p.rowGroups = p.rowGroups[1:]
+
+	fields, cols, pgs, err := p.columns(rg)
+	if err != nil {
+		out <- rowGroup{err: err}
+		return out
+	}
+
+	go func() {
+		errs := make([]error, len(cols))
+		failed := make(chan struct{})
+		var once sync.Once
+		var wg sync.WaitGroup
+	columns:
+		for i := range cols {
+			select {
+			case p.sem <- struct{}{}:
+			case <-failed:
+				break columns
+			case <-p.done:
+				errs[i] = fmt.Errorf("stopped reading field %s after an earlier error", cols[i].Name())
+				break columns
+			}
+
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-p.sem
+					wg.Done()
+				}()
+				if err := cols[i].Read(pgs[i].Section(p.ra), pgs[i]); err != nil {
+					errs[i] = fmt.Errorf("unable to read field %s, err: %w", cols[i].Name(), err)
+					once.Do(func() { close(failed) })
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				out <- rowGroup{err: err}
+				return
+			}
+		}
+		out <- rowGroup{fields: fields, rows: rg.Rows}
+	}()
+	return out
+}
+
 func (p *ParquetReader) Rows() int64 {
 	return p.rows
 }
@@ -386,6 +893,12 @@ func (p *ParquetReader) Scan(x *{{.Type}}) {
 {{if eq .Category "boolOptional"}}
 {{ template "boolOptionalField" .}}
 {{end}}
+{{if eq .Category "time"}}
+{{ template "timeField" .}}
+{{end}}
+{{if eq .Category "timeOptional"}}
+{{ template "timeOptionalField" .}}
+{{end}}
 {{end}}
 
 {{range dedupe .Fields}}
@@ -407,6 +920,12 @@ func (p *ParquetReader) Scan(x *{{.Type}}) {
 {{if eq .Category "boolOptional"}}
 {{ template "boolOptionalStats" .}}
 {{end}}
+{{if eq .Category "time"}}
+{{ template "timeStats" .}}
+{{end}}
+{{if eq .Category "timeOptional"}}
+{{ template "timeOptionalStats" .}}
+{{end}}
 {{end}}
 
 func pint32(i int32) *int32       { return &i }
@@ -417,7 +936,8 @@ func pbool(b bool) *bool          { return &b }
 func pstring(s string) *string    { return &s }
 func pfloat32(f float32) *float32 { return &f }
 func pfloat64(f float64) *float64 { return &f }
-
+{{if hasTime .Fields}}func ptime(t time.Time) *time.Time { return &t }
+{{end}}
 // keeps track of the indices of repeated fields
 // that have already been handled by a previous field
 type indices []int
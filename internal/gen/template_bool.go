@@ -17,7 +17,7 @@ func NewBoolField(read func(r {{.Type}}) {{.TypeName}}, write func(r *{{.Type}},
 }
 
 func (f *BoolField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 
@@ -62,6 +62,21 @@ func (f *BoolField) Add(r {{.Type}}) {
 func (f *BoolField) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
+
+func (f *BoolField) isNull(r {{.Type}}) bool {
+	return false
+}
+
+func (f *BoolField) compare(a, b {{.Type}}) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case !x && y:
+		return -1
+	case x && !y:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var boolStatsTpl = `{{define "boolStats"}}
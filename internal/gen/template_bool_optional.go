@@ -18,7 +18,7 @@ func NewBoolOptionalField(read func(r {{.Type}}) ([]{{removeStar .TypeName}}, []
 }
 
 func (f *BoolOptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *BoolOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -70,6 +70,23 @@ func (f *BoolOptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
 func (f *BoolOptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
+
+func (f *BoolOptionalField) isNull(r {{.Type}}) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *BoolOptionalField) compare(a, b {{.Type}}) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case !x[0] && y[0]:
+		return -1
+	case x[0] && !y[0]:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var boolOptionalStatsTpl = `{{define "boolOptionalStats"}}
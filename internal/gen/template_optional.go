@@ -22,17 +22,11 @@ func New{{.FieldType}}(read func(r {{.Type}}) ([]{{removeStar .TypeName}}, []uin
 }
 
 func (f *{{.FieldType}}) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: {{.ParquetType}}, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: {{.ParquetType}}, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *{{.FieldType}}) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.Encode{{camelCaseRemoveStar .TypeName}}s(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *{{.FieldType}}) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -41,9 +35,7 @@ func (f *{{.FieldType}}) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]{{removeStar .TypeName}}, f.Values()-len(f.vals))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.Decode{{camelCaseRemoveStar .TypeName}}s(f.vals, rr, f.Values()-len(f.vals))
 	return err
 }
 
@@ -71,6 +63,33 @@ func (f *{{.FieldType}}) Scan(r *{{.Type}}) {
 func (f *{{.FieldType}}) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
+
+func (f *{{.FieldType}}) isNull(r {{.Type}}) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *{{.FieldType}}) compare(a, b {{.Type}}) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	{{if isFloat .TypeName}}// NaN sorts after every number, so that the NaNs of
+	// a sorted row group end up together
+	switch xNaN, yNaN := math.IsNaN(float64(x[0])), math.IsNaN(float64(y[0])); {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	}
+	{{end}}switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var optionalStatsTpl = `{{define "optionalStats"}}
@@ -84,7 +103,6 @@ type {{removeStar .TypeName}}optionalStats struct {
 
 func new{{removeStar .TypeName}}optionalStats(d uint8) *{{removeStar .TypeName}}optionalStats {
 	return &{{removeStar .TypeName}}optionalStats{
-		min: {{removeStar .TypeName}}(math.Max{{camelCaseRemoveStar .TypeName}}),
 		maxDef: d,
 	}
 }
@@ -94,25 +112,32 @@ func (f *{{removeStar .TypeName}}optionalStats) add(vals []{{removeStar .TypeNam
 	for _, def := range defs {
 		if def < f.maxDef {
 			f.nils++
-		} else {
-			val := vals[i]
-			i++
-
-			f.nonNils++
-			if val < f.min {
-				f.min = val
-			}
-			if val > f.max {
-				f.max = val
-			}			
+			continue
+		}
+
+		val := vals[i]
+		i++
+		{{if isFloat .TypeName}}// NaN isn't included in the min and max
+		if math.IsNaN(float64(val)) {
+			continue
+		}
+		{{end}}
+		f.nonNils++
+		if f.nonNils == 1 {
+			f.min, f.max = val, val
+			continue
+		}
+		if val < f.min {
+			f.min = val
+		}
+		if val > f.max {
+			f.max = val
 		}
 	}
 }
 
 func (f *{{removeStar .TypeName}}optionalStats) bytes(val {{removeStar .TypeName}}) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.Encode{{camelCaseRemoveStar .TypeName}}s(nil, []{{removeStar .TypeName}}{val})
 }
 
 func (f *{{removeStar .TypeName}}optionalStats) NullCount() *int64 {
@@ -127,13 +152,21 @@ func (f *{{removeStar .TypeName}}optionalStats) Min() []byte {
 	if f.nonNils == 0  {
 		return nil
 	}
-	return f.bytes(f.min)
+	{{if isFloat .TypeName}}// a min of zero is written as -0
+	if f.min == 0 {
+		return f.bytes({{removeStar .TypeName}}(math.Copysign(0, -1)))
+	}
+	{{end}}return f.bytes(f.min)
 }
 
 func (f *{{removeStar .TypeName}}optionalStats) Max() []byte {
 	if f.nonNils == 0  {
 		return nil
 	}
-	return f.bytes(f.max)
+	{{if isFloat .TypeName}}// a max of zero is written as +0
+	if f.max == 0 {
+		return f.bytes(0)
+	}
+	{{end}}return f.bytes(f.max)
 }
 {{end}}`
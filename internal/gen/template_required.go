@@ -19,7 +19,7 @@ func New{{.FieldType}}(read func(r {{.Type}}) {{.TypeName}}, write func(r *{{.Ty
 }
 
 func (f *{{.FieldType}}) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: {{.ParquetType}}, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: {{.ParquetType}}, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *{{.FieldType}}) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -28,20 +28,12 @@ func (f *{{.FieldType}}) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]{{.TypeName}}, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.Decode{{camelCase .TypeName}}s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *{{.FieldType}}) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.Encode{{camelCase .TypeName}}s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *{{.FieldType}}) Scan(r *{{.Type}}) {
@@ -62,21 +54,54 @@ func (f *{{.FieldType}}) Add(r {{.Type}}) {
 func (f *{{.FieldType}}) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
+
+func (f *{{.FieldType}}) isNull(r {{.Type}}) bool {
+	return false
+}
+
+func (f *{{.FieldType}}) compare(a, b {{.Type}}) int {
+	x, y := f.read(a), f.read(b)
+	{{if isFloat .TypeName}}// NaN sorts after every number, so that the NaNs of
+	// a sorted row group end up together
+	switch xNaN, yNaN := math.IsNaN(float64(x)), math.IsNaN(float64(y)); {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	}
+	{{end}}switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var requiredStatsTpl = `{{define "requiredStats"}}
 type {{.TypeName}}stats struct {
 	min {{.TypeName}}
 	max {{.TypeName}}
+	set bool
 }
 
 func new{{camelCase .TypeName}}stats() *{{.TypeName}}stats {
-	return &{{.TypeName}}stats{
-		min: {{.TypeName}}(math.Max{{camelCase .TypeName}}),
-	}
+	return &{{.TypeName}}stats{}
 }
 
 func (i *{{.TypeName}}stats) add(val {{.TypeName}}) {
+	{{if isFloat .TypeName}}// NaN isn't included in the min and max
+	if math.IsNaN(float64(val)) {
+		return
+	}
+
+	{{end}}if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -86,9 +111,7 @@ func (i *{{.TypeName}}stats) add(val {{.TypeName}}) {
 }
 
 func (f *{{.TypeName}}stats) bytes(val {{.TypeName}}) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.Encode{{camelCase .TypeName}}s(nil, []{{.TypeName}}{val})
 }
 
 func (f *{{.TypeName}}stats) NullCount() *int64 {
@@ -100,10 +123,24 @@ func (f *{{.TypeName}}stats) DistinctCount() *int64 {
 }
 
 func (f *{{.TypeName}}stats) Min() []byte {
-	return f.bytes(f.min)
+	if !f.set {
+		return nil
+	}
+	{{if isFloat .TypeName}}// a min of zero is written as -0
+	if f.min == 0 {
+		return f.bytes({{.TypeName}}(math.Copysign(0, -1)))
+	}
+	{{end}}return f.bytes(f.min)
 }
 
 func (f *{{.TypeName}}stats) Max() []byte {
-	return f.bytes(f.max)
+	if !f.set {
+		return nil
+	}
+	{{if isFloat .TypeName}}// a max of zero is written as +0
+	if f.max == 0 {
+		return f.bytes(0)
+	}
+	{{end}}return f.bytes(f.max)
 }
 {{end}}`
@@ -19,20 +19,11 @@ func NewStringField(read func(r {{.Type}}) {{.TypeName}}, write func(r *{{.Type}
 }
 
 func (f *StringField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *StringField) Write(w io.Writer, meta *parquet.Metadata) error {
-	buf := bytes.Buffer{}
-
-	for _, s := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, int32(len(s))); err != nil {
-			return err
-		}
-		buf.Write([]byte(s))
-	}
-
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeStrings(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *StringField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -41,19 +32,8 @@ func (f *StringField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	for j := 0; j < pg.N; j++ {
-		var x int32
-		if err := binary.Read(rr, binary.LittleEndian, &x); err != nil {
-			return err
-		}
-		s := make([]byte, x)
-		if _, err := rr.Read(s); err != nil {
-			return err
-		}
-
-		f.vals = append(f.vals, string(s))
-	}
-	return nil
+	f.vals, err = pg.DecodeStrings(f.vals, rr, pg.N)
+	return err
 }
 
 func (f *StringField) Scan(r *{{.Type}}) {
@@ -74,13 +54,28 @@ func (f *StringField) Add(r {{.Type}}) {
 func (f *StringField) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
+
+func (f *StringField) isNull(r {{.Type}}) bool {
+	return false
+}
+
+func (f *StringField) compare(a, b {{.Type}}) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var stringStatsTpl = `{{define "stringStats"}}
 type stringStats struct {
-	vals []string
-	min []byte
-	max []byte
+	min string
+	max string
+	set bool
 }
 
 func newStringStats() *stringStats {
@@ -88,7 +83,16 @@ func newStringStats() *stringStats {
 }
 
 func (s *stringStats) add(val string) {
-	s.vals = append(s.vals, val)
+	if !s.set {
+		s.min, s.max, s.set = val, val, true
+		return
+	}
+	if val < s.min {
+		s.min = val
+	}
+	if val > s.max {
+		s.max = val
+	}
 }
 
 func (s *stringStats) NullCount() *int64 {
@@ -100,28 +104,16 @@ func (s *stringStats) DistinctCount() *int64 {
 }
 
 func (s *stringStats) Min() []byte {
-	if s.min == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.min
+	return []byte(s.min)
 }
 
 func (s *stringStats) Max() []byte {
-	if s.max == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.max
-}
-
-func (s *stringStats) minMax()  {
-	if len(s.vals) == 0 {
-		return
-	}
-
-	tmp := make([]string, len(s.vals))
-	copy(tmp, s.vals)
-	sort.Strings(tmp)
-	s.min = []byte(tmp[0])
-	s.max = []byte(tmp[len(tmp)-1])
+	return []byte(s.max)
 }
 {{end}}`
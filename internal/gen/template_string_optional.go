@@ -19,7 +19,7 @@ func NewStringOptionalField(read func(r {{.Type}}) ([]{{removeStar .TypeName}},
 }
 
 func (f *StringOptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *StringOptionalField) Add(r {{.Type}}) {
@@ -44,16 +44,7 @@ func (f *StringOptionalField) Scan(r *{{.Type}}) {
 }
 
 func (f *StringOptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	buf := bytes.Buffer{}
-
-	for _, s := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, int32(len(s))); err != nil {
-			return err
-		}
-		buf.Write([]byte(s))
-	}
-
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeStrings(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *StringOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -62,31 +53,37 @@ func (f *StringOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	for j := 0; j < f.Values(); j++ {
-		var x int32
-		if err := binary.Read(rr, binary.LittleEndian, &x); err != nil {
-			return err
-		}
-		s := make([]byte, x)
-		if _, err := rr.Read(s); err != nil {
-			return err
-		}
-
-		f.vals = append(f.vals, string(s))
-	}
-	return nil
+	f.vals, err = pg.DecodeStrings(f.vals, rr, f.Values()-len(f.vals))
+	return err
 }
 
 func (f *StringOptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
+
+func (f *StringOptionalField) isNull(r {{.Type}}) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *StringOptionalField) compare(a, b {{.Type}}) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
 {{end}}`
 
 var stringOptionalStatsTpl = `{{define "stringOptionalStats"}}
 type stringOptionalStats struct {
-	vals []string
-	min []byte
-	max []byte
+	min string
+	max string
+	set bool
 	nils int64
 	maxDef uint8
 }
@@ -100,9 +97,20 @@ func (s *stringOptionalStats) add(vals []string, defs []uint8) {
 	for _, def := range defs {
 		if def < s.maxDef {
 			s.nils++
-		} else {
-			s.vals = append(s.vals, vals[i])
-			i++
+			continue
+		}
+
+		val := vals[i]
+		i++
+		if !s.set {
+			s.min, s.max, s.set = val, val, true
+			continue
+		}
+		if val < s.min {
+			s.min = val
+		}
+		if val > s.max {
+			s.max = val
 		}
 	}
 }
@@ -116,28 +124,16 @@ func (s *stringOptionalStats) DistinctCount() *int64 {
 }
 
 func (s *stringOptionalStats) Min() []byte {
-	if s.min == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.min
+	return []byte(s.min)
 }
 
 func (s *stringOptionalStats) Max() []byte {
-	if s.max == nil {
-		s.minMax()
-	}
-	return s.max
-}
-
-func (s *stringOptionalStats) minMax()  {
-	if len(s.vals) == 0 {
-		return
+	if !s.set {
+		return nil
 	}
-
-	tmp := make([]string, len(s.vals))
-	copy(tmp, s.vals)
-	sort.Strings(tmp)
-	s.min = []byte(tmp[0])
-	s.max = []byte(tmp[len(tmp)-1])
+	return []byte(s.max)
 }
 {{end}}`
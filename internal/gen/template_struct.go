@@ -3,5 +3,7 @@ package gen
 var structTpl = `package {{.Package}}
 
 // This code is generated by github.com/parsyl/parquet.
-
+{{if .Time}}
+import "time"
+{{end}}
 {{.Structs}}`
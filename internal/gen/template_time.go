@@ -0,0 +1,274 @@
+package gen
+
+var timeTpl = `{{define "timeField"}}
+type TimeField struct {
+	vals []time.Time
+	parquet.RequiredField
+	read  func(r {{.Type}}) time.Time
+	write func(r *{{.Type}}, vals []time.Time)
+	stats *timeStats
+	unit  parquet.TimeUnit
+	utc   bool
+}
+
+func NewTimeField(read func(r {{.Type}}) time.Time, write func(r *{{.Type}}, vals []time.Time), path []string, unit parquet.TimeUnit, utc bool, opts ...func(*parquet.RequiredField)) *TimeField {
+	return &TimeField{
+		read:          read,
+		write:         write,
+		RequiredField: parquet.NewRequiredField(path, opts...),
+		stats:         newTimeStats(unit, utc),
+		unit:          unit,
+		utc:           utc,
+	}
+}
+
+func (f *TimeField) Schema() parquet.Field {
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: parquet.TimestampType(f.unit, f.utc), RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
+}
+
+func (f *TimeField) Read(r io.ReadSeeker, pg parquet.Page) error {
+	rr, _, err := f.DoRead(r, pg)
+	if err != nil {
+		return err
+	}
+
+	f.vals, err = parquet.DecodeTimes(f.vals, rr, pg.N, f.unit, f.utc)
+	return err
+}
+
+func (f *TimeField) Write(w io.Writer, meta *parquet.Metadata) error {
+	return f.DoWrite(w, meta, parquet.EncodeTimes(nil, f.vals, f.unit, f.utc), len(f.vals), f.stats)
+}
+
+func (f *TimeField) Scan(r *{{.Type}}) {
+	if len(f.vals) == 0 {
+		return
+	}
+
+	f.write(r, f.vals)
+	f.vals = f.vals[1:]
+}
+
+func (f *TimeField) Add(r {{.Type}}) {
+	v := f.read(r)
+	f.stats.add(v)
+	f.vals = append(f.vals, v)
+}
+
+func (f *TimeField) Levels() ([]uint8, []uint8) {
+	return nil, nil
+}
+
+func (f *TimeField) isNull(r {{.Type}}) bool {
+	return false
+}
+
+func (f *TimeField) compare(a, b {{.Type}}) int {
+	x, y := parquet.Timestamp(f.read(a), f.unit, f.utc), parquet.Timestamp(f.read(b), f.unit, f.utc)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+{{end}}`
+
+var timeOptionalTpl = `{{define "timeOptionalField"}}
+type TimeOptionalField struct {
+	parquet.OptionalField
+	vals  []time.Time
+	read  func(r {{.Type}}) ([]time.Time, []uint8, []uint8)
+	write func(r *{{.Type}}, vals []time.Time, def, rep []uint8) (int, int)
+	stats *timeOptionalStats
+	unit  parquet.TimeUnit
+	utc   bool
+}
+
+func NewTimeOptionalField(read func(r {{.Type}}) ([]time.Time, []uint8, []uint8), write func(r *{{.Type}}, vals []time.Time, defs, reps []uint8) (int, int), path []string, types []int, unit parquet.TimeUnit, utc bool, opts ...func(*parquet.OptionalField)) *TimeOptionalField {
+	return &TimeOptionalField{
+		read:          read,
+		write:         write,
+		OptionalField: parquet.NewOptionalField(path, types, opts...),
+		stats:         newTimeOptionalStats(maxDef(types), unit, utc),
+		unit:          unit,
+		utc:           utc,
+	}
+}
+
+func (f *TimeOptionalField) Schema() parquet.Field {
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: parquet.TimestampType(f.unit, f.utc), RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
+}
+
+func (f *TimeOptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
+	return f.DoWrite(w, meta, parquet.EncodeTimes(nil, f.vals, f.unit, f.utc), len(f.Defs), f.stats)
+}
+
+func (f *TimeOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
+	rr, _, err := f.DoRead(r, pg)
+	if err != nil {
+		return err
+	}
+
+	f.vals, err = parquet.DecodeTimes(f.vals, rr, f.Values()-len(f.vals), f.unit, f.utc)
+	return err
+}
+
+func (f *TimeOptionalField) Add(r {{.Type}}) {
+	vals, defs, reps := f.read(r)
+	f.stats.add(vals, defs)
+	f.vals = append(f.vals, vals...)
+	f.Defs = append(f.Defs, defs...)
+	f.Reps = append(f.Reps, reps...)
+}
+
+func (f *TimeOptionalField) Scan(r *{{.Type}}) {
+	if len(f.Defs) == 0 {
+		return
+	}
+
+	v, l := f.write(r, f.vals, f.Defs, f.Reps)
+	f.vals = f.vals[v:]
+	f.Defs = f.Defs[l:]
+	if len(f.Reps) > 0 {
+		f.Reps = f.Reps[l:]
+	}
+}
+
+func (f *TimeOptionalField) Levels() ([]uint8, []uint8) {
+	return f.Defs, f.Reps
+}
+
+func (f *TimeOptionalField) isNull(r {{.Type}}) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *TimeOptionalField) compare(a, b {{.Type}}) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	i, j := parquet.Timestamp(x[0], f.unit, f.utc), parquet.Timestamp(y[0], f.unit, f.utc)
+	switch {
+	case i < j:
+		return -1
+	case i > j:
+		return 1
+	}
+	return 0
+}
+{{end}}`
+
+var timeStatsTpl = `{{define "timeStats"}}
+type timeStats struct {
+	min  int64
+	max  int64
+	set  bool
+	unit parquet.TimeUnit
+	utc  bool
+}
+
+func newTimeStats(unit parquet.TimeUnit, utc bool) *timeStats {
+	return &timeStats{unit: unit, utc: utc}
+}
+
+func (t *timeStats) add(val time.Time) {
+	v := parquet.Timestamp(val, t.unit, t.utc)
+	if !t.set {
+		t.min, t.max, t.set = v, v, true
+		return
+	}
+	if v < t.min {
+		t.min = v
+	}
+	if v > t.max {
+		t.max = v
+	}
+}
+
+func (t *timeStats) NullCount() *int64 {
+	return nil
+}
+
+func (t *timeStats) DistinctCount() *int64 {
+	return nil
+}
+
+func (t *timeStats) Min() []byte {
+	// INT96 doesn't have a defined sort order
+	if !t.set || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.min})
+}
+
+func (t *timeStats) Max() []byte {
+	if !t.set || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.max})
+}
+{{end}}`
+
+var timeOptionalStatsTpl = `{{define "timeOptionalStats"}}
+type timeOptionalStats struct {
+	min     int64
+	max     int64
+	nils    int64
+	nonNils int64
+	maxDef  uint8
+	unit    parquet.TimeUnit
+	utc     bool
+}
+
+func newTimeOptionalStats(d uint8, unit parquet.TimeUnit, utc bool) *timeOptionalStats {
+	return &timeOptionalStats{maxDef: d, unit: unit, utc: utc}
+}
+
+func (t *timeOptionalStats) add(vals []time.Time, defs []uint8) {
+	var i int
+	for _, def := range defs {
+		if def < t.maxDef {
+			t.nils++
+			continue
+		}
+
+		v := parquet.Timestamp(vals[i], t.unit, t.utc)
+		i++
+		t.nonNils++
+		if t.nonNils == 1 {
+			t.min, t.max = v, v
+			continue
+		}
+		if v < t.min {
+			t.min = v
+		}
+		if v > t.max {
+			t.max = v
+		}
+	}
+}
+
+func (t *timeOptionalStats) NullCount() *int64 {
+	return &t.nils
+}
+
+func (t *timeOptionalStats) DistinctCount() *int64 {
+	return nil
+}
+
+func (t *timeOptionalStats) Min() []byte {
+	// INT96 doesn't have a defined sort order
+	if t.nonNils == 0 || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.min})
+}
+
+func (t *timeOptionalStats) Max() []byte {
+	if t.nonNils == 0 || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.max})
+}
+{{end}}`
@@ -140,7 +140,7 @@ func TestFields(t *testing.T) {
 		{
 			name:   "unsupported fields",
 			typ:    "Unsupported",
-			errors: []error{fmt.Errorf("unsupported type: Time")},
+			errors: []error{fmt.Errorf("unsupported type: Duration")},
 			expected: []fields.Field{
 				{Type: "Unsupported", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"ID"}, FieldTypes: []string{"int32"}, ColumnNames: []string{"ID"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required}},
 				{Type: "Unsupported", FieldType: "Int32OptionalField", ParquetType: "Int32Type", TypeName: "*int32", FieldNames: []string{"Age"}, FieldTypes: []string{"int32"}, ColumnNames: []string{"Age"}, Category: "numericOptional", RepetitionTypes: []fields.RepetitionType{fields.Optional}},
@@ -200,6 +200,73 @@ func TestFields(t *testing.T) {
 				{Type: "Tagged", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Name"}, FieldTypes: []string{"string"}, ColumnNames: []string{"name"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}},
 			},
 		},
+		{
+			name: "field ids",
+			typ:  "FieldIDs",
+			expected: []fields.Field{
+				{Type: "FieldIDs", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"ID"}, FieldTypes: []string{"int32"}, ColumnNames: []string{"id"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required}, FieldIDs: []int{1}},
+				{Type: "FieldIDs", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"Info", "ID"}, FieldTypes: []string{"Being", "int32"}, ColumnNames: []string{"info", "ID"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required, fields.Required}, FieldIDs: []int{2, -1}},
+				{Type: "FieldIDs", FieldType: "Int32OptionalField", ParquetType: "Int32Type", TypeName: "*int32", FieldNames: []string{"Info", "Age"}, FieldTypes: []string{"Being", "int32"}, ColumnNames: []string{"info", "Age"}, Category: "numericOptional", RepetitionTypes: []fields.RepetitionType{fields.Required, fields.Optional}, FieldIDs: []int{2, -1}},
+				{Type: "FieldIDs", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Name"}, FieldTypes: []string{"string"}, ColumnNames: []string{"Name"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}, FieldIDs: []int{3}},
+			},
+		},
+		{
+			name: "bad tag options",
+			typ:  "BadFieldID",
+			expected: []fields.Field{
+				{Type: "BadFieldID", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"Age"}, FieldTypes: []string{"int32"}, ColumnNames: []string{"age"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required}},
+			},
+			errors: []error{
+				fmt.Errorf("invalid field id in the parquet tag of ID: id=one"),
+				fmt.Errorf("unknown option in the parquet tag of Name: fast"),
+			},
+		},
+		{
+			name: "column options",
+			typ:  "ColumnOptions",
+			expected: []fields.Field{
+				{Type: "ColumnOptions", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"ID"}, FieldTypes: []string{"int32"}, ColumnNames: []string{"id"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required}, Compression: "uncompressed", NoStats: true},
+				{Type: "ColumnOptions", FieldType: "Int32Field", ParquetType: "Int32Type", TypeName: "int32", FieldNames: []string{"Info", "ID"}, FieldTypes: []string{"Being", "int32"}, ColumnNames: []string{"info", "ID"}, Category: "numeric", RepetitionTypes: []fields.RepetitionType{fields.Required, fields.Required}, Compression: "uncompressed"},
+				{Type: "ColumnOptions", FieldType: "Int32OptionalField", ParquetType: "Int32Type", TypeName: "*int32", FieldNames: []string{"Info", "Age"}, FieldTypes: []string{"Being", "int32"}, ColumnNames: []string{"info", "Age"}, Category: "numericOptional", RepetitionTypes: []fields.RepetitionType{fields.Required, fields.Optional}, Compression: "uncompressed"},
+				{Type: "ColumnOptions", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Payload"}, FieldTypes: []string{"string"}, ColumnNames: []string{"payload"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}},
+				{Type: "ColumnOptions", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Body"}, FieldTypes: []string{"string"}, ColumnNames: []string{"body"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}, Compression: "zstd"},
+				{Type: "ColumnOptions", FieldType: "Int64OptionalField", ParquetType: "Int64Type", TypeName: "*int64", FieldNames: []string{"Code"}, FieldTypes: []string{"int64"}, ColumnNames: []string{"code"}, Category: "numericOptional", RepetitionTypes: []fields.RepetitionType{fields.Optional}, Dictionary: true, Bloom: true},
+			},
+		},
+		{
+			name: "bad column options",
+			typ:  "BadColumnOptions",
+			expected: []fields.Field{
+				{Type: "BadColumnOptions", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Name"}, FieldTypes: []string{"string"}, ColumnNames: []string{"name"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}},
+			},
+			errors: []error{
+				fmt.Errorf("unsupported compression in the parquet tag of A: compression=lz4"),
+				fmt.Errorf("unsupported option in the parquet tag of B: encoding=dict"),
+				fmt.Errorf("unsupported option in the parquet tag of C: bloom=true"),
+				fmt.Errorf("invalid option in the parquet tag of D: stats=maybe"),
+			},
+		},
+		{
+			name: "time fields",
+			typ:  "Times",
+			expected: []fields.Field{
+				{Type: "Times", FieldType: "TimeField", ParquetType: "TimeType", TypeName: "time.Time", FieldNames: []string{"Created"}, FieldTypes: []string{"time.Time"}, ColumnNames: []string{"created"}, Category: "time", RepetitionTypes: []fields.RepetitionType{fields.Required}, TimeUnit: "micros"},
+				{Type: "Times", FieldType: "TimeOptionalField", ParquetType: "TimeType", TypeName: "*time.Time", FieldNames: []string{"Updated"}, FieldTypes: []string{"time.Time"}, ColumnNames: []string{"updated"}, Category: "timeOptional", RepetitionTypes: []fields.RepetitionType{fields.Optional}, TimeUnit: "millis", Local: true},
+				{Type: "Times", FieldType: "TimeOptionalField", ParquetType: "TimeType", TypeName: "[]time.Time", FieldNames: []string{"Seen"}, FieldTypes: []string{"time.Time"}, ColumnNames: []string{"Seen"}, Category: "timeOptional", RepetitionTypes: []fields.RepetitionType{fields.Repeated}, TimeUnit: "micros"},
+				{Type: "Times", FieldType: "TimeField", ParquetType: "TimeType", TypeName: "time.Time", FieldNames: []string{"Legacy"}, FieldTypes: []string{"time.Time"}, ColumnNames: []string{"legacy"}, Category: "time", RepetitionTypes: []fields.RepetitionType{fields.Required}, TimeUnit: "int96"},
+			},
+		},
+		{
+			name: "bad time options",
+			typ:  "BadTimes",
+			expected: []fields.Field{
+				{Type: "BadTimes", FieldType: "StringField", ParquetType: "StringType", TypeName: "string", FieldNames: []string{"Name"}, FieldTypes: []string{"string"}, ColumnNames: []string{"Name"}, Category: "string", RepetitionTypes: []fields.RepetitionType{fields.Required}},
+			},
+			errors: []error{
+				fmt.Errorf("invalid option in the parquet tag of Created: unit=hours"),
+				fmt.Errorf("invalid option in the parquet tag of ID: utc=true"),
+			},
+		},
 		{
 			name: "omit tag",
 			typ:  "IgnoreMe",
@@ -5,6 +5,7 @@ import (
 	"go/parser"
 	"go/token"
 	"log"
+	"strconv"
 	"strings"
 
 	"go/ast"
@@ -77,6 +78,10 @@ func Fields(typ, pth string) (*Result, error) {
 }
 
 func getOut(i int, f field, fields map[string][]field, errs []error, out []field) (int, []field, []error) {
+	if f.err != nil {
+		return i, out, append(errs, f.err)
+	}
+
 	ff, ok := fields[f.fieldType]
 	var o flds.RepetitionType = flds.Required
 	if strings.Contains(f.Field.TypeName, "*") {
@@ -97,6 +102,13 @@ func getOut(i int, f field, fields map[string][]field, errs []error, out []field
 				}
 
 				if !f.embedded {
+					fld.Field.FieldIDs = joinIDs(f.Field, fld.Field)
+					if fld.Field.Compression == "" {
+						fld.Field.Compression = f.Field.Compression
+					}
+					fld.Field.NoStats = fld.Field.NoStats || f.Field.NoStats
+					fld.Field.Dictionary = fld.Field.Dictionary || (f.Field.Dictionary && !strings.HasPrefix(fld.Field.Category, "bool"))
+					fld.Field.Bloom = fld.Field.Bloom || (f.Field.Bloom && !strings.HasPrefix(fld.Field.Category, "bool"))
 					fld.Field.RepetitionTypes = append(append(f.Field.RepetitionTypes[:0:0], f.Field.RepetitionTypes...), o) //make a copy
 					fld.Field.FieldNames = append(f.Field.FieldNames, fld.Field.FieldNames...)
 					fld.Field.FieldTypes = append(f.Field.FieldTypes, fld.Field.FieldTypes...)
@@ -106,7 +118,7 @@ func getOut(i int, f field, fields map[string][]field, errs []error, out []field
 			}
 		}
 		return i, out, errs
-	} else if f.err == nil {
+	} else {
 		_, ok := types[f.fieldType]
 		if ok {
 			f.Field.RepetitionTypes = append(f.Field.RepetitionTypes, o)
@@ -119,6 +131,26 @@ func getOut(i int, f field, fields map[string][]field, errs []error, out []field
 	return i, out, errs
 }
 
+// joinIDs returns the field ids of a parent's columns followed by
+// the field ids of its child's, or nil if neither has any.
+func joinIDs(parent, child flds.Field) []int {
+	if parent.FieldIDs == nil && child.FieldIDs == nil {
+		return nil
+	}
+
+	out := make([]int, 0, len(parent.ColumnNames)+len(child.ColumnNames))
+	for _, f := range []flds.Field{parent, child} {
+		if f.FieldIDs != nil {
+			out = append(out, f.FieldIDs...)
+			continue
+		}
+		for range f.ColumnNames {
+			out = append(out, -1)
+		}
+	}
+	return out
+}
+
 func makeOptional(f field) field {
 	f.optional = true
 	fn, cat, pt := lookupTypeAndCategory(strings.Replace(strings.Replace(f.Field.TypeName, "*", "", 1), "[]", "", 1), true, true)
@@ -189,12 +221,13 @@ func doGetFields(n map[string]ast.Node) (map[string][]field, error) {
 
 func getField(name string, x ast.Node) field {
 	var typ, tag string
+	var opts []string
 	var optional, repeated bool
 	ast.Inspect(x, func(n ast.Node) bool {
 		switch t := n.(type) {
 		case *ast.Field:
 			if t.Tag != nil {
-				tag = parseTag(t.Tag.Value)
+				tag, opts = parseTag(t.Tag.Value)
 			}
 			typ = fmt.Sprintf("%s", t.Type)
 		case *ast.ArrayType:
@@ -205,6 +238,9 @@ func getField(name string, x ast.Node) field {
 		case *ast.StarExpr:
 			optional = true
 			typ = fmt.Sprintf("%s", t.X)
+		case *ast.SelectorExpr:
+			typ = fmt.Sprintf("%s.%s", t.X, t.Sel.Name)
+			return false
 		case ast.Expr:
 			s := fmt.Sprintf("%v", t)
 			_, ok := types[s]
@@ -220,7 +256,7 @@ func getField(name string, x ast.Node) field {
 	}
 
 	fn, cat, pt := lookupTypeAndCategory(typ, optional, repeated)
-	return field{
+	f := field{
 		Field: flds.Field{
 			FieldNames:  []string{name},
 			FieldTypes:  []string{typ},
@@ -235,15 +271,82 @@ func getField(name string, x ast.Node) field {
 		optional:  optional,
 		repeated:  repeated,
 	}
+
+	for _, opt := range opts {
+		switch {
+		case strings.HasPrefix(opt, "id="):
+			id, err := strconv.ParseInt(opt[3:], 10, 32)
+			if err != nil || id < 0 {
+				f.err = fmt.Errorf("invalid field id in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			f.Field.FieldIDs = []int{int(id)}
+		case strings.HasPrefix(opt, "compression="):
+			c := opt[12:]
+			if c != "snappy" && c != "zstd" && c != "uncompressed" {
+				f.err = fmt.Errorf("unsupported compression in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			f.Field.Compression = c
+		case strings.HasPrefix(opt, "stats="):
+			stats, err := strconv.ParseBool(opt[6:])
+			if err != nil {
+				f.err = fmt.Errorf("invalid option in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			f.Field.NoStats = !stats
+		case opt == "encoding=plain", opt == "bloom=false":
+			// pages are plain encoded and bloom filters
+			// aren't written unless they are asked for
+		case opt == "encoding=dict", opt == "bloom=true":
+			// booleans can't be dictionary encoded and
+			// a bloom filter of them would be pointless
+			if typ == "bool" {
+				f.err = fmt.Errorf("unsupported option in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			if opt == "bloom=true" {
+				f.Field.Bloom = true
+			} else {
+				f.Field.Dictionary = true
+			}
+		case strings.HasPrefix(opt, "encoding="), strings.HasPrefix(opt, "bloom="):
+			f.err = fmt.Errorf("unsupported option in the parquet tag of %s: %s", name, opt)
+		case strings.HasPrefix(opt, "unit="):
+			unit := opt[5:]
+			if typ != "time.Time" || (unit != "millis" && unit != "micros" && unit != "nanos" && unit != "int96") {
+				f.err = fmt.Errorf("invalid option in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			f.Field.TimeUnit = unit
+		case strings.HasPrefix(opt, "utc="):
+			utc, err := strconv.ParseBool(opt[4:])
+			if typ != "time.Time" || err != nil {
+				f.err = fmt.Errorf("invalid option in the parquet tag of %s: %s", name, opt)
+				continue
+			}
+			f.Field.Local = !utc
+		default:
+			f.err = fmt.Errorf("unknown option in the parquet tag of %s: %s", name, opt)
+		}
+	}
+
+	if typ == "time.Time" && f.Field.TimeUnit == "" {
+		f.Field.TimeUnit = "micros"
+	}
+	return f
 }
 
-func parseTag(t string) string {
+// parseTag returns the column name and the options
+// of a parquet tag (for example: parquet:"name,id=7").
+func parseTag(t string) (string, []string) {
 	i := strings.Index(t, `parquet:"`)
 	if i == -1 {
-		return ""
+		return "", nil
 	}
 	t = t[i+9:]
-	return t[:strings.Index(t, `"`)]
+	parts := strings.Split(t[:strings.Index(t, `"`)], ",")
+	return parts[0], parts[1:]
 }
 
 func getTypeName(s string, optional bool) string {
@@ -272,14 +375,15 @@ type fieldType struct {
 }
 
 var types = map[string]fieldType{
-	"int32":   {"Int32%s%s", "numeric%s"},
-	"uint32":  {"Uint32%s%s", "numeric%s"},
-	"int64":   {"Int64%s%s", "numeric%s"},
-	"uint64":  {"Uint64%s%s", "numeric%s"},
-	"float32": {"Float32%s%s", "numeric%s"},
-	"float64": {"Float64%s%s", "numeric%s"},
-	"bool":    {"Bool%s%s", "bool%s"},
-	"string":  {"String%s%s", "string%s"},
+	"int32":     {"Int32%s%s", "numeric%s"},
+	"uint32":    {"Uint32%s%s", "numeric%s"},
+	"int64":     {"Int64%s%s", "numeric%s"},
+	"uint64":    {"Uint64%s%s", "numeric%s"},
+	"float32":   {"Float32%s%s", "numeric%s"},
+	"float64":   {"Float64%s%s", "numeric%s"},
+	"bool":      {"Bool%s%s", "bool%s"},
+	"string":    {"String%s%s", "string%s"},
+	"time.Time": {"Time%s%s", "time%s"},
 }
 
 type visitorFunc func(n ast.Node) ast.Visitor
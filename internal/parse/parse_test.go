@@ -41,6 +41,47 @@ type Tagged struct {
 	Name string `parquet:"name"`
 }
 
+type FieldIDs struct {
+	ID   int32  `parquet:"id,id=1"`
+	Info Being  `parquet:"info,id=2"`
+	Name string `parquet:",id=3"`
+}
+
+type BadFieldID struct {
+	ID   int32  `parquet:"id,id=one"`
+	Name string `parquet:"name,fast"`
+	Age  int32  `parquet:"age"`
+}
+
+type ColumnOptions struct {
+	ID      int32  `parquet:"id,compression=uncompressed,stats=false"`
+	Info    Being  `parquet:"info,compression=uncompressed"`
+	Payload string `parquet:"payload,encoding=plain,bloom=false,stats=true"`
+	Body    string `parquet:"body,compression=zstd"`
+	Code    *int64 `parquet:"code,encoding=dict,bloom=true"`
+}
+
+type BadColumnOptions struct {
+	A    int32  `parquet:"a,compression=lz4"`
+	B    bool   `parquet:"b,encoding=dict"`
+	C    bool   `parquet:"c,bloom=true"`
+	D    int32  `parquet:"d,stats=maybe"`
+	Name string `parquet:"name"`
+}
+
+type Times struct {
+	Created time.Time  `parquet:"created"`
+	Updated *time.Time `parquet:"updated,unit=millis,utc=false"`
+	Seen    []time.Time
+	Legacy  time.Time `parquet:"legacy,unit=int96"`
+}
+
+type BadTimes struct {
+	Created time.Time `parquet:"created,unit=hours"`
+	ID      int64     `parquet:"id,utc=true"`
+	Name    string
+}
+
 type Private struct {
 	Being
 	name string
@@ -82,16 +123,16 @@ type Unsupported struct {
 	Being
 	// This field will be ignored because it's not one of the
 	// supported types.
-	Time time.Time
+	Duration time.Duration
 }
 
 type SupportedAndUnsupported struct {
 	Happiness int64
 	x         int
-	T1        time.Time
+	T1        time.Duration
 	Being
 	y           int
-	T2          time.Time
+	T2          time.Duration
 	Anniversary *uint64
 }
 
@@ -0,0 +1,171 @@
+package rle
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/parsyl/parquet/internal/bitpack"
+)
+
+// Decoder decodes RLE/bit-packed hybrid data directly from a byte
+// slice.  Bit-packed runs are unpacked 32 (and then 8) values at a
+// time, and the values are appended to a slice the caller provides,
+// so a Decoder that is reused with the same slice doesn't allocate.
+type Decoder struct {
+	width    int
+	unpack   func(dst []uint32, vals []byte)
+	unpack32 func(dst []uint32, vals []byte)
+
+	maxBytes  int
+	maxValues int
+}
+
+// NewDecoder creates a Decoder for values that are width (0 through 32) bits wide.
+func NewDecoder(width int32) (*Decoder, error) {
+	if width < 0 {
+		return nil, fmt.Errorf("invalid bitwidth %d", width)
+	}
+	if width > 32 {
+		return nil, fmt.Errorf("bitwidth %d is greater than 32 (highest supported)", width)
+	}
+	return &Decoder{
+		width:    int(width),
+		unpack:   bitpack.Unpacker(int(width)),
+		unpack32: bitpack.Unpacker32(int(width)),
+	}, nil
+}
+
+// Limit sets the maximum number of encoded bytes and decoded
+// values that Read and Decode will accept.  Zero means no limit.
+func (d *Decoder) Limit(maxBytes, maxValues int) {
+	d.maxBytes = maxBytes
+	d.maxValues = maxValues
+}
+
+// Read decodes data that starts with its length as a 4 byte little
+// endian int32 (the way definition and repetition levels are stored
+// in a data page) and appends the values to dst.  It returns the
+// number of bytes of data that were used, including the length.
+func (d *Decoder) Read(dst []uint32, data []byte) ([]uint32, int, error) {
+	if len(data) < 4 {
+		return dst, 0, fmt.Errorf("RLE data of %d bytes is too short for its length", len(data))
+	}
+
+	length := int32(binary.LittleEndian.Uint32(data))
+	if length < 0 || (d.maxBytes > 0 && int(length) > d.maxBytes) {
+		return dst, 0, fmt.Errorf("invalid RLE length %d", length)
+	}
+
+	if int(length) > len(data)-4 {
+		return dst, 0, fmt.Errorf("RLE length %d is longer than the remaining %d bytes", length, len(data)-4)
+	}
+
+	dst, err := d.Decode(dst, data[4:4+length])
+	return dst, int(length) + 4, err
+}
+
+// Decode decodes all of the runs in data and appends the values to dst.
+func (d *Decoder) Decode(dst []uint32, data []byte) ([]uint32, error) {
+	start := len(dst)
+	for len(data) > 0 {
+		header, n := binary.Uvarint(data)
+		if n <= 0 {
+			return dst, fmt.Errorf("invalid RLE run header")
+		}
+		data = data[n:]
+
+		count, err := d.checkRun(header, len(dst)-start, len(data))
+		if err != nil {
+			return dst, err
+		}
+
+		var i int
+		dst, i = grow(dst, count)
+		if header&1 == 0 {
+			data, err = d.decodeRLE(dst[i:], data)
+		} else {
+			data, err = d.decodeBitPacked(dst[i:], data)
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+// checkRun returns the number of values in the run that starts with
+// header after making sure that the run fits in the remaining n bytes
+// and doesn't go over the maximum number of values.
+func (d *Decoder) checkRun(header uint64, values, n int) (int, error) {
+	count := header >> 1
+	if header&1 == 1 {
+		if d.width > 0 && count > uint64(n)/uint64(d.width) {
+			return 0, fmt.Errorf("bit packed run of %d groups is larger than the remaining %d bytes", count, n)
+		}
+		count *= 8
+	}
+
+	if count > math.MaxInt32 || (d.maxValues > 0 && values+int(count) > d.maxValues) {
+		return 0, fmt.Errorf("RLE run of %d values is too long", count)
+	}
+	return int(count), nil
+}
+
+// decodeRLE fills dst with the value that is repeated by an RLE
+// run and returns the rest of data.
+func (d *Decoder) decodeRLE(dst []uint32, data []byte) ([]byte, error) {
+	n := (d.width + 7) / 8
+	if len(data) < n {
+		return nil, fmt.Errorf("RLE run value needs %d bytes, only %d remain", n, len(data))
+	}
+
+	var v uint32
+	for i := 0; i < n; i++ {
+		v |= uint32(data[i]) << (8 * uint(i))
+	}
+
+	for i := range dst {
+		dst[i] = v
+	}
+	return data[n:], nil
+}
+
+// decodeBitPacked unpacks len(dst) (a multiple of 8) values from
+// the start of data and returns the rest of data.
+func (d *Decoder) decodeBitPacked(dst []uint32, data []byte) ([]byte, error) {
+	if d.width == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return data, nil
+	}
+
+	// checkRun makes sure that there are enough bytes
+	for len(dst) >= 32 {
+		d.unpack32(dst, data)
+		dst, data = dst[32:], data[4*d.width:]
+	}
+
+	for len(dst) > 0 {
+		d.unpack(dst, data)
+		dst, data = dst[8:], data[d.width:]
+	}
+	return data, nil
+}
+
+// grow extends dst by n values and returns it along
+// with the index of the first of the new values.
+func grow(dst []uint32, n int) ([]uint32, int) {
+	i := len(dst)
+	if cap(dst)-i < n {
+		c := 2 * cap(dst)
+		if c < i+n {
+			c = i + n
+		}
+		nd := make([]uint32, i, c)
+		copy(nd, dst)
+		dst = nd
+	}
+	return dst[:i+n], i
+}
@@ -0,0 +1,30 @@
+//go:build go1.18
+// +build go1.18
+
+package rle_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parsyl/parquet/internal/rle"
+)
+
+func FuzzRead(f *testing.F) {
+	enc, _ := rle.New(3, 0)
+	for _, v := range append(repeat(4, 100), 1, 2, 3, 4, 5, 6, 7, 0, 1) {
+		enc.Write(v)
+	}
+	f.Add(enc.Bytes(), int32(3))
+	f.Fuzz(func(t *testing.T, b []byte, width int32) {
+		dec, err := rle.New(width, 0)
+		if err != nil {
+			return
+		}
+		dec.Limit(1<<16, 1<<16)
+		out, _, err := dec.Read(bytes.NewReader(b))
+		if err == nil && len(out) > 1<<16 {
+			t.Fatalf("read %d values, more than the limit", len(out))
+		}
+	})
+}
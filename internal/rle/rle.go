@@ -9,11 +9,6 @@ import (
 	"github.com/parsyl/parquet/internal/bitpack"
 )
 
-const (
-	mask1 = uint64(0x7F)
-	mask2 = uint64(0x80)
-)
-
 // RLE holds metadata that is used while reading
 // and writing run length encoded data.
 type RLE struct {
@@ -21,31 +16,46 @@ type RLE struct {
 	out           *writeBuffer
 	bitWidth      int32
 	packBuf       []byte
-	prev          uint8
-	valBuf        []uint8
+	prev          uint32
+	valBuf        []uint32
 	bufCount      int
 	repeatCount   int
 	groupCount    int
 	headerPointer int
+
+	// maxBytes and maxValues limit what Read will decode (0 means
+	// no limit) so that untrusted data can't cause huge allocations.
+	maxBytes  int
+	maxValues int
 }
 
 // New creates an RLE struct based on the maximum bitwidth (width) of
-// the data that is to be encoded/decoded.
+// the data that is to be encoded/decoded.  The width can be 0 through 32.
 func New(width int32, size int) (*RLE, error) {
-	if width > 4 {
-		return nil, fmt.Errorf("bitwidth %d is greater than 4 (highest supported)", width)
+	if width < 0 {
+		return nil, fmt.Errorf("invalid bitwidth %d", width)
+	}
+	if width > 32 {
+		return nil, fmt.Errorf("bitwidth %d is greater than 32 (highest supported)", width)
 	}
 	return &RLE{
 		out:           newWriteBuffer(size),
 		bitWidth:      width,
 		packBuf:       make([]byte, int(width)),
-		valBuf:        make([]uint8, 8),
+		valBuf:        make([]uint32, 8),
 		headerPointer: -1,
 	}, nil
 }
 
+// Limit sets the maximum number of encoded bytes and decoded
+// values that Read will accept.  Zero means no limit.
+func (r *RLE) Limit(maxBytes, maxValues int) {
+	r.maxBytes = maxBytes
+	r.maxValues = maxValues
+}
+
 // Write encodes 'value' to run length encoded data.
-func (r *RLE) Write(value uint8) {
+func (r *RLE) Write(value uint32) {
 	if value == r.prev {
 		r.repeatCount++
 		if r.repeatCount >= 8 {
@@ -106,23 +116,15 @@ func (r *RLE) writeRLERun() error {
 	return nil
 }
 
-func (r *RLE) writeIntLittleEndianPaddedOnBitWidth(v uint8, bitWidth int32) ([]byte, error) {
+func (r *RLE) writeIntLittleEndianPaddedOnBitWidth(v uint32, bitWidth int32) ([]byte, error) {
 	bytesWidth := (bitWidth + 7) / 8
-	switch bytesWidth {
-	case 0:
-		return nil, nil
-	case 1:
-		return []byte{
-			byte(uint(v>>0) & 0xFF),
-		}, nil
-	case 2:
-		return []byte{
-			byte(uint(v>>0) & 0xFF),
-			byte(uint(v>>8) & 0xFF),
-		}, nil
-	default:
-		return nil, fmt.Errorf("Encountered value (%d) that requires more than 2 bytes", v)
+	if bytesWidth > 4 {
+		return nil, fmt.Errorf("Encountered value (%d) that requires more than 4 bytes", v)
 	}
+
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return b[:bytesWidth], nil
 }
 
 func (r *RLE) leb128(value int) []byte {
@@ -154,135 +156,30 @@ func (r *RLE) Bytes() []byte {
 }
 
 // Read reads the RLE encoded definition levels
-func (r *RLE) Read(in io.Reader) ([]uint8, int, error) {
-	var out []uint8
+func (r *RLE) Read(in io.Reader) ([]uint32, int, error) {
 	var length int32
 	if err := binary.Read(in, binary.LittleEndian, &length); err != nil {
-		return out, 0, err
-	}
-
-	buf := make([]byte, length)
-	if _, err := in.Read(buf); err != nil {
 		return nil, 0, err
 	}
 
-	rr := bytes.NewReader(buf)
-	var header uint64
-	var vals []uint8
-	var err error
-	for rr.Len() > 0 {
-		header, err = readLEB128(rr)
-		if err != nil {
-			return nil, 0, err
-		}
-		if header&1 == 0 {
-			vals, err = readRLE(rr, header, uint64(r.bitWidth))
-			if err != nil {
-				return nil, 0, err
-			}
-			out = append(out, vals...)
-		} else {
-			vals, err = readRLEBitPacked(rr, header, uint8(r.bitWidth))
-			if err != nil {
-				return nil, 0, err
-			}
-			out = append(out, vals...)
-		}
-	}
-	return out, int(length) + 4, nil
-}
-
-func readRLEBitPacked(r io.Reader, header uint64, width uint8) ([]uint8, error) {
-	count := (int(header) >> 1) * 8
-	if width == 0 {
-		return make([]uint8, count), nil
-	}
-
-	byteCount := (int(width) * count) / 8
-	rawBytes := make([]byte, byteCount)
-	if _, err := r.Read(rawBytes); err != nil {
-		return nil, err
+	if length < 0 || (r.maxBytes > 0 && int(length) > r.maxBytes) {
+		return nil, 0, fmt.Errorf("invalid RLE length %d", length)
 	}
 
-	var out []uint8
-	for len(rawBytes) > 0 {
-		out = append(out, bitpack.Unpack(int(width), rawBytes[:width])...)
-		rawBytes = rawBytes[int(width):]
-	}
-
-	return out, nil
-}
-
-func readRLE(r io.Reader, header uint64, bitWidth uint64) ([]uint8, error) {
-	count := header >> 1
-	value, err := readIntLittleEndianPaddedOnBitWidth(r, int(bitWidth))
-	if err != nil {
-		return nil, err
-	}
-
-	out := make([]uint8, count)
-	for i := 0; i < int(count); i++ {
-		out[i] = uint8(value)
-	}
-	return out, nil
-}
-
-func readIntLittleEndianPaddedOnBitWidth(in io.Reader, bitWidth int) (uint8, error) {
-	bytesWidth := (bitWidth + 7) / 8
-	switch bytesWidth {
-	case 0:
-		return 0, nil
-	case 1:
-		return readIntLittleEndianOnOneByte(in)
-	case 2:
-		return readIntLittleEndianOnTwoBytes(in)
-	default:
-		return 0, fmt.Errorf("Encountered bitWidth (%d) that requires more than 4 bytes", bitWidth)
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(in, buf); err != nil {
+		return nil, 0, err
 	}
-}
 
-func readIntLittleEndianOnOneByte(in io.Reader) (uint8, error) {
-	b := make([]byte, 1)
-	_, err := in.Read(b)
+	dec, err := NewDecoder(r.bitWidth)
 	if err != nil {
-		return 0, err
-	}
-	if b[0] < 0 {
-		return 0, io.EOF
+		return nil, 0, err
 	}
-	return uint8(b[0]), nil
-}
 
-func readIntLittleEndianOnTwoBytes(in io.Reader) (uint8, error) {
-	b := make([]byte, 2)
-	_, err := in.Read(b)
+	dec.Limit(r.maxBytes, r.maxValues)
+	out, err := dec.Decode(nil, buf)
 	if err != nil {
-		return 0, err
-	}
-	if b[0] < 0 {
-		return 0, io.EOF
-	}
-
-	if (b[0] | b[1]) < 0 {
-		return 0, io.EOF
-	}
-	return (uint8(b[1]) << 8) + (uint8(b[0]) << 0), nil
-}
-
-func readLEB128(r io.Reader) (uint64, error) {
-	var err error
-	var out, shift, x uint64
-	b := make([]byte, 1)
-	for {
-		_, err = r.Read(b)
-		if err != nil {
-			return out, err
-		}
-		x = uint64(b[0])
-		out |= (x & mask1) << shift
-		if (x & mask2) == 0 {
-			return out, nil
-		}
-		shift += 7
+		return nil, 0, err
 	}
+	return out, int(length) + 4, nil
 }
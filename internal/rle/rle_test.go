@@ -12,7 +12,7 @@ import (
 type testCase struct {
 	width int32
 	name  string
-	in    []uint8
+	in    []uint32
 	out   []byte
 	err   error
 }
@@ -52,27 +52,52 @@ func TestRLE(t *testing.T) {
 		{
 			name:  "single value",
 			width: 1,
-			in:    []uint8{1},
+			in:    []uint32{1},
 		},
 		{
 			name:  "odd number of non-repeated values",
 			width: 1,
-			in:    []uint8{1, 0, 1, 1, 0},
+			in:    []uint32{1, 0, 1, 1, 0},
 		},
 		{
 			name:  "width 2",
 			width: 2,
-			in:    []uint8{1, 2, 3},
+			in:    []uint32{1, 2, 3},
 		},
 		{
 			name:  "width 3",
 			width: 3,
-			in:    []uint8{1, 2, 7},
+			in:    []uint32{1, 2, 7},
 		},
 		{
 			name:  "width 4",
 			width: 4,
-			err:   fmt.Errorf("bitwidth 4 is greater than 3 (highest supported)"),
+			in:    mod(16, 100),
+		},
+		{
+			name:  "width 9",
+			width: 9,
+			in:    append(mod(512, 1000), repeat(300, 20)...),
+		},
+		{
+			name:  "width 17",
+			width: 17,
+			in:    append(repeat(1<<16+3, 50), 1<<17-1, 0, 1<<16),
+		},
+		{
+			name:  "width 31",
+			width: 31,
+			in:    append(mod(1<<31, 30), repeat(1<<31-1, 10)...),
+		},
+		{
+			name:  "width 32",
+			width: 32,
+			in:    append(repeat(1<<32-1, 10), 1<<31, 1, 1<<32-2, 7),
+		},
+		{
+			name:  "width 33",
+			width: 33,
+			err:   fmt.Errorf("bitwidth 33 is greater than 32 (highest supported)"),
 		},
 	}
 
@@ -80,7 +105,7 @@ func TestRLE(t *testing.T) {
 		t.Run(fmt.Sprintf("%02d-%s", i, tc.name), func(t *testing.T) {
 			r, err := rle.New(tc.width, len(tc.in))
 			if tc.err != nil {
-				assert.Error(t, tc.err, err)
+				assert.EqualError(t, err, tc.err.Error())
 				return
 			}
 
@@ -100,10 +125,95 @@ func TestRLE(t *testing.T) {
 	}
 }
 
-func mod(m, c int) []uint8 {
-	out := make([]uint8, c)
+func TestDecoder(t *testing.T) {
+	testCases := []testCase{
+		{name: "rle only", width: 3, in: append(repeat(4, 100), repeat(5, 100)...)},
+		{name: "bitpacking only", width: 5, in: mod(32, 1000)},
+		{name: "mixed", width: 12, in: append(append(mod(4096, 77), repeat(4000, 40)...), mod(7, 33)...)},
+		{name: "width 0", width: 0, in: repeat(0, 33)},
+		{name: "width 32", width: 32, in: append(mod(1<<31, 70), repeat(1<<32-1, 9)...)},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%02d-%s", i, tc.name), func(t *testing.T) {
+			enc, err := rle.New(tc.width, len(tc.in))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, x := range tc.in {
+				enc.Write(x)
+			}
+			b := enc.Bytes()
+
+			dec, err := rle.NewDecoder(tc.width)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// decoding twice into the same buffer appends
+			// the values without reallocating it
+			buf := make([]uint32, 0, 2*len(tc.in)+16)
+			vals, n, err := dec.Read(buf, append(b, 1, 2, 3))
+			if assert.NoError(t, err) {
+				assert.Equal(t, len(b), n)
+				assert.Equal(t, tc.in, vals[:len(tc.in)])
+			}
+
+			l := len(vals)
+			vals, _, err = dec.Read(vals, b)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.in, vals[l:l+len(tc.in)])
+				assert.Equal(t, &buf[:1][0], &vals[0])
+			}
+		})
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	enc, _ := rle.New(3, 0)
+	for _, x := range mod(8, 100) {
+		enc.Write(x)
+	}
+	b := enc.Bytes()
+
+	dec, _ := rle.NewDecoder(3)
+	_, _, err := dec.Read(nil, b[:2])
+	assert.Error(t, err)
+
+	_, _, err = dec.Read(nil, b[:len(b)-1])
+	assert.Error(t, err)
+
+	dec.Limit(0, 50)
+	_, _, err = dec.Read(nil, b)
+	assert.Error(t, err)
+
+	_, err = rle.NewDecoder(33)
+	assert.EqualError(t, err, "bitwidth 33 is greater than 32 (highest supported)")
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	enc, _ := rle.New(4, 0)
+	for i := 0; i < 10000; i++ {
+		if i%1000 < 500 {
+			enc.Write(uint32(i % 16))
+		} else {
+			enc.Write(15)
+		}
+	}
+	data := enc.Bytes()
+	dec, _ := rle.NewDecoder(4)
+	var out []uint32
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out, _, _ = dec.Read(out[:0], data)
+	}
+}
+
+func mod(m, c int) []uint32 {
+	out := make([]uint32, c)
 	for i := range out {
-		out[i] = uint8(i % m)
+		out[i] = uint32(i % m)
 	}
 	return out
 }
@@ -116,8 +226,8 @@ func modbytes(m, c int) []byte {
 	return out
 }
 
-func repeat(v uint8, c int) []uint8 {
-	out := make([]uint8, c)
+func repeat(v uint32, c int) []uint32 {
+	out := make([]uint32, c)
 	for i := range out {
 		out[i] = v
 	}
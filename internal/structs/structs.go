@@ -53,7 +53,50 @@ func field(elem *sch.SchemaElement) string {
 	if elem.RepetitionType != nil && *elem.RepetitionType == sch.FieldRepetitionType_OPTIONAL {
 		ptr = "*"
 	}
-	return fmt.Sprintf("%s %s%s `parquet:\"%s\"`", n, ptr, t, elem.Name)
+	tag := elem.Name
+	if elem.FieldID != nil && *elem.FieldID > 0 {
+		tag = fmt.Sprintf("%s,id=%d", tag, *elem.FieldID)
+	}
+	if ts := timestamp(elem); ts != "" {
+		t = "time.Time"
+		tag += ts
+	}
+	return fmt.Sprintf("%s %s%s `parquet:\"%s\"`", n, ptr, t, tag)
+}
+
+// timestamp returns the tag options of an INT64 TIMESTAMP
+// or a legacy INT96 column, or "" if elem isn't one.
+func timestamp(elem *sch.SchemaElement) string {
+	if elem.Type != nil && *elem.Type == sch.Type_INT96 {
+		return ",unit=int96"
+	}
+	if elem.Type == nil || *elem.Type != sch.Type_INT64 {
+		return ""
+	}
+
+	if lt := elem.LogicalType; lt != nil && lt.TIMESTAMP != nil && lt.TIMESTAMP.Unit != nil {
+		opts := ",unit=micros"
+		switch {
+		case lt.TIMESTAMP.Unit.MILLIS != nil:
+			opts = ",unit=millis"
+		case lt.TIMESTAMP.Unit.NANOS != nil:
+			opts = ",unit=nanos"
+		}
+		if !lt.TIMESTAMP.IsAdjustedToUTC {
+			opts += ",utc=false"
+		}
+		return opts
+	}
+
+	if elem.ConvertedType != nil {
+		switch *elem.ConvertedType {
+		case sch.ConvertedType_TIMESTAMP_MILLIS:
+			return ",unit=millis"
+		case sch.ConvertedType_TIMESTAMP_MICROS:
+			return ",unit=micros"
+		}
+	}
+	return ""
 }
 
 func getType(t string) string {
@@ -67,6 +110,7 @@ var parquetTypes = map[string]string{
 	"FLOAT":      "float32",
 	"DOUBLE":     "float64",
 	"BYTE_ARRAY": "string",
+	"INT96":      "time.Time",
 }
 
 var primitiveTypes = map[string]bool{
@@ -26,6 +26,17 @@ func TestStruct(t *testing.T) {
 			},
 			expected: "type Root struct {\n	Id int32 `parquet:\"id\"`\n}",
 		},
+		{
+			name: "timestamps",
+			schema: []*sch.SchemaElement{
+				{Name: "root", NumChildren: pint32(4)},
+				{Name: "created", Type: pt(sch.Type_INT64), RepetitionType: prt(sch.FieldRepetitionType_REQUIRED), LogicalType: &sch.LogicalType{TIMESTAMP: &sch.TimestampType{IsAdjustedToUTC: true, Unit: &sch.TimeUnit{NANOS: &sch.NanoSeconds{}}}}},
+				{Name: "updated", Type: pt(sch.Type_INT64), RepetitionType: prt(sch.FieldRepetitionType_OPTIONAL), LogicalType: &sch.LogicalType{TIMESTAMP: &sch.TimestampType{Unit: &sch.TimeUnit{MICROS: &sch.MicroSeconds{}}}}},
+				{Name: "seen", Type: pt(sch.Type_INT64), RepetitionType: prt(sch.FieldRepetitionType_REQUIRED), ConvertedType: sch.ConvertedTypePtr(sch.ConvertedType_TIMESTAMP_MILLIS)},
+				{Name: "legacy", Type: pt(sch.Type_INT96), RepetitionType: prt(sch.FieldRepetitionType_OPTIONAL)},
+			},
+			expected: "type Root struct {\n	Created time.Time  `parquet:\"created,unit=nanos\"`\n	Updated *time.Time `parquet:\"updated,unit=micros,utc=false\"`\n	Seen    time.Time  `parquet:\"seen,unit=millis\"`\n	Legacy  *time.Time `parquet:\"legacy,unit=int96\"`\n}",
+		},
 		{
 			name: "single nested field",
 			schema: []*sch.SchemaElement{
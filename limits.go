@@ -0,0 +1,68 @@
+package parquet
+
+import (
+	"io"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// Limits bounds what a reader will allocate while decoding a file, so
+// that a truncated or hostile file returns an error instead of exhausting
+// memory.  A zero field means that there is no limit.
+type Limits struct {
+	// MaxFooterSize is the maximum size, in bytes, of the FileMetaData.
+	MaxFooterSize int64
+	// MaxPageSize is the maximum size, in bytes, of a page header and of
+	// the compressed and uncompressed data of a page.  The data of a
+	// dictionary encoded page is the values that its indices refer to.
+	MaxPageSize int32
+	// MaxPageValues is the maximum number of values (including nulls)
+	// in a single page.
+	MaxPageValues int32
+}
+
+// DefaultLimits are used by ReadMetaData, PageHeader and GetBools, and by
+// readers that aren't given other Limits.  They are much larger than any
+// page or footer written by this package.
+var DefaultLimits = Limits{
+	MaxFooterSize: 64 << 20,
+	MaxPageSize:   256 << 20,
+	MaxPageValues: 64 << 20,
+}
+
+func (l Limits) checkFooter(n int64) error {
+	if l.MaxFooterSize > 0 && n > l.MaxFooterSize {
+		return corruptFooter("footer size %d is larger than the limit of %d", n, l.MaxFooterSize)
+	}
+	return nil
+}
+
+func (l Limits) checkPage(size, values int32) error {
+	if l.MaxPageSize > 0 && size > l.MaxPageSize {
+		return corruptPage("page size %d is larger than the limit of %d", size, l.MaxPageSize)
+	}
+	if l.MaxPageValues > 0 && values > l.MaxPageValues {
+		return corruptPage("%d values is more than the limit of %d", values, l.MaxPageValues)
+	}
+	return nil
+}
+
+// limitedTransport is a thrift transport that can read at most n bytes.
+// Thrift checks the length of strings and lists against RemainingBytes
+// before it allocates them.
+type limitedTransport struct {
+	*thrift.StreamTransport
+	r *io.LimitedReader
+}
+
+func newLimitedTransport(r io.Reader, n int64) *limitedTransport {
+	lr := &io.LimitedReader{R: r, N: n}
+	return &limitedTransport{
+		StreamTransport: &thrift.StreamTransport{Reader: lr},
+		r:               lr,
+	}
+}
+
+func (t *limitedTransport) RemainingBytes() uint64 {
+	return uint64(t.r.N)
+}
@@ -4,12 +4,17 @@
 package parquet
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
+	"math"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/apache/thrift/lib/go/thrift"
 	sch "github.com/parsyl/parquet/schema"
@@ -22,6 +27,9 @@ type Field struct {
 	Types          []int
 	Type           FieldFunc
 	RepetitionType FieldFunc
+	// IDs are the field ids of each level of Path, -1 for
+	// levels without one.  It is nil if there aren't any.
+	IDs []int
 }
 
 // Page keeps track of metadata for each ColumnChunk
@@ -31,6 +39,25 @@ type Page struct {
 	Size   int
 	Offset int64
 	Codec  sch.CompressionCodec
+
+	// typ is the physical type of the column and dictionary is
+	// true if the chunk starts with a dictionary page.
+	typ        sch.Type
+	dictionary bool
+
+	decrypt  *pageDecryptor
+	verify   bool
+	limits   Limits
+	zeroCopy bool
+	column   string
+	rowGroup int
+}
+
+// Section returns an io.SectionReader that covers the column chunk
+// described by pg.  It can be passed to DoRead without disturbing
+// the position of any other reader of r.
+func (pg Page) Section(r io.ReaderAt) *io.SectionReader {
+	return io.NewSectionReader(r, pg.Offset, int64(pg.Size))
 }
 
 type schema struct {
@@ -40,35 +67,34 @@ type schema struct {
 
 func (s schema) schema() (int64, []*sch.SchemaElement) {
 	out := make([]*sch.SchemaElement, 0, len(s.fields)+1)
-	out = append(out, &sch.SchemaElement{
-		Name: "root",
-	})
+	root := &sch.SchemaElement{
+		Name:        "root",
+		NumChildren: new(int32),
+	}
+	out = append(out, root)
 
-	var children int32
 	var z int32
+	// groups are keyed by their full path so that groups with
+	// the same name in different parts of the tree are kept apart
 	m := map[string]*sch.SchemaElement{}
 	for _, f := range s.fields {
-		if len(f.Path) > 1 {
-			for i, name := range f.Path[:len(f.Path)-1] {
-				par, ok := m[name]
-				if !ok {
-					children++
-					parts := strings.Split(name, ".")
-					rt := sch.FieldRepetitionType(f.Types[i])
-					par = &sch.SchemaElement{
-						Name:           parts[len(parts)-1],
-						RepetitionType: &rt,
-						NumChildren:    &z,
-					}
-					out = append(out, par)
+		par := root
+		for i, name := range f.Path[:len(f.Path)-1] {
+			key := strings.Join(f.Path[:i+1], ".")
+			group, ok := m[key]
+			if !ok {
+				rt := sch.FieldRepetitionType(f.Types[i])
+				group = &sch.SchemaElement{
+					Name:           name,
+					RepetitionType: &rt,
+					NumChildren:    new(int32),
+					FieldID:        f.id(i),
 				}
-				n := *par.NumChildren
-				n++
-				par.NumChildren = &n
-				m[name] = par
+				out = append(out, group)
+				*par.NumChildren++
+				m[key] = group
 			}
-		} else if len(f.Path) == 1 {
-			children++
+			par = group
 		}
 
 		se := &sch.SchemaElement{
@@ -76,30 +102,119 @@ func (s schema) schema() (int64, []*sch.SchemaElement) {
 			TypeLength: &z,
 			Scale:      &z,
 			Precision:  &z,
-			FieldID:    &z,
+			FieldID:    f.id(len(f.Path) - 1),
 		}
 
 		f.Type(se)
 		f.RepetitionType(se)
 		out = append(out, se)
+		*par.NumChildren++
 	}
 
-	out[0].NumChildren = &children
 	return int64(len(s.fields)), out
 }
 
+// legacySchema returns the schema that older versions of this package
+// wrote for s.  They kept track of groups by name alone and counted every
+// new group as a child of the root, so structs that are nested more than
+// one level deep got the wrong number of children, and every column had
+// a field id of 0.
+func (s schema) legacySchema() []*sch.SchemaElement {
+	out := make([]*sch.SchemaElement, 0, len(s.fields)+1)
+	root := &sch.SchemaElement{
+		Name:        "root",
+		NumChildren: new(int32),
+	}
+	out = append(out, root)
+
+	var z int32
+	m := map[string]*sch.SchemaElement{}
+	for _, f := range s.fields {
+		for i, name := range f.Path[:len(f.Path)-1] {
+			group, ok := m[name]
+			if !ok {
+				*root.NumChildren++
+				rt := sch.FieldRepetitionType(f.Types[i])
+				group = &sch.SchemaElement{
+					Name:           name,
+					RepetitionType: &rt,
+					NumChildren:    new(int32),
+				}
+				out = append(out, group)
+				m[name] = group
+			}
+			*group.NumChildren++
+		}
+
+		if len(f.Path) == 1 {
+			*root.NumChildren++
+		}
+
+		se := &sch.SchemaElement{
+			Name:       f.Path[len(f.Path)-1],
+			TypeLength: &z,
+			Scale:      &z,
+			Precision:  &z,
+			FieldID:    &z,
+		}
+
+		f.Type(se)
+		f.RepetitionType(se)
+		out = append(out, se)
+	}
+	return out
+}
+
+// id returns the field id of the i'th level of
+// f's path, or nil if it doesn't have one.
+func (f Field) id(i int) *int32 {
+	if i >= len(f.IDs) || f.IDs[i] < 0 {
+		return nil
+	}
+	id := int32(f.IDs[i])
+	return &id
+}
+
 // Metadata keeps track of the things that need to
 // be kept track of in order to write the FileMetaData
 // at the end of the parquet file.
 type Metadata struct {
+	// mu guards the page header accounting so that
+	// columns can be written by concurrent goroutines.
+	mu           sync.Mutex
 	ts           *thrift.TSerializer
 	schema       schema
 	docs         int64
 	pageDocs     int64
 	rowGroupDocs int64
 	rowGroups    []RowGroup
-
-	metadata *sch.FileMetaData
+	enc          *fileEncryptor
+	checksums    bool
+
+	metadata   *sch.FileMetaData
+	decryption *Decryption
+	dec        *fileDecryptor
+	verify     bool
+	limits     Limits
+	zeroCopy   bool
+	statsSize  int
+
+	// columnNames maps the columns of the file that was read
+	// to the fields that read them when fieldIDs is true.
+	fieldIDs    bool
+	columnNames map[string]string
+
+	// appended is the footer of the file that
+	// new row groups are being appended to.
+	appended *sch.FileMetaData
+	appendAt int64
+
+	// keyValues is written to the footer (Compact uses
+	// it to keep the key value metadata of its inputs).
+	keyValues []*sch.KeyValue
+
+	// sorting is written to each new row group's SortingColumns.
+	sorting []*sch.SortingColumn
 }
 
 // Stats is passed in by each column's call to DoWrite
@@ -118,6 +233,7 @@ func New(fields ...Field) *Metadata {
 	m := &Metadata{
 		ts:     ts,
 		schema: schemaElements(fields),
+		limits: DefaultLimits,
 	}
 
 	m.StartRowGroup(fields...)
@@ -128,11 +244,262 @@ func New(fields ...Field) *Metadata {
 func (m *Metadata) StartRowGroup(fields ...Field) {
 	m.rowGroupDocs = 0
 	m.rowGroups = append(m.rowGroups, RowGroup{
-		fields:  schemaElements(fields),
-		columns: make(map[string]sch.ColumnChunk),
+		fields:       schemaElements(fields),
+		columns:      make(map[string]sch.ColumnChunk),
+		pages:        make(map[string]int),
+		dictionaries: make(map[string]*dictionary),
+		blooms:       make(map[string]map[uint64]struct{}),
 	})
 }
 
+// Encrypt turns on parquet modular encryption for the file
+// that is being written.  It must be called before any pages
+// are written.
+func (m *Metadata) Encrypt(e *Encryption) error {
+	enc, err := newFileEncryptor(e, m.schema.fields)
+	if err != nil {
+		return err
+	}
+	m.enc = enc
+	return nil
+}
+
+// WriteChecksums turns on writing the CRC32 of each page's
+// (compressed) data to its page header.
+func (m *Metadata) WriteChecksums() {
+	m.checksums = true
+}
+
+// VerifyChecksums makes the Pages that are returned by Pages
+// check the CRC32 of each page that has one when it is read.
+func (m *Metadata) VerifyChecksums() {
+	m.verify = true
+}
+
+// ZeroCopyStrings makes the Pages that are returned by Pages decode
+// strings that share the memory of their column chunk's data (see
+// Page.DecodeStrings) instead of allocating each string separately.
+func (m *Metadata) ZeroCopyStrings() {
+	m.zeroCopy = true
+}
+
+// MatchFieldIDs makes ReadFooter match the columns of the file to
+// the fields that m was created with by field id rather than by name
+// (see FieldName).  Fields without an id are still matched by name.
+func (m *Metadata) MatchFieldIDs() {
+	m.fieldIDs = true
+}
+
+// FieldName returns the name of the field that reads column, which
+// is column itself unless MatchFieldIDs was called.  It is "" when
+// the column has a field id that none of the fields have.
+func (m *Metadata) FieldName(column string) string {
+	if !m.fieldIDs {
+		return column
+	}
+	return m.columnNames[column]
+}
+
+// matchFieldIDs works out the columnNames of the file that was read.
+func (m *Metadata) matchFieldIDs() error {
+	if !m.fieldIDs || m.metadata == nil {
+		return nil
+	}
+
+	file, err := SchemaFields(m.metadata.Schema)
+	if err != nil {
+		return corruptFooter("invalid schema: %s", err)
+	}
+
+	ids := map[int]string{}
+	names := map[string]bool{}
+	for _, f := range m.schema.fields {
+		if id, ok := leafID(f); ok {
+			ids[id] = f.Name
+		} else {
+			names[f.Name] = true
+		}
+	}
+
+	m.columnNames = make(map[string]string, len(file))
+	seen := map[int]string{}
+	for _, f := range file {
+		id, ok := leafID(f)
+		if !ok {
+			if names[f.Name] {
+				m.columnNames[f.Name] = f.Name
+			}
+			continue
+		}
+
+		if col, ok := seen[id]; ok {
+			return corruptFooter("columns %s and %s have the same field id %d", col, f.Name, id)
+		}
+		seen[id] = f.Name
+
+		if name, ok := ids[id]; ok {
+			m.columnNames[f.Name] = name
+		} else if names[f.Name] {
+			m.columnNames[f.Name] = f.Name
+		}
+	}
+	return nil
+}
+
+// leafID returns the field id of the column of f.
+func leafID(f Field) (int, bool) {
+	if len(f.IDs) < len(f.Path) || f.IDs[len(f.Path)-1] < 0 {
+		return 0, false
+	}
+	return f.IDs[len(f.Path)-1], true
+}
+
+// TruncateStats limits the min and max statistics of byte array
+// (string) columns to n bytes.  A longer min is cut to its first n
+// bytes and a longer max is cut to n bytes with the last byte
+// incremented so that they still bound the values, and the
+// statistics are marked as not exact.  Zero means no limit.
+func (m *Metadata) TruncateStats(n int) {
+	m.statsSize = n
+}
+
+// SortingColumn is one of the columns that the rows of
+// a row group are sorted by (see Metadata.SortingColumns).
+type SortingColumn struct {
+	// Column is the path of the column, for example hobby.name.
+	Column     string
+	Descending bool
+	NullsFirst bool
+}
+
+// SortingColumns records that the rows of every row group that is
+// written are sorted by cols (the first column, then the second, and
+// so on) in the footer's RowGroup.SortingColumns.  It doesn't sort
+// anything itself.  The columns must be in the schema and can't be
+// repeated.
+func (m *Metadata) SortingColumns(cols []SortingColumn) error {
+	sorting := make([]*sch.SortingColumn, len(cols))
+	for i, col := range cols {
+		idx := -1
+		for j, f := range m.schema.fields {
+			if strings.Join(f.Path, ".") == col.Column {
+				idx = j
+				break
+			}
+		}
+
+		if idx == -1 {
+			return fmt.Errorf("can't sort by %s, there is no such column", col.Column)
+		}
+
+		if getRepetitionTypes(m.schema.fields[idx].Types).MaxRep() > 0 {
+			return fmt.Errorf("can't sort by %s, it is repeated", col.Column)
+		}
+
+		sorting[i] = &sch.SortingColumn{
+			ColumnIdx:  int32(idx),
+			Descending: col.Descending,
+			NullsFirst: col.NullsFirst,
+		}
+	}
+
+	m.sorting = sorting
+	return nil
+}
+
+func (m *Metadata) truncateStats(st *sch.Statistics) {
+	if st.MinValue == nil || st.MaxValue == nil {
+		return
+	}
+
+	var minExact, maxExact bool
+	st.MinValue, minExact = truncateMin(st.MinValue, m.statsSize)
+	st.MaxValue, maxExact = truncateMax(st.MaxValue, m.statsSize)
+	st.IsMinValueExact = &minExact
+	st.IsMaxValueExact = &maxExact
+}
+
+// truncateMin returns the first n bytes of min, which sort
+// before (or the same as) min.
+func truncateMin(min []byte, n int) ([]byte, bool) {
+	if len(min) <= n {
+		return min, true
+	}
+	return min[:n:n], false
+}
+
+// truncateMax returns the shortest value of at most n bytes that sorts
+// after max.  If every one of the first n bytes is 0xff there is no
+// such value and max is returned as is.
+func truncateMax(max []byte, n int) ([]byte, bool) {
+	if len(max) <= n {
+		return max, true
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		if max[i] < 0xff {
+			out := make([]byte, i+1)
+			copy(out, max)
+			out[i]++
+			return out, false
+		}
+	}
+	return max, true
+}
+
+// Checksum returns the CRC32 of a page's data if checksums are
+// turned on, otherwise nil.  The result is passed to WritePageHeader.
+func (m *Metadata) Checksum(data []byte) *int32 {
+	if !m.checksums {
+		return nil
+	}
+	crc := int32(crc32.ChecksumIEEE(data))
+	return &crc
+}
+
+// SetLimits replaces the DefaultLimits that are used by ReadFooter,
+// ReadFooterAt and the Pages that are returned by Pages.
+func (m *Metadata) SetLimits(l Limits) {
+	m.limits = l
+}
+
+// Decrypt sets the keys that are used by ReadFooter and
+// ReadFooterAt to read files that are encrypted.
+func (m *Metadata) Decrypt(d *Decryption) {
+	m.decryption = d
+}
+
+// EncryptPage encrypts the data of the next page of the column
+// at pth.  The data is returned as is if the column isn't encrypted.
+func (m *Metadata) EncryptPage(pth []string, data []byte) ([]byte, error) {
+	if m.enc == nil {
+		return data, nil
+	}
+
+	col := strings.Join(pth, ".")
+	key, ok := m.enc.key(col)
+	if !ok {
+		return data, nil
+	}
+
+	m.mu.Lock()
+	rg, page := m.rowGroupOrdinal(), m.rowGroups[len(m.rowGroups)-1].pages[col]
+	m.mu.Unlock()
+	return m.enc.encrypt(key, moduleDataPage, rg, m.enc.ordinals[col], page, data)
+}
+
+// rowGroupOrdinal is the position the current row group
+// will have in the footer, which skips empty row groups.
+func (m *Metadata) rowGroupOrdinal() int {
+	var n int
+	for _, rg := range m.rowGroups[:len(m.rowGroups)-1] {
+		if rg.rowGroup.NumRows > 0 {
+			n++
+		}
+	}
+	return n
+}
+
 // NextDoc keeps track of how many documents have been
 // added to this parquet file.  The final value of m.docs
 // is used for the FileMetaData.NumRows
@@ -155,25 +522,48 @@ func (m *Metadata) RowGroups() []RowGroup {
 }
 
 // WritePageHeader is called in order to finish writing to a column chunk.
-func (m *Metadata) WritePageHeader(w io.Writer, pth []string, dataLen, compressedLen, defCount, count int, defLen, repLen int64, comp sch.CompressionCodec, stats Stats) error {
+// crc is the CRC32 of the page's data (see Checksum) and may be nil.
+// The page header has no statistics if stats is nil.
+func (m *Metadata) WritePageHeader(w io.Writer, pth []string, dataLen, compressedLen, defCount, count int, defLen, repLen int64, comp sch.CompressionCodec, stats Stats, crc *int32) error {
+	return m.writePageHeader(w, pth, dataLen, compressedLen, count, comp, sch.Encoding_PLAIN, stats, crc)
+}
+
+// writePageHeader is WritePageHeader for a page whose
+// values have the encoding enc.
+func (m *Metadata) writePageHeader(w io.Writer, pth []string, dataLen, compressedLen, count int, comp sch.CompressionCodec, enc sch.Encoding, stats Stats, crc *int32) error {
+	var st *sch.Statistics
+	if stats != nil {
+		st = &sch.Statistics{
+			NullCount:     stats.NullCount(),
+			DistinctCount: stats.DistinctCount(),
+			MinValue:      stats.Min(),
+			MaxValue:      stats.Max(),
+		}
+	}
+
+	if st != nil && m.statsSize > 0 {
+		if typ, err := columnType(strings.Join(pth, "."), m.schema); err == nil && typ == sch.Type_BYTE_ARRAY {
+			m.truncateStats(st)
+		}
+	}
+
 	ph := &sch.PageHeader{
 		Type:                 sch.PageType_DATA_PAGE,
 		UncompressedPageSize: int32(dataLen),
 		CompressedPageSize:   int32(compressedLen),
+		Crc:                  crc,
 		DataPageHeader: &sch.DataPageHeader{
 			NumValues:               int32(count),
-			Encoding:                sch.Encoding_PLAIN,
+			Encoding:                enc,
 			DefinitionLevelEncoding: sch.Encoding_RLE,
 			RepetitionLevelEncoding: sch.Encoding_RLE,
-			Statistics: &sch.Statistics{
-				NullCount:     stats.NullCount(),
-				DistinctCount: stats.DistinctCount(),
-				MinValue:      stats.Min(),
-				MaxValue:      stats.Max(),
-			},
+			Statistics:              st,
 		},
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.pageDocs = 0
 
 	buf, err := m.ts.Write(context.TODO(), ph)
@@ -181,6 +571,18 @@ func (m *Metadata) WritePageHeader(w io.Writer, pth []string, dataLen, compresse
 		return err
 	}
 
+	if m.enc != nil {
+		col := strings.Join(pth, ".")
+		if key, ok := m.enc.key(col); ok {
+			rg := m.rowGroups[len(m.rowGroups)-1]
+			buf, err = m.enc.encrypt(key, moduleDataPageHeader, m.rowGroupOrdinal(), m.enc.ordinals[col], rg.pages[col], buf)
+			if err != nil {
+				return err
+			}
+			rg.pages[col]++
+		}
+	}
+
 	if err := m.updateRowGroup(pth, dataLen, compressedLen, len(buf), count, comp); err != nil {
 		return err
 	}
@@ -211,23 +613,270 @@ func columnType(col string, fields schema) (sch.Type, error) {
 	return *f.Type, nil
 }
 
+// Append prepares m to add row groups to the end of the existing parquet
+// file r.  It reads the footer of r, checks that r has the schema that m
+// writes and returns the offset of the start of the footer, which is where
+// the new row groups must be written.  Footer then writes the existing row
+// groups followed by the new ones.  Encrypted files can't be appended to.
+func (m *Metadata) Append(r io.ReadSeeker) (int64, error) {
+	if m.enc != nil {
+		return 0, fmt.Errorf("unable to append to an encrypted file")
+	}
+
+	size, _, err := getTail(r, m.limits)
+	if err != nil {
+		return 0, err
+	}
+
+	footer, err := readMetaData(r, m.limits)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := m.checkSchema(footer.Schema); err != nil {
+		return 0, err
+	}
+
+	end, err := r.Seek(-(size + 8), io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	m.appended = footer
+	m.appendAt = end
+	return end, nil
+}
+
+// checkSchema returns an ErrSchemaMismatch if elems (the schema
+// of an existing file) isn't the schema m writes, or the schema
+// that older versions of this package wrote for m's fields.
+func (m *Metadata) checkSchema(elems []*sch.SchemaElement) error {
+	_, exp := m.schema.schema()
+	err := compareSchema(elems, exp)
+	if err != nil && compareSchema(elems, m.schema.legacySchema()) == nil {
+		return nil
+	}
+	return err
+}
+
+// compareSchema returns an ErrSchemaMismatch if elems isn't the
+// same schema as exp.  The names of the root elements don't have
+// to match.
+func compareSchema(elems, exp []*sch.SchemaElement) error {
+	if len(elems) != len(exp) {
+		return fmt.Errorf("%w: the file has %d schema elements, expected %d", ErrSchemaMismatch, len(elems), len(exp))
+	}
+
+	for i, se := range elems {
+		e := exp[i]
+		if se == nil || e == nil || (i > 0 && se.Name != e.Name) ||
+			se.IsSetType() != e.IsSetType() || se.GetType() != e.GetType() ||
+			se.GetRepetitionType() != e.GetRepetitionType() ||
+			se.GetNumChildren() != e.GetNumChildren() ||
+			se.IsSetConvertedType() != e.IsSetConvertedType() || se.GetConvertedType() != e.GetConvertedType() ||
+			se.IsSetFieldID() != e.IsSetFieldID() || se.GetFieldID() != e.GetFieldID() ||
+			!reflect.DeepEqual(se.LogicalType, e.LogicalType) {
+			return fmt.Errorf("%w: schema element %d is %s, expected %s", ErrSchemaMismatch, i, se, e)
+		}
+	}
+	return nil
+}
+
+// ValidateSchema checks the schema of the file whose footer was read
+// by ReadFooter against the fields that m was created with (see the
+// package level ValidateSchema).
+func (m *Metadata) ValidateSchema(strict bool) error {
+	file, err := SchemaFields(m.metadata.Schema)
+	if err != nil {
+		// older versions of this package wrote a malformed schema for
+		// structs that are nested more than one level deep, which can
+		// still be read if it is exactly what they wrote for m's fields
+		if compareSchema(m.metadata.Schema, m.schema.legacySchema()) == nil {
+			return nil
+		}
+		return fmt.Errorf("%w: invalid schema: %s", ErrSchemaMismatch, err)
+	}
+
+	// columns whose field id none of the fields have
+	// can't be compared, they can only be unexpected
+	var matched []Field
+	var extra []ColumnMismatch
+	for _, f := range file {
+		name := m.FieldName(f.Name)
+		if name == "" {
+			extra = append(extra, ColumnMismatch{Column: f.Name, File: describeField(f)})
+			continue
+		}
+		f.Name = name
+		matched = append(matched, f)
+	}
+
+	err = validateSchema(matched, m.schema.fields, strict)
+	if !strict || len(extra) == 0 {
+		return err
+	}
+
+	se, ok := err.(*SchemaError)
+	if !ok {
+		se = &SchemaError{}
+	}
+	se.Columns = append(se.Columns, extra...)
+	return se
+}
+
+// ValidateSchema returns a *SchemaError that lists the columns of elems
+// (the schema of a file) whose physical type, timestamp unit or
+// repetition (at each level of nesting) doesn't match fields.  Columns that only one of them
+// has are left out unless strict is true.  If elems isn't a valid schema
+// the error wraps ErrSchemaMismatch too.
+func ValidateSchema(elems []*sch.SchemaElement, fields []Field, strict bool) error {
+	file, err := SchemaFields(elems)
+	if err != nil {
+		return fmt.Errorf("%w: invalid schema: %s", ErrSchemaMismatch, err)
+	}
+	return validateSchema(file, fields, strict)
+}
+
+func validateSchema(file, fields []Field, strict bool) error {
+	lookup := make(map[string]Field, len(file))
+	for _, f := range file {
+		lookup[f.Name] = f
+	}
+
+	var out []ColumnMismatch
+	expected := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		expected[f.Name] = true
+		ff, ok := lookup[f.Name]
+		if !ok {
+			if strict {
+				out = append(out, ColumnMismatch{Column: f.Name, Expected: describeField(f)})
+			}
+			continue
+		}
+
+		if fd, ed := describeField(ff), describeField(f); fd != ed {
+			out = append(out, ColumnMismatch{Column: f.Name, File: fd, Expected: ed})
+		}
+	}
+
+	if strict {
+		for _, f := range file {
+			if !expected[f.Name] {
+				out = append(out, ColumnMismatch{Column: f.Name, File: describeField(f)})
+			}
+		}
+	}
+
+	if len(out) > 0 {
+		return &SchemaError{Columns: out}
+	}
+	return nil
+}
+
+// describeField returns the repetition of each level of f's
+// path and its physical type, for example "optional/repeated int64".
+// Timestamps also have their unit and whether they are adjusted
+// to UTC, for example "required int64 timestamp(millis, utc)".
+// Required fields only have a repetition for their last level, so
+// the levels above it are filled in.
+func describeField(f Field) string {
+	types := f.Types
+	if len(types) < len(f.Path) {
+		types = append(make([]int, len(f.Path)-len(types)), types...)
+	}
+
+	reps := make([]string, len(types))
+	for i, t := range types {
+		reps[i] = strings.ToLower(sch.FieldRepetitionType(t).String())
+	}
+
+	var se sch.SchemaElement
+	if f.Type != nil {
+		f.Type(&se)
+	}
+
+	typ := "<nil>"
+	if se.Type != nil {
+		typ = strings.ToLower(se.Type.String())
+	}
+	if ts := describeTimestamp(se); ts != "" {
+		typ += " " + ts
+	}
+	return fmt.Sprintf("%s %s", strings.Join(reps, "/"), typ)
+}
+
+// describeTimestamp returns the unit of a TIMESTAMP column and whether
+// it is adjusted to UTC, for example "timestamp(micros, local)".
+// Files that only have the older converted types are always in UTC.
+func describeTimestamp(se sch.SchemaElement) string {
+	unit, utc := "<nil>", true
+	switch {
+	case se.LogicalType != nil && se.LogicalType.TIMESTAMP != nil:
+		ts := se.LogicalType.TIMESTAMP
+		utc = ts.IsAdjustedToUTC
+		switch u := ts.Unit; {
+		case u == nil:
+		case u.MILLIS != nil:
+			unit = "millis"
+		case u.MICROS != nil:
+			unit = "micros"
+		case u.NANOS != nil:
+			unit = "nanos"
+		}
+	case se.IsSetConvertedType() && se.GetConvertedType() == sch.ConvertedType_TIMESTAMP_MILLIS:
+		unit = "millis"
+	case se.IsSetConvertedType() && se.GetConvertedType() == sch.ConvertedType_TIMESTAMP_MICROS:
+		unit = "micros"
+	default:
+		return ""
+	}
+
+	zone := "utc"
+	if !utc {
+		zone = "local"
+	}
+	return fmt.Sprintf("timestamp(%s, %s)", unit, zone)
+}
+
 // Rows return the total number of rows that are being written
 // in to a parquet file.
 func (m *Metadata) Rows() int64 {
 	return m.metadata.NumRows
 }
 
-// Footer writes the FileMetaData at the end of the file.
+// Footer writes the FileMetaData at the end of the file, after
+// the bloom filters of the columns that have them.
 func (m *Metadata) Footer(w io.Writer) error {
 	_, s := m.schema.schema()
 	fmd := &sch.FileMetaData{
-		Version:   1,
-		Schema:    s,
-		NumRows:   m.docs,
-		RowGroups: make([]*sch.RowGroup, 0, len(m.rowGroups)),
+		Version:          1,
+		Schema:           s,
+		NumRows:          m.docs,
+		RowGroups:        make([]*sch.RowGroup, 0, len(m.rowGroups)),
+		KeyValueMetadata: m.keyValues,
 	}
 
 	pos := int64(4)
+	if m.appended != nil {
+		pos = m.appendAt
+		fmd.NumRows += m.appended.NumRows
+		fmd.RowGroups = append(fmd.RowGroups, m.appended.RowGroups...)
+		fmd.KeyValueMetadata = m.appended.KeyValueMetadata
+		fmd.CreatedBy = m.appended.CreatedBy
+	}
+
+	// the bloom filters go between the last row group and the footer
+	end := pos
+	for _, mrg := range m.rowGroups {
+		if mrg.rowGroup.NumRows > 0 {
+			for _, ch := range mrg.columns {
+				end += ch.MetaData.TotalCompressedSize
+			}
+		}
+	}
+	var blooms bytes.Buffer
+
 	for _, mrg := range m.rowGroups {
 		rg := mrg.rowGroup
 		if rg.NumRows == 0 {
@@ -235,21 +884,56 @@ func (m *Metadata) Footer(w io.Writer) error {
 		}
 
 		for _, col := range mrg.fields.fields {
-			ch, ok := mrg.columns[strings.Join(col.Path, ".")]
+			name := strings.Join(col.Path, ".")
+			ch, ok := mrg.columns[name]
 			if !ok {
 				continue
 			}
 
 			ch.FileOffset = pos
 			ch.MetaData.DataPageOffset = pos
+			if d, ok := mrg.dictionaries[name]; ok {
+				if d.pageLen == 0 {
+					return fmt.Errorf("the pages of column %s were never written, see WriteDictionary", name)
+				}
+				dict := pos
+				ch.MetaData.DictionaryPageOffset = &dict
+				ch.MetaData.DataPageOffset += d.pageLen
+			}
+
+			if hashes, ok := mrg.blooms[name]; ok {
+				buf, err := m.bloomFilter(name, hashes, len(fmd.RowGroups))
+				if err != nil {
+					return err
+				}
+				offset := end + int64(blooms.Len())
+				ch.MetaData.BloomFilterOffset = &offset
+				blooms.Write(buf)
+			}
 			rg.TotalByteSize += ch.MetaData.TotalCompressedSize
-			rg.Columns = append(rg.Columns, &ch)
 			pos += ch.MetaData.TotalCompressedSize
+			if m.enc != nil {
+				if err := m.enc.encryptColumn(m.ts, &ch, len(fmd.RowGroups)); err != nil {
+					return err
+				}
+			}
+			rg.Columns = append(rg.Columns, &ch)
 		}
 
+		if len(m.sorting) > 0 {
+			rg.SortingColumns = m.sorting
+		}
 		fmd.RowGroups = append(fmd.RowGroups, &rg)
 	}
 
+	if _, err := w.Write(blooms.Bytes()); err != nil {
+		return err
+	}
+
+	if m.enc != nil {
+		return m.enc.footer(w, m.ts, fmd)
+	}
+
 	buf, err := m.ts.Write(context.TODO(), fmd)
 	if err != nil {
 		return err
@@ -267,10 +951,15 @@ func (m *Metadata) Footer(w io.Writer) error {
 // that are used to keep track of number of rows written, byte size,
 // etc.
 type RowGroup struct {
-	fields   schema
-	rowGroup sch.RowGroup
-	columns  map[string]sch.ColumnChunk
-	child    *RowGroup
+	fields       schema
+	rowGroup     sch.RowGroup
+	columns      map[string]sch.ColumnChunk
+	pages        map[string]int
+	dictionaries map[string]*dictionary
+	// blooms are the hashes of the distinct values of
+	// the columns that have bloom filters.
+	blooms map[string]map[uint64]struct{}
+	child  *RowGroup
 
 	Rows int64
 }
@@ -316,7 +1005,7 @@ func schemaElements(fields []Field) schema {
 			TypeLength: &z,
 			Scale:      &z,
 			Precision:  &z,
-			FieldID:    &z,
+			FieldID:    f.id(len(f.Path) - 1),
 		}
 
 		f.Type(&se)
@@ -327,27 +1016,137 @@ func schemaElements(fields []Field) schema {
 	return schema{lookup: m, fields: fields}
 }
 
-// Pages maps each column name to its Pages
+// SchemaFields returns a Field for each column of a parquet schema
+// (the Schema of a FileMetaData) in the order the columns are stored.
+func SchemaFields(elems []*sch.SchemaElement) ([]Field, error) {
+	if len(elems) == 0 || elems[0] == nil {
+		return nil, fmt.Errorf("the schema is empty")
+	}
+
+	var out []Field
+	i := 1
+	var walk func(pth []string, types, ids []int, n int32) error
+	walk = func(pth []string, types, ids []int, n int32) error {
+		for j := int32(0); j < n; j++ {
+			if i >= len(elems) || elems[i] == nil {
+				return fmt.Errorf("the schema is missing elements")
+			}
+
+			se := *elems[i]
+			i++
+
+			var rt int
+			if se.RepetitionType != nil {
+				rt = int(*se.RepetitionType)
+			}
+			if rt < 0 || rt >= len(fieldFuncs) {
+				return fmt.Errorf("invalid repetition type %d for %s", rt, se.Name)
+			}
+
+			id := -1
+			if se.FieldID != nil {
+				id = int(*se.FieldID)
+			}
+
+			p := append(pth[:len(pth):len(pth)], se.Name)
+			t := append(types[:len(types):len(types)], rt)
+			d := append(ids[:len(ids):len(ids)], id)
+			if se.NumChildren != nil && *se.NumChildren > 0 {
+				if se.Type != nil {
+					return fmt.Errorf("group %s has a type", strings.Join(p, "."))
+				}
+
+				if err := walk(p, t, d, *se.NumChildren); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if se.Type == nil {
+				return fmt.Errorf("column %s doesn't have a type", strings.Join(p, "."))
+			}
+
+			// IDs is left nil when none of the levels have an id
+			var fieldIDs []int
+			for _, id := range d {
+				if id >= 0 {
+					fieldIDs = d
+				}
+			}
+
+			out = append(out, Field{
+				Name:  strings.Join(p, "."),
+				Path:  p,
+				Types: t,
+				IDs:   fieldIDs,
+				Type: func(dst *sch.SchemaElement) {
+					dst.Type = se.Type
+					dst.ConvertedType = se.ConvertedType
+					dst.LogicalType = se.LogicalType
+				},
+				RepetitionType: fieldFuncs[rt],
+			})
+		}
+		return nil
+	}
+
+	var n int32
+	if elems[0].NumChildren != nil {
+		n = *elems[0].NumChildren
+	}
+
+	if err := walk(nil, nil, nil, n); err != nil {
+		return nil, err
+	}
+
+	if i < len(elems) {
+		return nil, fmt.Errorf("the schema has %d elements that aren't in the tree", len(elems)-i)
+	}
+	return out, nil
+}
+
+// Pages maps each column name to its Pages.  Columns that aren't
+// in the schema that m was created with are included too.
 func (m *Metadata) Pages() (map[string][]Page, error) {
 	if len(m.metadata.RowGroups) == 0 {
 		return nil, nil
 	}
 	out := map[string][]Page{}
-	for _, rg := range m.metadata.RowGroups {
-		for _, ch := range rg.Columns {
-			pth := ch.MetaData.PathInSchema
-			_, ok := m.schema.lookup[strings.Join(pth, ".")]
-			if !ok {
-				return nil, fmt.Errorf("could not find schema for %v", pth)
+	for i, rg := range m.metadata.RowGroups {
+		for j, ch := range rg.Columns {
+			if ch.MetaData == nil {
+				return nil, fmt.Errorf("column %d of row group %d is encrypted, a Decryption with its key is required", j, i)
 			}
 
+			k := strings.Join(ch.MetaData.PathInSchema, ".")
+			offset := chunkOffset(ch.MetaData)
 			pg := Page{
-				N:      int(ch.MetaData.NumValues),
-				Offset: ch.FileOffset,
-				Size:   int(ch.MetaData.TotalCompressedSize),
-				Codec:  ch.MetaData.Codec,
+				N:          int(ch.MetaData.NumValues),
+				Offset:     offset,
+				Size:       int(ch.MetaData.TotalCompressedSize),
+				Codec:      ch.MetaData.Codec,
+				typ:        ch.MetaData.Type,
+				dictionary: offset != ch.MetaData.DataPageOffset,
+				verify:     m.verify,
+				limits:     m.limits,
+				zeroCopy:   m.zeroCopy,
+				column:     k,
+				rowGroup:   i,
 			}
-			k := strings.Join(pth, ".")
+
+			if m.dec != nil && ch.CryptoMetadata != nil {
+				key, err := m.dec.columnKey(ch.CryptoMetadata)
+				if err != nil {
+					return nil, err
+				}
+
+				o, err := m.dec.ordinal(ch)
+				if err != nil {
+					return nil, err
+				}
+				pg.decrypt = &pageDecryptor{file: m.dec, key: key, rg: i, col: o}
+			}
+
 			out[k] = append(out[k], pg)
 		}
 	}
@@ -356,43 +1155,207 @@ func (m *Metadata) Pages() (map[string][]Page, error) {
 
 // ReadMetaData reads the FileMetaData from the end of a parquet file
 func ReadMetaData(r io.ReadSeeker) (*sch.FileMetaData, error) {
-	p := thrift.NewTCompactProtocol(&thrift.StreamTransport{Reader: r})
-	size, err := getMetaDataSize(r)
+	return readMetaData(r, DefaultLimits)
+}
+
+func readMetaData(r io.ReadSeeker, l Limits) (*sch.FileMetaData, error) {
+	size, mgc, err := getTail(r, l)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = r.Seek(-int64(size+8), io.SeekEnd)
+	if mgc == magicEncrypted {
+		return nil, fmt.Errorf("%w: the footer is encrypted, use ReadDecryptedMetaData", ErrEncrypted)
+	}
+
+	end, err := r.Seek(-(size + 8), io.SeekEnd)
 	if err != nil {
 		return nil, err
 	}
 
+	p := thrift.NewTCompactProtocol(newLimitedTransport(r, size))
 	m := sch.NewFileMetaData()
-	return m, m.Read(p)
+	if err := m.Read(p); err != nil {
+		return nil, corruptFooter("%s", err)
+	}
+	return m, validateFooter(m, end)
+}
+
+// ReadMetaDataAt reads the FileMetaData from the end of a parquet file
+// of the given size.  Unlike ReadMetaData it does not depend on a seek
+// position, so r can be shared by concurrent readers.
+func ReadMetaDataAt(r io.ReaderAt, size int64) (*sch.FileMetaData, error) {
+	return readMetaDataAt(r, size, DefaultLimits)
+}
+
+func readMetaDataAt(r io.ReaderAt, size int64, l Limits) (*sch.FileMetaData, error) {
+	n, mgc, err := readTail(r, size, l)
+	if err != nil {
+		return nil, err
+	}
+
+	if mgc == magicEncrypted {
+		return nil, fmt.Errorf("%w: the footer is encrypted, use ReadDecryptedMetaData", ErrEncrypted)
+	}
+
+	p := thrift.NewTCompactProtocol(newLimitedTransport(io.NewSectionReader(r, size-8-n, n), n))
+	m := sch.NewFileMetaData()
+	if err := m.Read(p); err != nil {
+		return nil, corruptFooter("%s", err)
+	}
+	return m, validateFooter(m, size-8-n)
+}
+
+// validateFooter checks the parts of the FileMetaData that the
+// reader depends on so that a corrupt footer can't cause a panic
+// later on.  end is the offset of the start of the footer.
+func validateFooter(m *sch.FileMetaData, end int64) error {
+	if m.NumRows < 0 {
+		return corruptFooter("negative number of rows %d", m.NumRows)
+	}
+
+	var rows int64
+	for i, rg := range m.RowGroups {
+		if rg == nil {
+			return corruptFooter("row group %d is missing", i)
+		}
+
+		if rg.NumRows < 0 || rg.NumRows > m.NumRows-rows {
+			return corruptFooter("row group %d has an invalid number of rows %d", i, rg.NumRows)
+		}
+		rows += rg.NumRows
+
+		for j, ch := range rg.Columns {
+			if ch == nil || (ch.MetaData == nil && ch.CryptoMetadata == nil) {
+				return corruptFooter("row group %d is missing the metadata of column %d", i, j)
+			}
+
+			md := ch.MetaData
+			if md == nil {
+				continue
+			}
+
+			if md.NumValues < 0 || md.TotalCompressedSize < 0 {
+				return corruptFooter("column %s in row group %d has negative sizes", strings.Join(md.PathInSchema, "."), i)
+			}
+
+			start := chunkOffset(md)
+			if start < 4 || md.DataPageOffset > end || md.TotalCompressedSize > end-start {
+				return corruptFooter("column %s in row group %d is outside of the file", strings.Join(md.PathInSchema, "."), i)
+			}
+		}
+	}
+
+	if rows != m.NumRows {
+		return corruptFooter("the row groups have %d rows, expected %d", rows, m.NumRows)
+	}
+	return nil
+}
+
+// chunkOffset returns the offset of the first page of a column
+// chunk, which is its dictionary page if it has one.
+func chunkOffset(md *sch.ColumnMetaData) int64 {
+	if md.DictionaryPageOffset != nil && *md.DictionaryPageOffset < md.DataPageOffset {
+		return *md.DictionaryPageOffset
+	}
+	return md.DataPageOffset
 }
 
 // ReadFooter reads the parquet metadata
 func (m *Metadata) ReadFooter(r io.ReadSeeker) error {
-	meta, err := ReadMetaData(r)
+	if m.decryption != nil {
+		meta, dec, err := readDecryptedMetaData(r, m.decryption, m.limits)
+		m.metadata, m.dec = meta, dec
+		if err != nil {
+			return err
+		}
+		return m.matchFieldIDs()
+	}
+
+	meta, err := readMetaData(r, m.limits)
 	m.metadata = meta
-	return err
+	if err != nil {
+		return err
+	}
+	return m.matchFieldIDs()
+}
+
+// ReadFooterAt reads the parquet metadata from a file of the given size.
+func (m *Metadata) ReadFooterAt(r io.ReaderAt, size int64) error {
+	if m.decryption != nil {
+		return m.ReadFooter(io.NewSectionReader(r, 0, size))
+	}
+
+	meta, err := readMetaDataAt(r, size, m.limits)
+	m.metadata = meta
+	if err != nil {
+		return err
+	}
+	return m.matchFieldIDs()
 }
 
 // PageHeader reads the page header from a column page
 func PageHeader(r io.Reader) (*sch.PageHeader, error) {
-	p := thrift.NewTCompactProtocol(&thrift.StreamTransport{Reader: r})
+	return readPageHeader(r, DefaultLimits)
+}
+
+func readPageHeader(r io.Reader, l Limits) (*sch.PageHeader, error) {
+	n := int64(l.MaxPageSize)
+	if n <= 0 {
+		n = math.MaxInt64
+	}
+
+	p := thrift.NewTCompactProtocol(newLimitedTransport(r, n))
 	pg := &sch.PageHeader{}
-	err := pg.Read(p)
-	return pg, err
+	if err := pg.Read(p); err != nil {
+		return pg, corruptPage("%s", err)
+	}
+
+	var values int32
+	switch {
+	case pg.DataPageHeader != nil:
+		values = pg.DataPageHeader.NumValues
+	case pg.DictionaryPageHeader != nil:
+		values = pg.DictionaryPageHeader.NumValues
+	}
+
+	if err := l.checkPage(pg.CompressedPageSize, values); err != nil {
+		return pg, err
+	}
+	return pg, l.checkPage(pg.UncompressedPageSize, 0)
 }
 
 // PageHeaders reads all the page headers without reading the actual
 // data.  It is used by parquetgen to print the page headers.
 func PageHeaders(footer *sch.FileMetaData, r io.ReadSeeker) ([]sch.PageHeader, error) {
 	var pageHeaders []sch.PageHeader
-	for _, rg := range footer.RowGroups {
-		for _, col := range rg.Columns {
-			h, err := PageHeadersAtOffset(r, col.MetaData.DataPageOffset, col.MetaData.NumValues)
+	for i, rg := range footer.RowGroups {
+		for j, col := range rg.Columns {
+			if col.MetaData == nil {
+				return nil, fmt.Errorf("column %d of row group %d is encrypted, a Decryption with its key is required", j, i)
+			}
+			h, err := PageHeadersAtOffset(r, chunkOffset(col.MetaData), col.MetaData.NumValues)
+			if err != nil {
+				return nil, err
+			}
+			pageHeaders = append(pageHeaders, h...)
+		}
+	}
+	return pageHeaders, nil
+}
+
+// PageHeadersAt reads all the page headers without reading the actual
+// data.  Each column chunk is read through its own io.SectionReader so
+// r can be shared by concurrent readers.
+func PageHeadersAt(footer *sch.FileMetaData, r io.ReaderAt) ([]sch.PageHeader, error) {
+	var pageHeaders []sch.PageHeader
+	for i, rg := range footer.RowGroups {
+		for j, col := range rg.Columns {
+			if col.MetaData == nil {
+				return nil, fmt.Errorf("column %d of row group %d is encrypted, a Decryption with its key is required", j, i)
+			}
+			sr := io.NewSectionReader(r, chunkOffset(col.MetaData), col.MetaData.TotalCompressedSize)
+			h, err := PageHeadersAtOffset(sr, 0, col.MetaData.NumValues)
 			if err != nil {
 				return nil, err
 			}
@@ -424,7 +1387,11 @@ func PageHeadersAtOffset(r io.ReadSeeker, o, n int64) ([]sch.PageHeader, error)
 		rc := &readCounter{r: r}
 		ph, err := PageHeader(rc)
 		if err != nil {
-			return nil, fmt.Errorf("unable to read page header: %s", err)
+			return nil, fmt.Errorf("unable to read page header: %w", err)
+		}
+
+		if ph.DataPageHeader == nil && ph.DictionaryPageHeader == nil {
+			return nil, fmt.Errorf("%w: %s page", ErrUnsupportedEncoding, ph.Type)
 		}
 		out = append(out, *ph)
 		_, err = r.Seek(int64(ph.CompressedPageSize), io.SeekCurrent)
@@ -432,7 +1399,9 @@ func PageHeadersAtOffset(r io.ReadSeeker, o, n int64) ([]sch.PageHeader, error)
 			return nil, fmt.Errorf("unable to seek to next page: %s", err)
 		}
 
-		nRead += int64(ph.DataPageHeader.NumValues)
+		if ph.DataPageHeader != nil {
+			nRead += int64(ph.DataPageHeader.NumValues)
+		}
 	}
 	return out, nil
 }
@@ -460,32 +1429,54 @@ func RepetitionRepeated(se *sch.SchemaElement) {
 
 var fieldFuncs = []FieldFunc{RepetitionRequired, RepetitionOptional, RepetitionRepeated}
 
-// GetBools reads a byte array and turns each bit into a bool
+// GetBools reads a byte array and turns each bit into a bool.
+// pageSizes are the number of booleans in each page, which can't
+// be more than the MaxPageValues of DefaultLimits.
 func GetBools(r io.Reader, n int, pageSizes []int) ([]bool, error) {
-	var vals [8]bool
-	data, _ := ioutil.ReadAll(r)
-	out := make([]bool, 0, n)
+	if n < 0 {
+		return nil, corruptPage("negative number of booleans %d", n)
+	}
+
+	l := DefaultLimits
 	for _, nVals := range pageSizes {
+		if nVals < 0 {
+			return nil, corruptPage("negative number of booleans %d", nVals)
+		}
+
+		if l.MaxPageValues > 0 && nVals > int(l.MaxPageValues) {
+			return nil, corruptPage("%d values is more than the limit of %d", nVals, l.MaxPageValues)
+		}
+	}
 
+	var vals [8]bool
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read booleans: %w", err)
+	}
+
+	out := make([]bool, 0, min(n, 8*len(data)))
+	for _, nVals := range pageSizes {
 		if nVals == 0 {
 			continue
 		}
 
-		l := (nVals / 8)
+		size := (nVals / 8)
 		if nVals%8 > 0 {
-			l++
+			size++
 		}
 
-		var i int
-		chunk := data[:l]
-		data = data[l:]
+		if size > len(data) {
+			return nil, corruptPage("expected %d bytes of booleans, got %d", size, len(data))
+		}
+
+		chunk := data[:size]
+		data = data[size:]
 		for _, b := range chunk {
 			vals = unpackBools(b)
 			m := min(nVals, 8)
 			for j := 0; j < m; j++ {
 				out = append(out, vals[j])
 			}
-			i += m
 			nVals -= m
 		}
 	}
@@ -513,12 +1504,54 @@ func unpackBools(data byte) [8]bool {
 	}
 }
 
-func getMetaDataSize(r io.ReadSeeker) (int, error) {
-	_, err := r.Seek(-8, io.SeekEnd)
+// getTail is readTail for an io.ReadSeeker.
+func getTail(r io.ReadSeeker, l Limits) (int64, string, error) {
+	size, err := r.Seek(0, io.SeekEnd)
 	if err != nil {
-		return 0, err
+		return 0, "", err
+	}
+	return readTail(seekerAt{r}, size, l)
+}
+
+// readTail checks the magic bytes at the start and end of a
+// parquet file of the given size and returns the size of the
+// footer and the magic bytes.
+func readTail(r io.ReaderAt, size int64, l Limits) (int64, string, error) {
+	if size < 12 {
+		return 0, "", fmt.Errorf("%w: size %d is too small", ErrNotParquet, size)
 	}
 
-	var size uint32
-	return int(size), binary.Read(r, binary.LittleEndian, &size)
+	var head [4]byte
+	if _, err := r.ReadAt(head[:], 0); err != nil {
+		return 0, "", err
+	}
+
+	var tail [8]byte
+	if _, err := r.ReadAt(tail[:], size-8); err != nil {
+		return 0, "", err
+	}
+
+	mgc := string(tail[4:])
+	if (mgc != magic && mgc != magicEncrypted) || string(head[:]) != mgc {
+		return 0, "", fmt.Errorf("%w: invalid magic bytes %q and %q", ErrNotParquet, head[:], tail[4:])
+	}
+
+	n := int64(binary.LittleEndian.Uint32(tail[:4]))
+	if n > size-12 {
+		return 0, "", corruptFooter("footer size %d is larger than the file", n)
+	}
+	return n, mgc, l.checkFooter(n)
+}
+
+// seekerAt turns an io.ReadSeeker into an io.ReaderAt
+// by moving its position.
+type seekerAt struct {
+	r io.ReadSeeker
+}
+
+func (s seekerAt) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := s.r.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(s.r, p)
 }
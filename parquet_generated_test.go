@@ -4,16 +4,17 @@ package parquet_test
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/parsyl/parquet"
 	sch "github.com/parsyl/parquet/schema"
 
 	"math"
-	"sort"
+	"time"
 )
 
 type compression int
@@ -21,6 +22,7 @@ type compression int
 const (
 	compressionUncompressed compression = 0
 	compressionSnappy       compression = 1
+	compressionZstd         compression = 2
 	compressionUnknown      compression = -1
 )
 
@@ -40,25 +42,41 @@ type ParquetWriter struct {
 	meta        *parquet.Metadata
 	w           io.Writer
 	compression compression
+
+	// workers is the number of goroutines that encode and
+	// compress column chunks during Write.
+	workers int
+
+	encryption *parquet.Encryption
+	checksums  bool
+	statsSize  int
+
+	// sorting is the order (see SortBy) of the rows of each row
+	// group.  The rows are kept in rows until Write sorts them.
+	sorting    []parquet.SortingColumn
+	sortFields []Field
+	rows       []Person
 }
 
 func Fields(compression compression) []Field {
 	return []Field{
 		NewInt32Field(readID, writeID, []string{"id"}, fieldCompression(compression)),
 		NewInt32OptionalField(readAge, writeAge, []string{"age"}, []int{1}, optionalFieldCompression(compression)),
-		NewInt64Field(readHappiness, writeHappiness, []string{"happiness"}, fieldCompression(compression)),
-		NewInt64OptionalField(readSadness, writeSadness, []string{"sadness"}, []int{1}, optionalFieldCompression(compression)),
-		NewStringOptionalField(readCode, writeCode, []string{"code"}, []int{1}, optionalFieldCompression(compression)),
+		NewInt64Field(readHappiness, writeHappiness, []string{"happiness"}, fieldCompression(compression), parquet.RequiredFieldIDs(1)),
+		NewInt64OptionalField(readSadness, writeSadness, []string{"sadness"}, []int{1}, optionalFieldCompression(compression), parquet.OptionalFieldIDs(2)),
+		NewStringOptionalField(readCode, writeCode, []string{"code"}, []int{1}, optionalFieldCompression(compression), parquet.OptionalFieldDictionary, parquet.OptionalFieldBloom),
 		NewFloat32Field(readFunkiness, writeFunkiness, []string{"funkiness"}, fieldCompression(compression)),
 		NewFloat64Field(readBoldness, writeBoldness, []string{"boldness"}, fieldCompression(compression)),
 		NewFloat32OptionalField(readLameness, writeLameness, []string{"lameness"}, []int{1}, optionalFieldCompression(compression)),
 		NewBoolOptionalField(readKeen, writeKeen, []string{"keen"}, []int{1}, optionalFieldCompression(compression)),
-		NewUint32Field(readBirthday, writeBirthday, []string{"birthday"}, fieldCompression(compression)),
-		NewUint64OptionalField(readAnniversary, writeAnniversary, []string{"anniversary"}, []int{1}, optionalFieldCompression(compression)),
-		NewStringField(readBFF, writeBFF, []string{"bff"}, fieldCompression(compression)),
+		NewUint32Field(readBirthday, writeBirthday, []string{"birthday"}, fieldCompression(compression), parquet.RequiredFieldDictionary),
+		NewUint64OptionalField(readAnniversary, writeAnniversary, []string{"anniversary"}, []int{1}, parquet.OptionalFieldUncompressed),
+		NewStringField(readBFF, writeBFF, []string{"bff"}, parquet.RequiredFieldZstd, parquet.RequiredFieldBloom),
 		NewBoolField(readHungry, writeHungry, []string{"hungry"}, fieldCompression(compression)),
-		NewStringOptionalField(readHobbyName, writeHobbyName, []string{"hobby", "name"}, []int{1, 0}, optionalFieldCompression(compression)),
-		NewInt32OptionalField(readHobbyDifficulty, writeHobbyDifficulty, []string{"hobby", "difficulty"}, []int{1, 1}, optionalFieldCompression(compression)),
+		NewTimeField(readBorn, writeBorn, []string{"born"}, parquet.Millis, true, fieldCompression(compression)),
+		NewTimeOptionalField(readLastSeen, writeLastSeen, []string{"last_seen"}, []int{1}, parquet.Micros, false, optionalFieldCompression(compression)),
+		NewStringOptionalField(readHobbyName, writeHobbyName, []string{"hobby", "name"}, []int{1, 0}, optionalFieldCompression(compression), parquet.OptionalFieldIDs(3, 4)),
+		NewInt32OptionalField(readHobbyDifficulty, writeHobbyDifficulty, []string{"hobby", "difficulty"}, []int{1, 1}, optionalFieldCompression(compression), parquet.OptionalFieldIDs(3, 5)),
 		NewInt32OptionalField(readFriendsID, writeFriendsID, []string{"friends", "id"}, []int{2, 0}, optionalFieldCompression(compression)),
 		NewInt32OptionalField(readFriendsAge, writeFriendsAge, []string{"friends", "age"}, []int{2, 1}, optionalFieldCompression(compression)),
 		NewBoolField(readSleepy, writeSleepy, []string{"Sleepy"}, fieldCompression(compression)),
@@ -241,6 +259,34 @@ func writeHungry(x *Person, vals []bool) {
 	x.Hungry = vals[0]
 }
 
+func readBorn(x Person) time.Time {
+	return x.Born
+}
+
+func writeBorn(x *Person, vals []time.Time) {
+	x.Born = vals[0]
+}
+
+func readLastSeen(x Person) ([]time.Time, []uint8, []uint8) {
+	switch {
+	case x.LastSeen == nil:
+		return nil, []uint8{0}, nil
+	default:
+		return []time.Time{*x.LastSeen}, []uint8{1}, nil
+	}
+}
+
+func writeLastSeen(x *Person, vals []time.Time, defs, reps []uint8) (int, int) {
+	def := defs[0]
+	switch def {
+	case 1:
+		x.LastSeen = ptime(vals[0])
+		return 1, 1
+	}
+
+	return 0, 1
+}
+
 func readHobbyName(x Person) ([]string, []uint8, []uint8) {
 	switch {
 	case x.Hobby == nil:
@@ -410,6 +456,8 @@ func fieldCompression(c compression) func(*parquet.RequiredField) {
 		return parquet.RequiredFieldUncompressed
 	case compressionSnappy:
 		return parquet.RequiredFieldSnappy
+	case compressionZstd:
+		return parquet.RequiredFieldZstd
 	default:
 		return parquet.RequiredFieldUncompressed
 	}
@@ -421,6 +469,8 @@ func optionalFieldCompression(c compression) func(*parquet.OptionalField) {
 		return parquet.OptionalFieldUncompressed
 	case compressionSnappy:
 		return parquet.OptionalFieldSnappy
+	case compressionZstd:
+		return parquet.OptionalFieldZstd
 	default:
 		return parquet.OptionalFieldUncompressed
 	}
@@ -430,11 +480,43 @@ func NewParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*Parquet
 	return newParquetWriter(w, append(opts, begin)...)
 }
 
+// NewParquetAppender creates a ParquetWriter that adds row groups to the
+// end of an existing parquet file that has the same schema.  The new row
+// groups are written where the file's footer was, and Close writes a footer
+// that has both the existing and the new row groups.  rw must have a
+// Truncate method (*os.File does), which removes the old footer.
+func NewParquetAppender(rw io.ReadWriteSeeker, opts ...func(*ParquetWriter) error) (*ParquetWriter, error) {
+	t, ok := rw.(interface{ Truncate(int64) error })
+	if !ok {
+		return nil, fmt.Errorf("NewParquetAppender requires an io.ReadWriteSeeker that also has a Truncate method")
+	}
+
+	p, err := newParquetWriter(rw, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := p.meta.Append(rw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.Truncate(offset); err != nil {
+		return nil, err
+	}
+
+	if _, err := rw.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func newParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*ParquetWriter, error) {
 	p := &ParquetWriter{
 		max:         1000,
 		w:           w,
 		compression: compressionSnappy,
+		workers:     1,
 	}
 
 	for _, opt := range opts {
@@ -451,6 +533,28 @@ func newParquetWriter(w io.Writer, opts ...func(*ParquetWriter) error) (*Parquet
 			schema[i] = f.Schema()
 		}
 		p.meta = parquet.New(schema...)
+		if p.encryption != nil {
+			if err := p.meta.Encrypt(p.encryption); err != nil {
+				return nil, err
+			}
+		}
+		if p.checksums {
+			p.meta.WriteChecksums()
+		}
+		if p.statsSize > 0 {
+			p.meta.TruncateStats(p.statsSize)
+		}
+	}
+
+	if len(p.sorting) > 0 {
+		if err := p.meta.SortingColumns(p.sorting); err != nil {
+			return nil, err
+		}
+
+		fields := getFields(p.fields)
+		for _, col := range p.sorting {
+			p.sortFields = append(p.sortFields, fields[col.Column])
+		}
 	}
 
 	return p, nil
@@ -464,8 +568,56 @@ func MaxPageSize(m int) func(*ParquetWriter) error {
 	}
 }
 
+// Concurrency is the number of goroutines used to encode and compress
+// the columns of a row group.  Each column chunk is buffered in memory
+// and then written to the io.Writer in schema order.
+func Concurrency(n int) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		if n < 1 {
+			return fmt.Errorf("concurrency must be at least 1, got %d", n)
+		}
+		p.workers = n
+		return nil
+	}
+}
+
+// Encrypt writes the file with parquet modular encryption.
+func Encrypt(e *parquet.Encryption) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.encryption = e
+		return nil
+	}
+}
+
+// WriteChecksums stores the CRC32 of each page in its page header.
+func WriteChecksums(p *ParquetWriter) error {
+	p.checksums = true
+	return nil
+}
+
+// TruncateStats limits the min and max statistics of string columns
+// to n bytes.  Longer values are shortened to bounds that are marked
+// as not exact (see parquet.Metadata.TruncateStats).
+func TruncateStats(n int) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.statsSize = n
+		return nil
+	}
+}
+
+// SortBy sorts the rows of each row group by cols (the first column,
+// then the second, and so on) when Write is called and records the
+// order in the row group's SortingColumns.  The rows that are added
+// are held in memory until Write.
+func SortBy(cols ...parquet.SortingColumn) func(*ParquetWriter) error {
+	return func(p *ParquetWriter) error {
+		p.sorting = cols
+		return nil
+	}
+}
+
 func begin(p *ParquetWriter) error {
-	_, err := p.w.Write([]byte("PAR1"))
+	_, err := p.w.Write(parquet.Magic(p.encryption))
 	return err
 }
 
@@ -486,6 +638,11 @@ func Snappy(p *ParquetWriter) error {
 	return nil
 }
 
+func Zstd(p *ParquetWriter) error {
+	p.compression = compressionZstd
+	return nil
+}
+
 func withCompression(c compression) func(*ParquetWriter) error {
 	return func(p *ParquetWriter) error {
 		p.compression = c
@@ -494,13 +651,17 @@ func withCompression(c compression) func(*ParquetWriter) error {
 }
 
 func (p *ParquetWriter) Write() error {
-	for i, f := range p.fields {
-		if err := f.Write(p.w, p.meta); err != nil {
+	if len(p.sorting) > 0 {
+		p.sortRows()
+	}
+
+	if p.workers > 1 {
+		if err := p.writeConcurrent(); err != nil {
 			return err
 		}
-
-		for child := p.child; child != nil; child = child.child {
-			if err := child.fields[i].Write(p.w, p.meta); err != nil {
+	} else {
+		for i := range p.fields {
+			if err := p.writeColumn(p.w, i); err != nil {
 				return err
 			}
 		}
@@ -518,23 +679,130 @@ func (p *ParquetWriter) Write() error {
 	return nil
 }
 
+// writeColumn writes the i'th column of this writer and all of its
+// children (the pages of the row group) to w.  The pages of dictionary
+// encoded columns are written by WriteDictionary after the dictionary.
+func (p *ParquetWriter) writeColumn(w io.Writer, i int) error {
+	if err := p.fields[i].Write(w, p.meta); err != nil {
+		return err
+	}
+
+	for child := p.child; child != nil; child = child.child {
+		if err := child.fields[i].Write(w, p.meta); err != nil {
+			return err
+		}
+	}
+	return p.meta.WriteDictionary(w, p.fields[i].Schema().Path)
+}
+
+// writeConcurrent encodes each column into its own buffer using
+// at most p.workers goroutines, then writes the buffers in order.
+func (p *ParquetWriter) writeConcurrent() error {
+	bufs := make([]bytes.Buffer, len(p.fields))
+	errs := make([]error, len(p.fields))
+	sem := make(chan struct{}, p.workers)
+	var wg sync.WaitGroup
+	for i := range p.fields {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = p.writeColumn(&bufs[i], i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range bufs {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if _, err := p.w.Write(bufs[i].Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p *ParquetWriter) Close() error {
 	if err := p.meta.Footer(p.w); err != nil {
 		return err
 	}
 
-	_, err := p.w.Write([]byte("PAR1"))
+	_, err := p.w.Write(parquet.Magic(p.encryption))
 	return err
 }
 
+// Recover copies the complete row groups of a file that was written
+// by a ParquetWriter that was never closed to w and adds a new footer.
+// It returns the number of rows that were recovered (see parquet.Recover).
+func Recover(r io.ReaderAt, size int64, w io.Writer) (int64, error) {
+	ff := Fields(compressionUnknown)
+	schema := make([]parquet.Field, len(ff))
+	for i, f := range ff {
+		schema[i] = f.Schema()
+	}
+	return parquet.Recover(r, size, w, schema...)
+}
+
+// sortRows sorts the rows that were added since the
+// last Write (see SortBy) and adds them to the columns.
+func (p *ParquetWriter) sortRows() {
+	sort.SliceStable(p.rows, func(i, j int) bool {
+		return p.less(p.rows[i], p.rows[j])
+	})
+
+	for _, rec := range p.rows {
+		p.add(rec)
+	}
+	p.rows = p.rows[:0]
+}
+
+// less reports whether a comes before b in the SortBy order.
+func (p *ParquetWriter) less(a, b Person) bool {
+	for i, f := range p.sortFields {
+		col := p.sorting[i]
+		aNull, bNull := f.isNull(a), f.isNull(b)
+		switch {
+		case aNull && bNull:
+			continue
+		case aNull:
+			return col.NullsFirst
+		case bNull:
+			return !col.NullsFirst
+		}
+
+		c := f.compare(a, b)
+		if c == 0 {
+			continue
+		}
+		if col.Descending {
+			return c > 0
+		}
+		return c < 0
+	}
+	return false
+}
+
 func (p *ParquetWriter) Add(rec Person) {
+	if len(p.sorting) > 0 {
+		p.rows = append(p.rows, rec)
+		return
+	}
+
+	p.add(rec)
+}
+
+func (p *ParquetWriter) add(rec Person) {
 	if p.len == p.max {
 		if p.child == nil {
 			// an error can't happen here
 			p.child, _ = newParquetWriter(p.w, MaxPageSize(p.max), withMeta(p.meta), withCompression(p.compression))
 		}
 
-		p.child.Add(rec)
+		p.child.add(rec)
 		return
 	}
 
@@ -554,6 +822,12 @@ type Field interface {
 	Read(r io.ReadSeeker, pg parquet.Page) error
 	Name() string
 	Levels() ([]uint8, []uint8)
+
+	// isNull and compare are used to sort rows (see SortBy).
+	// compare returns -1, 0 or 1 and is only called if neither
+	// row is null.
+	isNull(r Person) bool
+	compare(a, b Person) int
 }
 
 func getFields(ff []Field) map[string]Field {
@@ -574,6 +848,10 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 		opt(pr)
 	}
 
+	if pr.err != nil {
+		return nil, pr.err
+	}
+
 	schema := make([]parquet.Field, len(ff))
 	for i, f := range ff {
 		pr.fieldNames = append(pr.fieldNames, f.Name())
@@ -581,9 +859,30 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 	}
 
 	meta := parquet.New(schema...)
+	if pr.decryption != nil {
+		meta.Decrypt(pr.decryption)
+	}
+	if pr.verify {
+		meta.VerifyChecksums()
+	}
+	if pr.limits != nil {
+		meta.SetLimits(*pr.limits)
+	}
+	if pr.zeroCopy {
+		meta.ZeroCopyStrings()
+	}
+	if pr.fieldIDs {
+		meta.MatchFieldIDs()
+	}
+
 	if err := meta.ReadFooter(r); err != nil {
 		return nil, err
 	}
+
+	if err := meta.ValidateSchema(pr.strict); err != nil {
+		return nil, err
+	}
+
 	pr.rows = meta.Rows()
 	var err error
 	pr.pages, err = meta.Pages()
@@ -598,15 +897,97 @@ func NewParquetReader(r io.ReadSeeker, opts ...func(*ParquetReader)) (*ParquetRe
 	}
 	pr.meta = meta
 
+	if pr.workers > 1 {
+		ra, ok := r.(io.ReaderAt)
+		if !ok {
+			return nil, fmt.Errorf("ReadConcurrency requires an io.ReadSeeker that also implements io.ReaderAt")
+		}
+		pr.ra = ra
+		pr.sem = make(chan struct{}, pr.workers)
+		pr.done = make(chan struct{})
+	}
+
 	return pr, pr.readRowGroup()
 }
 
+// NewParquetReaderAt creates a ParquetReader from an io.ReaderAt that
+// holds size bytes.  The reader keeps its own position, so several
+// readers (in several goroutines) can share one *os.File, memory-mapped
+// region, or any other io.ReaderAt.
+func NewParquetReaderAt(r io.ReaderAt, size int64, opts ...func(*ParquetReader)) (*ParquetReader, error) {
+	return NewParquetReader(io.NewSectionReader(r, 0, size), opts...)
+}
+
 func readerIndex(i int) func(*ParquetReader) {
 	return func(p *ParquetReader) {
 		p.index = i
 	}
 }
 
+// ReadConcurrency decodes up to n row groups ahead of the one being
+// scanned, reading their columns with at most n goroutines.  Rows are
+// still returned by Next and Scan in file order.  The io.ReadSeeker
+// passed to NewParquetReader must also be an io.ReaderAt (*os.File and
+// *bytes.Reader both are).  n must be at least 1.
+func ReadConcurrency(n int) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		if n < 1 {
+			p.err = fmt.Errorf("read concurrency must be at least 1, got %d", n)
+			return
+		}
+		p.workers = n
+	}
+}
+
+// Limits replaces parquet.DefaultLimits, which bound the memory that
+// is allocated while reading each page and the footer.
+func Limits(l parquet.Limits) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		p.limits = &l
+	}
+}
+
+// VerifyChecksums checks the CRC32 of each page that has one.  A
+// mismatch is returned as a *parquet.ChecksumError.
+func VerifyChecksums(p *ParquetReader) {
+	p.verify = true
+}
+
+// ZeroCopyStrings makes the strings that are scanned point into the
+// decompressed data of their column chunk instead of being copied.  The
+// reader never reuses that data, but a string that is kept after it is
+// scanned keeps the whole column chunk in memory, so copy any that are
+// kept for long.
+func ZeroCopyStrings(p *ParquetReader) {
+	p.zeroCopy = true
+}
+
+// StrictSchema makes the reader return an error that wraps
+// parquet.ErrSchemaMismatch when a row group has a column that
+// Person doesn't or is missing one of Person's columns.  Without
+// it, columns that Person doesn't have are skipped and columns that
+// the file doesn't have are read as zero values (optional fields are
+// left nil), so that files written with an older or newer Person
+// can still be read.
+func StrictSchema(p *ParquetReader) {
+	p.strict = true
+}
+
+// MatchFieldIDs matches the columns of the file to the fields of
+// Person by their field ids (the id option of the parquet tag)
+// instead of by name, so columns that were renamed can still be read.
+// Fields without an id are matched by name.
+func MatchFieldIDs(p *ParquetReader) {
+	p.fieldIDs = true
+}
+
+// Decrypt reads a file that was written with parquet modular encryption.
+func Decrypt(d *parquet.Decryption) func(*ParquetReader) {
+	return func(p *ParquetReader) {
+		p.decryption = d
+	}
+}
+
 // ParquetReader reads one page from a row group.
 type ParquetReader struct {
 	fields         map[string]Field
@@ -622,6 +1003,30 @@ type ParquetReader struct {
 
 	r         io.ReadSeeker
 	rowGroups []parquet.RowGroup
+
+	// workers, ra, sem, done and pending are only
+	// used when reading with ReadConcurrency.  done
+	// is closed when a row group can't be read.
+	workers int
+	ra      io.ReaderAt
+	sem     chan struct{}
+	done    chan struct{}
+	pending []chan rowGroup
+
+	decryption *parquet.Decryption
+	verify     bool
+	limits     *parquet.Limits
+	zeroCopy   bool
+	strict     bool
+	fieldIDs   bool
+}
+
+// rowGroup is the result of decoding a row group
+// in the background.
+type rowGroup struct {
+	fields map[string]Field
+	rows   int64
+	err    error
 }
 
 type Levels struct {
@@ -652,36 +1057,168 @@ func (p *ParquetReader) Error() error {
 func (p *ParquetReader) readRowGroup() error {
 	p.rowGroupCursor = 0
 
+	if p.workers > 1 {
+		return p.readRowGroupConcurrent()
+	}
+
 	if len(p.rowGroups) == 0 {
 		p.rowGroupCount = 0
 		return nil
 	}
 
 	rg := p.rowGroups[0]
-	p.fields = getFields(Fields(compressionUnknown))
+	fields, cols, pgs, err := p.columns(rg)
+	if err != nil {
+		return err
+	}
+
+	p.fields = fields
 	p.rowGroupCount = rg.Rows
 	p.rowGroupCursor = 0
+	for i, f := range cols {
+		if _, err := p.r.Seek(pgs[i].Offset, io.SeekStart); err != nil {
+			return err
+		}
+
+		if err := f.Read(p.r, pgs[i]); err != nil {
+			return fmt.Errorf("unable to read field %s, err: %w", f.Name(), err)
+		}
+	}
+	p.rowGroups = p.rowGroups[1:]
+	return nil
+}
+
+// columns returns the fields for a row group along with the fields
+// that have a column in it and the pages of those columns.  The
+// fields that don't have a column are filled with zero values.
+func (p *ParquetReader) columns(rg parquet.RowGroup) (map[string]Field, []Field, []parquet.Page, error) {
+	fields := getFields(Fields(compressionUnknown))
+	found := make(map[string]bool, len(fields))
+	var cols []Field
+	var pgs []parquet.Page
 	for _, col := range rg.Columns() {
 		name := strings.Join(col.MetaData.PathInSchema, ".")
-		f, ok := p.fields[name]
-		if !ok {
-			return fmt.Errorf("unknown field: %s", name)
-		}
 		pages := p.pages[name]
 		if len(pages) <= p.index {
 			break
 		}
+		p.pages[name] = pages[1:]
 
-		pg := pages[0]
-		if err := f.Read(p.r, pg); err != nil {
-			return fmt.Errorf("unable to read field %s, err: %s", f.Name(), err)
+		f, ok := fields[p.meta.FieldName(name)]
+		if !ok {
+			if p.strict {
+				return nil, nil, nil, fmt.Errorf("%w: unknown field: %s", parquet.ErrSchemaMismatch, name)
+			}
+			continue
 		}
-		p.pages[name] = p.pages[name][1:]
+
+		found[f.Name()] = true
+		cols = append(cols, f)
+		pgs = append(pgs, pages[0])
 	}
-	p.rowGroups = p.rowGroups[1:]
+
+	var zero Person
+	for _, name := range p.fieldNames {
+		if found[name] {
+			continue
+		}
+
+		if p.strict {
+			return nil, nil, nil, fmt.Errorf("%w: missing field: %s", parquet.ErrSchemaMismatch, name)
+		}
+
+		f := fields[name]
+		for i := int64(0); i < rg.Rows; i++ {
+			f.Add(zero)
+		}
+	}
+	return fields, cols, pgs, nil
+}
+
+func (p *ParquetReader) readRowGroupConcurrent() error {
+	// the row groups after one that failed aren't read
+	if p.err != nil {
+		return p.err
+	}
+
+	for len(p.pending) < p.workers && len(p.rowGroups) > 0 {
+		p.pending = append(p.pending, p.decodeRowGroup())
+	}
+
+	if len(p.pending) == 0 {
+		p.rowGroupCount = 0
+		return nil
+	}
+
+	rg := <-p.pending[0]
+	p.pending = p.pending[1:]
+	if rg.err != nil {
+		// stop decoding the row groups after this one
+		close(p.done)
+		p.pending = nil
+		return rg.err
+	}
+
+	p.fields = rg.fields
+	p.rowGroupCount = rg.rows
 	return nil
 }
 
+// decodeRowGroup starts reading the columns of the next row group in
+// the background and returns a channel that receives the result.  No
+// more columns are started once one of them fails or p.done is closed.
+func (p *ParquetReader) decodeRowGroup() chan rowGroup {
+	out := make(chan rowGroup, 1)
+	rg := p.rowGroups[0]
+	p.rowGroups = p.rowGroups[1:]
+
+	fields, cols, pgs, err := p.columns(rg)
+	if err != nil {
+		out <- rowGroup{err: err}
+		return out
+	}
+
+	go func() {
+		errs := make([]error, len(cols))
+		failed := make(chan struct{})
+		var once sync.Once
+		var wg sync.WaitGroup
+	columns:
+		for i := range cols {
+			select {
+			case p.sem <- struct{}{}:
+			case <-failed:
+				break columns
+			case <-p.done:
+				errs[i] = fmt.Errorf("stopped reading field %s after an earlier error", cols[i].Name())
+				break columns
+			}
+
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-p.sem
+					wg.Done()
+				}()
+				if err := cols[i].Read(pgs[i].Section(p.ra), pgs[i]); err != nil {
+					errs[i] = fmt.Errorf("unable to read field %s, err: %w", cols[i].Name(), err)
+					once.Do(func() { close(failed) })
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				out <- rowGroup{err: err}
+				return
+			}
+		}
+		out <- rowGroup{fields: fields, rows: rg.Rows}
+	}()
+	return out
+}
+
 func (p *ParquetReader) Rows() int64 {
 	return p.rows
 }
@@ -731,7 +1268,7 @@ func NewInt32Field(read func(r Person) int32, write func(r *Person, vals []int32
 }
 
 func (f *Int32Field) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *Int32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -740,20 +1277,12 @@ func (f *Int32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]int32, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeInt32s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *Int32Field) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeInt32s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *Int32Field) Scan(r *Person) {
@@ -775,6 +1304,21 @@ func (f *Int32Field) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *Int32Field) isNull(r Person) bool {
+	return false
+}
+
+func (f *Int32Field) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type Int32OptionalField struct {
 	parquet.OptionalField
 	vals  []int32
@@ -793,17 +1337,11 @@ func NewInt32OptionalField(read func(r Person) ([]int32, []uint8, []uint8), writ
 }
 
 func (f *Int32OptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int32Type, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int32Type, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *Int32OptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeInt32s(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *Int32OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -812,9 +1350,7 @@ func (f *Int32OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]int32, f.Values()-len(f.vals))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeInt32s(f.vals, rr, f.Values()-len(f.vals))
 	return err
 }
 
@@ -843,6 +1379,23 @@ func (f *Int32OptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
+func (f *Int32OptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *Int32OptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
+
 type Int64Field struct {
 	vals []int64
 	parquet.RequiredField
@@ -861,7 +1414,7 @@ func NewInt64Field(read func(r Person) int64, write func(r *Person, vals []int64
 }
 
 func (f *Int64Field) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int64Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int64Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *Int64Field) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -870,20 +1423,12 @@ func (f *Int64Field) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]int64, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeInt64s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *Int64Field) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeInt64s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *Int64Field) Scan(r *Person) {
@@ -905,6 +1450,21 @@ func (f *Int64Field) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *Int64Field) isNull(r Person) bool {
+	return false
+}
+
+func (f *Int64Field) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type Int64OptionalField struct {
 	parquet.OptionalField
 	vals  []int64
@@ -923,17 +1483,11 @@ func NewInt64OptionalField(read func(r Person) ([]int64, []uint8, []uint8), writ
 }
 
 func (f *Int64OptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int64Type, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Int64Type, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *Int64OptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeInt64s(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *Int64OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -942,9 +1496,7 @@ func (f *Int64OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]int64, f.Values()-len(f.vals))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeInt64s(f.vals, rr, f.Values()-len(f.vals))
 	return err
 }
 
@@ -973,9 +1525,26 @@ func (f *Int64OptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
-type StringOptionalField struct {
-	parquet.OptionalField
-	vals  []string
+func (f *Int64OptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *Int64OptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
+
+type StringOptionalField struct {
+	parquet.OptionalField
+	vals  []string
 	read  func(r Person) ([]string, []uint8, []uint8)
 	write func(r *Person, vals []string, def, rep []uint8) (int, int)
 	stats *stringOptionalStats
@@ -991,7 +1560,7 @@ func NewStringOptionalField(read func(r Person) ([]string, []uint8, []uint8), wr
 }
 
 func (f *StringOptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *StringOptionalField) Add(r Person) {
@@ -1016,16 +1585,7 @@ func (f *StringOptionalField) Scan(r *Person) {
 }
 
 func (f *StringOptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	buf := bytes.Buffer{}
-
-	for _, s := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, int32(len(s))); err != nil {
-			return err
-		}
-		buf.Write([]byte(s))
-	}
-
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeStrings(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *StringOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1034,25 +1594,31 @@ func (f *StringOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	for j := 0; j < f.Values(); j++ {
-		var x int32
-		if err := binary.Read(rr, binary.LittleEndian, &x); err != nil {
-			return err
-		}
-		s := make([]byte, x)
-		if _, err := rr.Read(s); err != nil {
-			return err
-		}
-
-		f.vals = append(f.vals, string(s))
-	}
-	return nil
+	f.vals, err = pg.DecodeStrings(f.vals, rr, f.Values()-len(f.vals))
+	return err
 }
 
 func (f *StringOptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
+func (f *StringOptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *StringOptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
+
 type Float32Field struct {
 	vals []float32
 	parquet.RequiredField
@@ -1071,7 +1637,7 @@ func NewFloat32Field(read func(r Person) float32, write func(r *Person, vals []f
 }
 
 func (f *Float32Field) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *Float32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1080,20 +1646,12 @@ func (f *Float32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]float32, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeFloat32s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *Float32Field) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeFloat32s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *Float32Field) Scan(r *Person) {
@@ -1115,6 +1673,31 @@ func (f *Float32Field) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *Float32Field) isNull(r Person) bool {
+	return false
+}
+
+func (f *Float32Field) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	// NaN sorts after every number, so that the NaNs of
+	// a sorted row group end up together
+	switch xNaN, yNaN := math.IsNaN(float64(x)), math.IsNaN(float64(y)); {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type Float64Field struct {
 	vals []float64
 	parquet.RequiredField
@@ -1133,7 +1716,7 @@ func NewFloat64Field(read func(r Person) float64, write func(r *Person, vals []f
 }
 
 func (f *Float64Field) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float64Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float64Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *Float64Field) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1142,20 +1725,12 @@ func (f *Float64Field) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]float64, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeFloat64s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *Float64Field) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeFloat64s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *Float64Field) Scan(r *Person) {
@@ -1177,6 +1752,31 @@ func (f *Float64Field) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *Float64Field) isNull(r Person) bool {
+	return false
+}
+
+func (f *Float64Field) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	// NaN sorts after every number, so that the NaNs of
+	// a sorted row group end up together
+	switch xNaN, yNaN := math.IsNaN(float64(x)), math.IsNaN(float64(y)); {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type Float32OptionalField struct {
 	parquet.OptionalField
 	vals  []float32
@@ -1195,17 +1795,11 @@ func NewFloat32OptionalField(read func(r Person) ([]float32, []uint8, []uint8),
 }
 
 func (f *Float32OptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float32Type, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Float32Type, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *Float32OptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeFloat32s(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *Float32OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1214,9 +1808,7 @@ func (f *Float32OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]float32, f.Values()-len(f.vals))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeFloat32s(f.vals, rr, f.Values()-len(f.vals))
 	return err
 }
 
@@ -1245,6 +1837,33 @@ func (f *Float32OptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
+func (f *Float32OptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *Float32OptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	// NaN sorts after every number, so that the NaNs of
+	// a sorted row group end up together
+	switch xNaN, yNaN := math.IsNaN(float64(x[0])), math.IsNaN(float64(y[0])); {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return 1
+	case yNaN:
+		return -1
+	}
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
+
 type BoolOptionalField struct {
 	parquet.OptionalField
 	vals  []bool
@@ -1263,7 +1882,7 @@ func NewBoolOptionalField(read func(r Person) ([]bool, []uint8, []uint8), write
 }
 
 func (f *BoolOptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *BoolOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1316,6 +1935,23 @@ func (f *BoolOptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
+func (f *BoolOptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *BoolOptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case !x[0] && y[0]:
+		return -1
+	case x[0] && !y[0]:
+		return 1
+	}
+	return 0
+}
+
 type Uint32Field struct {
 	vals []uint32
 	parquet.RequiredField
@@ -1334,7 +1970,7 @@ func NewUint32Field(read func(r Person) uint32, write func(r *Person, vals []uin
 }
 
 func (f *Uint32Field) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Uint32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Uint32Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *Uint32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1343,20 +1979,12 @@ func (f *Uint32Field) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]uint32, int(pg.N))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeUint32s(f.vals, rr, pg.N)
 	return err
 }
 
 func (f *Uint32Field) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeUint32s(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *Uint32Field) Scan(r *Person) {
@@ -1378,6 +2006,21 @@ func (f *Uint32Field) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *Uint32Field) isNull(r Person) bool {
+	return false
+}
+
+func (f *Uint32Field) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type Uint64OptionalField struct {
 	parquet.OptionalField
 	vals  []uint64
@@ -1396,17 +2039,11 @@ func NewUint64OptionalField(read func(r Person) ([]uint64, []uint8, []uint8), wr
 }
 
 func (f *Uint64OptionalField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Uint64Type, RepetitionType: f.RepetitionType, Types: f.Types}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: Uint64Type, RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
 }
 
 func (f *Uint64OptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
-	var buf bytes.Buffer
-	for _, v := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
-			return err
-		}
-	}
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.Defs), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeUint64s(nil, f.vals), len(f.Defs), f.stats)
 }
 
 func (f *Uint64OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1415,9 +2052,7 @@ func (f *Uint64OptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	v := make([]uint64, f.Values()-len(f.vals))
-	err = binary.Read(rr, binary.LittleEndian, &v)
-	f.vals = append(f.vals, v...)
+	f.vals, err = parquet.DecodeUint64s(f.vals, rr, f.Values()-len(f.vals))
 	return err
 }
 
@@ -1446,6 +2081,23 @@ func (f *Uint64OptionalField) Levels() ([]uint8, []uint8) {
 	return f.Defs, f.Reps
 }
 
+func (f *Uint64OptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *Uint64OptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	switch {
+	case x[0] < y[0]:
+		return -1
+	case x[0] > y[0]:
+		return 1
+	}
+	return 0
+}
+
 type StringField struct {
 	parquet.RequiredField
 	vals  []string
@@ -1464,20 +2116,11 @@ func NewStringField(read func(r Person) string, write func(r *Person, vals []str
 }
 
 func (f *StringField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: StringType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *StringField) Write(w io.Writer, meta *parquet.Metadata) error {
-	buf := bytes.Buffer{}
-
-	for _, s := range f.vals {
-		if err := binary.Write(&buf, binary.LittleEndian, int32(len(s))); err != nil {
-			return err
-		}
-		buf.Write([]byte(s))
-	}
-
-	return f.DoWrite(w, meta, buf.Bytes(), len(f.vals), f.stats)
+	return f.DoWrite(w, meta, parquet.EncodeStrings(nil, f.vals), len(f.vals), f.stats)
 }
 
 func (f *StringField) Read(r io.ReadSeeker, pg parquet.Page) error {
@@ -1486,19 +2129,8 @@ func (f *StringField) Read(r io.ReadSeeker, pg parquet.Page) error {
 		return err
 	}
 
-	for j := 0; j < pg.N; j++ {
-		var x int32
-		if err := binary.Read(rr, binary.LittleEndian, &x); err != nil {
-			return err
-		}
-		s := make([]byte, x)
-		if _, err := rr.Read(s); err != nil {
-			return err
-		}
-
-		f.vals = append(f.vals, string(s))
-	}
-	return nil
+	f.vals, err = pg.DecodeStrings(f.vals, rr, pg.N)
+	return err
 }
 
 func (f *StringField) Scan(r *Person) {
@@ -1520,6 +2152,21 @@ func (f *StringField) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *StringField) isNull(r Person) bool {
+	return false
+}
+
+func (f *StringField) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
 type BoolField struct {
 	parquet.RequiredField
 	vals  []bool
@@ -1537,7 +2184,7 @@ func NewBoolField(read func(r Person) bool, write func(r *Person, vals []bool),
 }
 
 func (f *BoolField) Schema() parquet.Field {
-	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}}
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: BoolType, RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
 }
 
 func (f *BoolField) Write(w io.Writer, meta *parquet.Metadata) error {
@@ -1582,18 +2229,191 @@ func (f *BoolField) Levels() ([]uint8, []uint8) {
 	return nil, nil
 }
 
+func (f *BoolField) isNull(r Person) bool {
+	return false
+}
+
+func (f *BoolField) compare(a, b Person) int {
+	x, y := f.read(a), f.read(b)
+	switch {
+	case !x && y:
+		return -1
+	case x && !y:
+		return 1
+	}
+	return 0
+}
+
+type TimeField struct {
+	vals []time.Time
+	parquet.RequiredField
+	read  func(r Person) time.Time
+	write func(r *Person, vals []time.Time)
+	stats *timeStats
+	unit  parquet.TimeUnit
+	utc   bool
+}
+
+func NewTimeField(read func(r Person) time.Time, write func(r *Person, vals []time.Time), path []string, unit parquet.TimeUnit, utc bool, opts ...func(*parquet.RequiredField)) *TimeField {
+	return &TimeField{
+		read:          read,
+		write:         write,
+		RequiredField: parquet.NewRequiredField(path, opts...),
+		stats:         newTimeStats(unit, utc),
+		unit:          unit,
+		utc:           utc,
+	}
+}
+
+func (f *TimeField) Schema() parquet.Field {
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: parquet.TimestampType(f.unit, f.utc), RepetitionType: parquet.RepetitionRequired, Types: []int{0}, IDs: f.IDs()}
+}
+
+func (f *TimeField) Read(r io.ReadSeeker, pg parquet.Page) error {
+	rr, _, err := f.DoRead(r, pg)
+	if err != nil {
+		return err
+	}
+
+	f.vals, err = parquet.DecodeTimes(f.vals, rr, pg.N, f.unit, f.utc)
+	return err
+}
+
+func (f *TimeField) Write(w io.Writer, meta *parquet.Metadata) error {
+	return f.DoWrite(w, meta, parquet.EncodeTimes(nil, f.vals, f.unit, f.utc), len(f.vals), f.stats)
+}
+
+func (f *TimeField) Scan(r *Person) {
+	if len(f.vals) == 0 {
+		return
+	}
+
+	f.write(r, f.vals)
+	f.vals = f.vals[1:]
+}
+
+func (f *TimeField) Add(r Person) {
+	v := f.read(r)
+	f.stats.add(v)
+	f.vals = append(f.vals, v)
+}
+
+func (f *TimeField) Levels() ([]uint8, []uint8) {
+	return nil, nil
+}
+
+func (f *TimeField) isNull(r Person) bool {
+	return false
+}
+
+func (f *TimeField) compare(a, b Person) int {
+	x, y := parquet.Timestamp(f.read(a), f.unit, f.utc), parquet.Timestamp(f.read(b), f.unit, f.utc)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+type TimeOptionalField struct {
+	parquet.OptionalField
+	vals  []time.Time
+	read  func(r Person) ([]time.Time, []uint8, []uint8)
+	write func(r *Person, vals []time.Time, def, rep []uint8) (int, int)
+	stats *timeOptionalStats
+	unit  parquet.TimeUnit
+	utc   bool
+}
+
+func NewTimeOptionalField(read func(r Person) ([]time.Time, []uint8, []uint8), write func(r *Person, vals []time.Time, defs, reps []uint8) (int, int), path []string, types []int, unit parquet.TimeUnit, utc bool, opts ...func(*parquet.OptionalField)) *TimeOptionalField {
+	return &TimeOptionalField{
+		read:          read,
+		write:         write,
+		OptionalField: parquet.NewOptionalField(path, types, opts...),
+		stats:         newTimeOptionalStats(maxDef(types), unit, utc),
+		unit:          unit,
+		utc:           utc,
+	}
+}
+
+func (f *TimeOptionalField) Schema() parquet.Field {
+	return parquet.Field{Name: f.Name(), Path: f.Path(), Type: parquet.TimestampType(f.unit, f.utc), RepetitionType: f.RepetitionType, Types: f.Types, IDs: f.IDs()}
+}
+
+func (f *TimeOptionalField) Write(w io.Writer, meta *parquet.Metadata) error {
+	return f.DoWrite(w, meta, parquet.EncodeTimes(nil, f.vals, f.unit, f.utc), len(f.Defs), f.stats)
+}
+
+func (f *TimeOptionalField) Read(r io.ReadSeeker, pg parquet.Page) error {
+	rr, _, err := f.DoRead(r, pg)
+	if err != nil {
+		return err
+	}
+
+	f.vals, err = parquet.DecodeTimes(f.vals, rr, f.Values()-len(f.vals), f.unit, f.utc)
+	return err
+}
+
+func (f *TimeOptionalField) Add(r Person) {
+	vals, defs, reps := f.read(r)
+	f.stats.add(vals, defs)
+	f.vals = append(f.vals, vals...)
+	f.Defs = append(f.Defs, defs...)
+	f.Reps = append(f.Reps, reps...)
+}
+
+func (f *TimeOptionalField) Scan(r *Person) {
+	if len(f.Defs) == 0 {
+		return
+	}
+
+	v, l := f.write(r, f.vals, f.Defs, f.Reps)
+	f.vals = f.vals[v:]
+	f.Defs = f.Defs[l:]
+	if len(f.Reps) > 0 {
+		f.Reps = f.Reps[l:]
+	}
+}
+
+func (f *TimeOptionalField) Levels() ([]uint8, []uint8) {
+	return f.Defs, f.Reps
+}
+
+func (f *TimeOptionalField) isNull(r Person) bool {
+	vals, _, _ := f.read(r)
+	return len(vals) == 0
+}
+
+func (f *TimeOptionalField) compare(a, b Person) int {
+	x, _, _ := f.read(a)
+	y, _, _ := f.read(b)
+	i, j := parquet.Timestamp(x[0], f.unit, f.utc), parquet.Timestamp(y[0], f.unit, f.utc)
+	switch {
+	case i < j:
+		return -1
+	case i > j:
+		return 1
+	}
+	return 0
+}
+
 type int32stats struct {
 	min int32
 	max int32
+	set bool
 }
 
 func newInt32stats() *int32stats {
-	return &int32stats{
-		min: int32(math.MaxInt32),
-	}
+	return &int32stats{}
 }
 
 func (i *int32stats) add(val int32) {
+	if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -1603,9 +2423,7 @@ func (i *int32stats) add(val int32) {
 }
 
 func (f *int32stats) bytes(val int32) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeInt32s(nil, []int32{val})
 }
 
 func (f *int32stats) NullCount() *int64 {
@@ -1617,10 +2435,16 @@ func (f *int32stats) DistinctCount() *int64 {
 }
 
 func (f *int32stats) Min() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.min)
 }
 
 func (f *int32stats) Max() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.max)
 }
 
@@ -1634,7 +2458,6 @@ type int32optionalStats struct {
 
 func newint32optionalStats(d uint8) *int32optionalStats {
 	return &int32optionalStats{
-		min:    int32(math.MaxInt32),
 		maxDef: d,
 	}
 }
@@ -1644,25 +2467,28 @@ func (f *int32optionalStats) add(vals []int32, defs []uint8) {
 	for _, def := range defs {
 		if def < f.maxDef {
 			f.nils++
-		} else {
-			val := vals[i]
-			i++
+			continue
+		}
 
-			f.nonNils++
-			if val < f.min {
-				f.min = val
-			}
-			if val > f.max {
-				f.max = val
-			}
+		val := vals[i]
+		i++
+
+		f.nonNils++
+		if f.nonNils == 1 {
+			f.min, f.max = val, val
+			continue
+		}
+		if val < f.min {
+			f.min = val
+		}
+		if val > f.max {
+			f.max = val
 		}
 	}
 }
 
 func (f *int32optionalStats) bytes(val int32) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeInt32s(nil, []int32{val})
 }
 
 func (f *int32optionalStats) NullCount() *int64 {
@@ -1690,15 +2516,18 @@ func (f *int32optionalStats) Max() []byte {
 type int64stats struct {
 	min int64
 	max int64
+	set bool
 }
 
 func newInt64stats() *int64stats {
-	return &int64stats{
-		min: int64(math.MaxInt64),
-	}
+	return &int64stats{}
 }
 
 func (i *int64stats) add(val int64) {
+	if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -1708,9 +2537,7 @@ func (i *int64stats) add(val int64) {
 }
 
 func (f *int64stats) bytes(val int64) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeInt64s(nil, []int64{val})
 }
 
 func (f *int64stats) NullCount() *int64 {
@@ -1722,10 +2549,16 @@ func (f *int64stats) DistinctCount() *int64 {
 }
 
 func (f *int64stats) Min() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.min)
 }
 
 func (f *int64stats) Max() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.max)
 }
 
@@ -1739,7 +2572,6 @@ type int64optionalStats struct {
 
 func newint64optionalStats(d uint8) *int64optionalStats {
 	return &int64optionalStats{
-		min:    int64(math.MaxInt64),
 		maxDef: d,
 	}
 }
@@ -1749,25 +2581,28 @@ func (f *int64optionalStats) add(vals []int64, defs []uint8) {
 	for _, def := range defs {
 		if def < f.maxDef {
 			f.nils++
-		} else {
-			val := vals[i]
-			i++
+			continue
+		}
 
-			f.nonNils++
-			if val < f.min {
-				f.min = val
-			}
-			if val > f.max {
-				f.max = val
-			}
+		val := vals[i]
+		i++
+
+		f.nonNils++
+		if f.nonNils == 1 {
+			f.min, f.max = val, val
+			continue
+		}
+		if val < f.min {
+			f.min = val
+		}
+		if val > f.max {
+			f.max = val
 		}
 	}
 }
 
 func (f *int64optionalStats) bytes(val int64) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeInt64s(nil, []int64{val})
 }
 
 func (f *int64optionalStats) NullCount() *int64 {
@@ -1793,9 +2628,9 @@ func (f *int64optionalStats) Max() []byte {
 }
 
 type stringOptionalStats struct {
-	vals   []string
-	min    []byte
-	max    []byte
+	min    string
+	max    string
+	set    bool
 	nils   int64
 	maxDef uint8
 }
@@ -1809,9 +2644,20 @@ func (s *stringOptionalStats) add(vals []string, defs []uint8) {
 	for _, def := range defs {
 		if def < s.maxDef {
 			s.nils++
-		} else {
-			s.vals = append(s.vals, vals[i])
-			i++
+			continue
+		}
+
+		val := vals[i]
+		i++
+		if !s.set {
+			s.min, s.max, s.set = val, val, true
+			continue
+		}
+		if val < s.min {
+			s.min = val
+		}
+		if val > s.max {
+			s.max = val
 		}
 	}
 }
@@ -1825,43 +2671,39 @@ func (s *stringOptionalStats) DistinctCount() *int64 {
 }
 
 func (s *stringOptionalStats) Min() []byte {
-	if s.min == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.min
+	return []byte(s.min)
 }
 
 func (s *stringOptionalStats) Max() []byte {
-	if s.max == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.max
-}
-
-func (s *stringOptionalStats) minMax() {
-	if len(s.vals) == 0 {
-		return
-	}
-
-	tmp := make([]string, len(s.vals))
-	copy(tmp, s.vals)
-	sort.Strings(tmp)
-	s.min = []byte(tmp[0])
-	s.max = []byte(tmp[len(tmp)-1])
+	return []byte(s.max)
 }
 
 type float32stats struct {
 	min float32
 	max float32
+	set bool
 }
 
 func newFloat32stats() *float32stats {
-	return &float32stats{
-		min: float32(math.MaxFloat32),
-	}
+	return &float32stats{}
 }
 
 func (i *float32stats) add(val float32) {
+	// NaN isn't included in the min and max
+	if math.IsNaN(float64(val)) {
+		return
+	}
+
+	if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -1871,9 +2713,7 @@ func (i *float32stats) add(val float32) {
 }
 
 func (f *float32stats) bytes(val float32) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeFloat32s(nil, []float32{val})
 }
 
 func (f *float32stats) NullCount() *int64 {
@@ -1885,25 +2725,47 @@ func (f *float32stats) DistinctCount() *int64 {
 }
 
 func (f *float32stats) Min() []byte {
+	if !f.set {
+		return nil
+	}
+	// a min of zero is written as -0
+	if f.min == 0 {
+		return f.bytes(float32(math.Copysign(0, -1)))
+	}
 	return f.bytes(f.min)
 }
 
 func (f *float32stats) Max() []byte {
+	if !f.set {
+		return nil
+	}
+	// a max of zero is written as +0
+	if f.max == 0 {
+		return f.bytes(0)
+	}
 	return f.bytes(f.max)
 }
 
 type float64stats struct {
 	min float64
 	max float64
+	set bool
 }
 
 func newFloat64stats() *float64stats {
-	return &float64stats{
-		min: float64(math.MaxFloat64),
-	}
+	return &float64stats{}
 }
 
 func (i *float64stats) add(val float64) {
+	// NaN isn't included in the min and max
+	if math.IsNaN(float64(val)) {
+		return
+	}
+
+	if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -1913,9 +2775,7 @@ func (i *float64stats) add(val float64) {
 }
 
 func (f *float64stats) bytes(val float64) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeFloat64s(nil, []float64{val})
 }
 
 func (f *float64stats) NullCount() *int64 {
@@ -1927,10 +2787,24 @@ func (f *float64stats) DistinctCount() *int64 {
 }
 
 func (f *float64stats) Min() []byte {
+	if !f.set {
+		return nil
+	}
+	// a min of zero is written as -0
+	if f.min == 0 {
+		return f.bytes(float64(math.Copysign(0, -1)))
+	}
 	return f.bytes(f.min)
 }
 
 func (f *float64stats) Max() []byte {
+	if !f.set {
+		return nil
+	}
+	// a max of zero is written as +0
+	if f.max == 0 {
+		return f.bytes(0)
+	}
 	return f.bytes(f.max)
 }
 
@@ -1944,7 +2818,6 @@ type float32optionalStats struct {
 
 func newfloat32optionalStats(d uint8) *float32optionalStats {
 	return &float32optionalStats{
-		min:    float32(math.MaxFloat32),
 		maxDef: d,
 	}
 }
@@ -1954,25 +2827,32 @@ func (f *float32optionalStats) add(vals []float32, defs []uint8) {
 	for _, def := range defs {
 		if def < f.maxDef {
 			f.nils++
-		} else {
-			val := vals[i]
-			i++
+			continue
+		}
 
-			f.nonNils++
-			if val < f.min {
-				f.min = val
-			}
-			if val > f.max {
-				f.max = val
-			}
+		val := vals[i]
+		i++
+		// NaN isn't included in the min and max
+		if math.IsNaN(float64(val)) {
+			continue
+		}
+
+		f.nonNils++
+		if f.nonNils == 1 {
+			f.min, f.max = val, val
+			continue
+		}
+		if val < f.min {
+			f.min = val
+		}
+		if val > f.max {
+			f.max = val
 		}
 	}
 }
 
 func (f *float32optionalStats) bytes(val float32) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeFloat32s(nil, []float32{val})
 }
 
 func (f *float32optionalStats) NullCount() *int64 {
@@ -1987,6 +2867,10 @@ func (f *float32optionalStats) Min() []byte {
 	if f.nonNils == 0 {
 		return nil
 	}
+	// a min of zero is written as -0
+	if f.min == 0 {
+		return f.bytes(float32(math.Copysign(0, -1)))
+	}
 	return f.bytes(f.min)
 }
 
@@ -1994,6 +2878,10 @@ func (f *float32optionalStats) Max() []byte {
 	if f.nonNils == 0 {
 		return nil
 	}
+	// a max of zero is written as +0
+	if f.max == 0 {
+		return f.bytes(0)
+	}
 	return f.bytes(f.max)
 }
 
@@ -2033,15 +2921,18 @@ func (b *boolOptionalStats) Max() []byte {
 type uint32stats struct {
 	min uint32
 	max uint32
+	set bool
 }
 
 func newUint32stats() *uint32stats {
-	return &uint32stats{
-		min: uint32(math.MaxUint32),
-	}
+	return &uint32stats{}
 }
 
 func (i *uint32stats) add(val uint32) {
+	if !i.set {
+		i.min, i.max, i.set = val, val, true
+		return
+	}
 	if val < i.min {
 		i.min = val
 	}
@@ -2051,9 +2942,7 @@ func (i *uint32stats) add(val uint32) {
 }
 
 func (f *uint32stats) bytes(val uint32) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeUint32s(nil, []uint32{val})
 }
 
 func (f *uint32stats) NullCount() *int64 {
@@ -2065,10 +2954,16 @@ func (f *uint32stats) DistinctCount() *int64 {
 }
 
 func (f *uint32stats) Min() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.min)
 }
 
 func (f *uint32stats) Max() []byte {
+	if !f.set {
+		return nil
+	}
 	return f.bytes(f.max)
 }
 
@@ -2082,7 +2977,6 @@ type uint64optionalStats struct {
 
 func newuint64optionalStats(d uint8) *uint64optionalStats {
 	return &uint64optionalStats{
-		min:    uint64(math.MaxUint64),
 		maxDef: d,
 	}
 }
@@ -2092,25 +2986,28 @@ func (f *uint64optionalStats) add(vals []uint64, defs []uint8) {
 	for _, def := range defs {
 		if def < f.maxDef {
 			f.nils++
-		} else {
-			val := vals[i]
-			i++
+			continue
+		}
 
-			f.nonNils++
-			if val < f.min {
-				f.min = val
-			}
-			if val > f.max {
-				f.max = val
-			}
+		val := vals[i]
+		i++
+
+		f.nonNils++
+		if f.nonNils == 1 {
+			f.min, f.max = val, val
+			continue
+		}
+		if val < f.min {
+			f.min = val
+		}
+		if val > f.max {
+			f.max = val
 		}
 	}
 }
 
 func (f *uint64optionalStats) bytes(val uint64) []byte {
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, val)
-	return buf.Bytes()
+	return parquet.EncodeUint64s(nil, []uint64{val})
 }
 
 func (f *uint64optionalStats) NullCount() *int64 {
@@ -2136,9 +3033,9 @@ func (f *uint64optionalStats) Max() []byte {
 }
 
 type stringStats struct {
-	vals []string
-	min  []byte
-	max  []byte
+	min string
+	max string
+	set bool
 }
 
 func newStringStats() *stringStats {
@@ -2146,7 +3043,16 @@ func newStringStats() *stringStats {
 }
 
 func (s *stringStats) add(val string) {
-	s.vals = append(s.vals, val)
+	if !s.set {
+		s.min, s.max, s.set = val, val, true
+		return
+	}
+	if val < s.min {
+		s.min = val
+	}
+	if val > s.max {
+		s.max = val
+	}
 }
 
 func (s *stringStats) NullCount() *int64 {
@@ -2158,29 +3064,17 @@ func (s *stringStats) DistinctCount() *int64 {
 }
 
 func (s *stringStats) Min() []byte {
-	if s.min == nil {
-		s.minMax()
+	if !s.set {
+		return nil
 	}
-	return s.min
+	return []byte(s.min)
 }
 
 func (s *stringStats) Max() []byte {
-	if s.max == nil {
-		s.minMax()
-	}
-	return s.max
-}
-
-func (s *stringStats) minMax() {
-	if len(s.vals) == 0 {
-		return
+	if !s.set {
+		return nil
 	}
-
-	tmp := make([]string, len(s.vals))
-	copy(tmp, s.vals)
-	sort.Strings(tmp)
-	s.min = []byte(tmp[0])
-	s.max = []byte(tmp[len(tmp)-1])
+	return []byte(s.max)
 }
 
 type boolStats struct{}
@@ -2191,14 +3085,125 @@ func (b *boolStats) DistinctCount() *int64 { return nil }
 func (b *boolStats) Min() []byte           { return nil }
 func (b *boolStats) Max() []byte           { return nil }
 
-func pint32(i int32) *int32       { return &i }
-func puint32(i uint32) *uint32    { return &i }
-func pint64(i int64) *int64       { return &i }
-func puint64(i uint64) *uint64    { return &i }
-func pbool(b bool) *bool          { return &b }
-func pstring(s string) *string    { return &s }
-func pfloat32(f float32) *float32 { return &f }
-func pfloat64(f float64) *float64 { return &f }
+type timeStats struct {
+	min  int64
+	max  int64
+	set  bool
+	unit parquet.TimeUnit
+	utc  bool
+}
+
+func newTimeStats(unit parquet.TimeUnit, utc bool) *timeStats {
+	return &timeStats{unit: unit, utc: utc}
+}
+
+func (t *timeStats) add(val time.Time) {
+	v := parquet.Timestamp(val, t.unit, t.utc)
+	if !t.set {
+		t.min, t.max, t.set = v, v, true
+		return
+	}
+	if v < t.min {
+		t.min = v
+	}
+	if v > t.max {
+		t.max = v
+	}
+}
+
+func (t *timeStats) NullCount() *int64 {
+	return nil
+}
+
+func (t *timeStats) DistinctCount() *int64 {
+	return nil
+}
+
+func (t *timeStats) Min() []byte {
+	// INT96 doesn't have a defined sort order
+	if !t.set || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.min})
+}
+
+func (t *timeStats) Max() []byte {
+	if !t.set || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.max})
+}
+
+type timeOptionalStats struct {
+	min     int64
+	max     int64
+	nils    int64
+	nonNils int64
+	maxDef  uint8
+	unit    parquet.TimeUnit
+	utc     bool
+}
+
+func newTimeOptionalStats(d uint8, unit parquet.TimeUnit, utc bool) *timeOptionalStats {
+	return &timeOptionalStats{maxDef: d, unit: unit, utc: utc}
+}
+
+func (t *timeOptionalStats) add(vals []time.Time, defs []uint8) {
+	var i int
+	for _, def := range defs {
+		if def < t.maxDef {
+			t.nils++
+			continue
+		}
+
+		v := parquet.Timestamp(vals[i], t.unit, t.utc)
+		i++
+		t.nonNils++
+		if t.nonNils == 1 {
+			t.min, t.max = v, v
+			continue
+		}
+		if v < t.min {
+			t.min = v
+		}
+		if v > t.max {
+			t.max = v
+		}
+	}
+}
+
+func (t *timeOptionalStats) NullCount() *int64 {
+	return &t.nils
+}
+
+func (t *timeOptionalStats) DistinctCount() *int64 {
+	return nil
+}
+
+func (t *timeOptionalStats) Min() []byte {
+	// INT96 doesn't have a defined sort order
+	if t.nonNils == 0 || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.min})
+}
+
+func (t *timeOptionalStats) Max() []byte {
+	if t.nonNils == 0 || t.unit == parquet.Int96 {
+		return nil
+	}
+	return parquet.EncodeInt64s(nil, []int64{t.max})
+}
+
+func pint32(i int32) *int32        { return &i }
+func puint32(i uint32) *uint32     { return &i }
+func pint64(i int64) *int64        { return &i }
+func puint64(i uint64) *uint64     { return &i }
+func pbool(b bool) *bool           { return &b }
+func pstring(s string) *string     { return &s }
+func pfloat32(f float32) *float32  { return &f }
+func pfloat64(f float64) *float64  { return &f }
+func ptime(t time.Time) *time.Time { return &t }
 
 // keeps track of the indices of repeated fields
 // that have already been handled by a previous field
@@ -2,14 +2,23 @@ package parquet_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"math/rand"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/parsyl/parquet"
 	sch "github.com/parsyl/parquet/schema"
 	"github.com/stretchr/testify/assert"
@@ -435,8 +444,8 @@ func TestParquet(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		for j, comp := range []string{"uncompressed", "snappy"} {
-			t.Run(fmt.Sprintf("%02d %s %s", 2*i+j, tc.name, comp), func(t *testing.T) {
+		for j, comp := range []string{"uncompressed", "snappy", "zstd"} {
+			t.Run(fmt.Sprintf("%02d %s %s", 3*i+j, tc.name, comp), func(t *testing.T) {
 				if tc.pageSize == 0 {
 					tc.pageSize = 100
 				}
@@ -450,71 +459,2067 @@ func TestParquet(t *testing.T) {
 					assert.Nil(t, w.Write(), tc.name)
 				}
 
-				err = w.Close()
-				assert.Nil(t, err, tc.name)
+				err = w.Close()
+				assert.Nil(t, err, tc.name)
+
+				r, err := NewParquetReader(bytes.NewReader(buf.Bytes()))
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				expected := tc.expected
+				if expected == nil {
+					expected = tc.input
+				}
+
+				if !assert.Equal(t, getLen(expected), int(r.Rows()), tc.name) {
+					return
+				}
+
+				var i int
+				for r.Next() {
+					var p Person
+					r.Scan(&p)
+					exp := getExpected(expected, i)
+					assert.Equal(t, *exp, p, fmt.Sprintf("%s-%d", tc.name, i))
+					i++
+				}
+
+				assert.Nil(t, r.Error(), tc.name)
+				assert.Equal(t, getLen(expected), i, tc.name)
+			})
+		}
+	}
+}
+
+func TestPageHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(2))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	docs := [][]Person{
+		{{}, {}, {}, {}},
+		{{}, {}, {}, {}},
+	}
+	for _, rowgroup := range docs {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+
+	assert.NoError(t, w.Close())
+
+	rd := bytes.NewReader(buf.Bytes())
+	footer, err := parquet.ReadMetaData(rd)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pageHeaders, err := parquet.PageHeaders(footer, rd)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// code and birthday start each chunk with a dictionary page
+	assert.Equal(t, 84, len(pageHeaders))
+
+	footerAt, err := parquet.ReadMetaDataAt(rd, rd.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, footer, footerAt)
+
+	pageHeadersAt, err := parquet.PageHeadersAt(footerAt, rd)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, pageHeaders, pageHeadersAt)
+
+	// columns encrypted with a column key don't have plaintext metadata
+	footer.RowGroups[1].Columns[2].MetaData = nil
+	_, err = parquet.PageHeaders(footer, rd)
+	assert.EqualError(t, err, "column 2 of row group 1 is encrypted, a Decryption with its key is required")
+	_, err = parquet.PageHeadersAt(footer, rd)
+	assert.EqualError(t, err, "column 2 of row group 1 is encrypted, a Decryption with its key is required")
+}
+
+func TestReaderAt(t *testing.T) {
+	input := getPeople(50, 500)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20))
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	ra := bytes.NewReader(buf.Bytes())
+	var wg sync.WaitGroup
+	for j := 0; j < 4; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := NewParquetReaderAt(ra, ra.Size())
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var i int
+			for r.Next() {
+				var p Person
+				r.Scan(&p)
+				assert.Equal(t, *getExpected(input, i), p)
+				i++
+			}
+			assert.NoError(t, r.Error())
+			assert.Equal(t, getLen(input), i)
+		}()
+	}
+	wg.Wait()
+
+	_, err = parquet.ReadMetaDataAt(ra, 4)
+	assert.Error(t, err)
+}
+
+func TestColumnOptions(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, Snappy)
+	if !assert.NoError(t, err) {
+		return
+	}
+	w.Add(Person{Happiness: 1, Anniversary: puint64(2)})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// anniversary has compression=uncompressed and bff
+	// has compression=zstd in their tags
+	for _, ch := range footer.RowGroups[0].Columns {
+		codec := sch.CompressionCodec_SNAPPY
+		switch ch.MetaData.PathInSchema[0] {
+		case "anniversary":
+			codec = sch.CompressionCodec_UNCOMPRESSED
+		case "bff":
+			codec = sch.CompressionCodec_ZSTD
+		}
+		assert.Equal(t, codec, ch.MetaData.Codec, ch.MetaData.PathInSchema)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(10))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	codes := []string{"a", "b", "c"}
+	var input []Person
+	for i := 0; i < 100; i++ {
+		p := Person{Birthday: uint32(i % 4)}
+		if i%5 != 0 {
+			p.Code = pstring(codes[i%3])
+		}
+		input = append(input, p)
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// code and birthday have encoding=dict in their tags
+	for _, ch := range footer.RowGroups[0].Columns {
+		md := ch.MetaData
+		switch md.PathInSchema[0] {
+		case "code", "birthday":
+			if assert.NotNil(t, md.DictionaryPageOffset, md.PathInSchema) {
+				assert.True(t, *md.DictionaryPageOffset < md.DataPageOffset, md.PathInSchema)
+			}
+			assert.Equal(t, []sch.Encoding{sch.Encoding_PLAIN_DICTIONARY}, md.Encodings, md.PathInSchema)
+		default:
+			assert.Nil(t, md.DictionaryPageOffset, md.PathInSchema)
+		}
+	}
+
+	readPeople := func(t *testing.T, b []byte) []Person {
+		r, err := NewParquetReader(bytes.NewReader(b))
+		if !assert.NoError(t, err) {
+			return nil
+		}
+
+		var out []Person
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+			out = append(out, p)
+		}
+		assert.NoError(t, r.Error())
+		return out
+	}
+	assert.Equal(t, input, readPeople(t, buf.Bytes()))
+
+	// once the dictionary would be larger than 1MB the
+	// rest of the pages of the chunk are plain encoded
+	buf.Reset()
+	w, err = NewParquetWriter(&buf, MaxPageSize(2))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	long := strings.Repeat("x", 300<<10)
+	input = nil
+	for i := 0; i < 8; i++ {
+		p := Person{Code: pstring(fmt.Sprintf("%d%s", i, long))}
+		input = append(input, p)
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err = parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, ch := range footer.RowGroups[0].Columns {
+		if ch.MetaData.PathInSchema[0] == "code" {
+			assert.Equal(t, []sch.Encoding{sch.Encoding_PLAIN_DICTIONARY, sch.Encoding_PLAIN}, ch.MetaData.Encodings)
+		}
+	}
+	assert.Equal(t, input, readPeople(t, buf.Bytes()))
+
+	// an index that is past the end of the dictionary
+	buf.Reset()
+	w, err = NewParquetWriter(&buf, Uncompressed)
+	if !assert.NoError(t, err) {
+		return
+	}
+	w.Add(Person{Birthday: 1})
+	w.Add(Person{Birthday: 2})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err = parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	b := buf.Bytes()
+	for _, ch := range footer.RowGroups[0].Columns {
+		if ch.MetaData.PathInSchema[0] != "birthday" {
+			continue
+		}
+
+		rc := &readCounter{r: bytes.NewReader(b[ch.MetaData.DataPageOffset:])}
+		_, err := parquet.PageHeader(rc)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// a bit width of 1 and a bit-packed run of indices 0 and
+		// 1, make it a bit width of 2 and an RLE run of two 2s
+		data := b[ch.MetaData.DataPageOffset+rc.n:]
+		assert.Equal(t, []byte{1, 3, 2}, data[:3])
+		copy(data, []byte{2, 4, 2})
+	}
+
+	_, err = NewParquetReader(bytes.NewReader(b))
+	if assert.True(t, errors.Is(err, parquet.ErrCorruptPage), err) {
+		assert.Contains(t, err.Error(), "dictionary index 2 is out of range, the dictionary has 2 values")
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	var input []Person
+	for i := 0; i < 100; i++ {
+		p := Person{BFF: fmt.Sprintf("friend %d", i)}
+		if i%4 != 0 {
+			p.Code = pstring(fmt.Sprintf("code %d", i))
+		}
+		input = append(input, p)
+	}
+
+	// write puts the people in two row groups
+	write := func(t *testing.T, opts ...func(*ParquetWriter) error) []byte {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, opts...)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		for i, p := range input {
+			w.Add(p)
+			if i == 49 {
+				assert.NoError(t, w.Write())
+			}
+		}
+		assert.NoError(t, w.Write())
+		assert.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	var schema []parquet.Field
+	for _, f := range Fields(compressionUnknown) {
+		schema = append(schema, f.Schema())
+	}
+
+	// check reads the bloom filters of bff and code, which have
+	// bloom=true in their tags, and of happiness, which doesn't.
+	// The people of the i'th row group are input[50*(i%2):].
+	check := func(t *testing.T, b []byte, rowGroups int, d *parquet.Decryption) {
+		m := parquet.New(schema...)
+		if d != nil {
+			m.Decrypt(d)
+		}
+
+		r := bytes.NewReader(b)
+		if !assert.NoError(t, m.ReadFooter(r)) {
+			return
+		}
+
+		for i := 0; i < rowGroups; i++ {
+			for _, col := range []string{"bff", "code"} {
+				f, err := m.BloomFilter(r, i, []string{col})
+				if !assert.NoError(t, err) || !assert.NotNil(t, f) {
+					return
+				}
+
+				var falsePositives int
+				for j, p := range input {
+					v := p.BFF
+					if col == "code" {
+						if p.Code == nil {
+							continue
+						}
+						v = *p.Code
+					}
+
+					if j/50 == i%2 {
+						assert.True(t, f.Check([]byte(v)), v)
+					} else if f.Check([]byte(v)) {
+						falsePositives++
+					}
+				}
+				assert.True(t, falsePositives < 5, falsePositives)
+			}
+
+			f, err := m.BloomFilter(r, i, []string{"happiness"})
+			assert.NoError(t, err)
+			assert.Nil(t, f)
+		}
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		check(t, write(t), 2, nil)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		b := write(t)
+		footer, err := parquet.ReadMetaData(bytes.NewReader(b))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var n int
+		for _, rg := range footer.RowGroups {
+			for _, ch := range rg.Columns {
+				if ch.MetaData.BloomFilterOffset == nil {
+					continue
+				}
+				n++
+
+				r := bytes.NewReader(b[*ch.MetaData.BloomFilterOffset:])
+				p := thrift.NewTCompactProtocol(thrift.NewStreamTransportR(r))
+				bh := &sch.BloomFilterHeader{}
+				if !assert.NoError(t, bh.Read(p)) {
+					return
+				}
+
+				assert.NotNil(t, bh.Algorithm.BLOCK)
+				assert.NotNil(t, bh.Hash.XXHASH)
+				assert.NotNil(t, bh.Compression.UNCOMPRESSED)
+				assert.Equal(t, int32(0), bh.NumBytes%32)
+			}
+		}
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		footerKey := []byte("0123456789012345")
+		bffKey := []byte("abcdefghijklmnop")
+		b := write(t, Encrypt(&parquet.Encryption{
+			FooterKey: footerKey,
+			Columns:   map[string]parquet.ColumnKey{"bff": {Key: bffKey, KeyMetadata: []byte("bff")}},
+		}))
+		check(t, b, 2, &parquet.Decryption{Keys: parquet.InMemoryKeys{"": footerKey, "bff": bffKey}})
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		var buf bytes.Buffer
+		b := write(t)
+		assert.NoError(t, parquet.Concat(&buf, bytes.NewReader(b), bytes.NewReader(b)))
+		check(t, buf.Bytes(), 4, nil)
+	})
+}
+
+func TestNoStats(t *testing.T) {
+	fields := []Field{
+		NewInt64Field(readHappiness, writeHappiness, []string{"happiness"}, parquet.RequiredFieldNoStats),
+		NewInt64OptionalField(readSadness, writeSadness, []string{"sadness"}, []int{1}, parquet.OptionalFieldNoStats),
+		NewStringField(readBFF, writeBFF, []string{"bff"}),
+	}
+
+	schema := make([]parquet.Field, len(fields))
+	for i, f := range fields {
+		schema[i] = f.Schema()
+	}
+	meta := parquet.New(schema...)
+
+	for _, p := range []Person{{Happiness: 1, Sadness: pint64(2), BFF: "Fred"}, {Happiness: 3, BFF: "Val"}} {
+		meta.NextDoc()
+		for _, f := range fields {
+			f.Add(p)
+		}
+	}
+
+	buf := bytes.NewBuffer(parquet.Magic(nil))
+	for _, f := range fields {
+		assert.NoError(t, f.Write(buf, meta))
+	}
+	assert.NoError(t, meta.Footer(buf))
+	buf.Write(parquet.Magic(nil))
+
+	rd := bytes.NewReader(buf.Bytes())
+	footer, err := parquet.ReadMetaData(rd)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pageHeaders, err := parquet.PageHeaders(footer, rd)
+	if assert.NoError(t, err) && assert.Equal(t, 3, len(pageHeaders)) {
+		assert.Nil(t, pageHeaders[0].DataPageHeader.Statistics)
+		assert.Nil(t, pageHeaders[1].DataPageHeader.Statistics)
+		assert.Equal(t, []byte("Fred"), pageHeaders[2].DataPageHeader.Statistics.MinValue)
+		assert.Equal(t, []byte("Val"), pageHeaders[2].DataPageHeader.Statistics.MaxValue)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(3), SortBy(
+		parquet.SortingColumn{Column: "sadness", NullsFirst: true},
+		parquet.SortingColumn{Column: "happiness", Descending: true},
+	))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	rowGroups := [][]Person{
+		{
+			{Happiness: 1, Sadness: pint64(2)},
+			{Happiness: 5},
+			{Happiness: 2, Sadness: pint64(1)},
+			{Happiness: 3, Sadness: pint64(2)},
+			{Happiness: 4},
+			{Happiness: 0, Sadness: pint64(1)},
+			{Happiness: 4, Sadness: pint64(1)},
+		},
+		{
+			{Happiness: 7, Sadness: pint64(3)},
+			{Happiness: 8},
+		},
+	}
+	for _, rowgroup := range rowGroups {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	expected := []Person{
+		{Happiness: 5},
+		{Happiness: 4},
+		{Happiness: 4, Sadness: pint64(1)},
+		{Happiness: 2, Sadness: pint64(1)},
+		{Happiness: 0, Sadness: pint64(1)},
+		{Happiness: 3, Sadness: pint64(2)},
+		{Happiness: 1, Sadness: pint64(2)},
+		{Happiness: 8},
+		{Happiness: 7, Sadness: pint64(3)},
+	}
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var actual []Person
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		actual = append(actual, p)
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, expected, actual)
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if assert.NoError(t, err) && assert.Equal(t, 2, len(footer.RowGroups)) {
+		for _, rg := range footer.RowGroups {
+			assert.Equal(t, []*sch.SortingColumn{
+				{ColumnIdx: 3, NullsFirst: true},
+				{ColumnIdx: 2, Descending: true},
+			}, rg.SortingColumns)
+			assert.Equal(t, "sadness", rg.Columns[3].MetaData.PathInSchema[0])
+			assert.Equal(t, "happiness", rg.Columns[2].MetaData.PathInSchema[0])
+		}
+	}
+
+	// nested strings (nulls last) and bools
+	buf.Reset()
+	w, err = NewParquetWriter(&buf, SortBy(
+		parquet.SortingColumn{Column: "hobby.name"},
+		parquet.SortingColumn{Column: "hungry"},
+	))
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, p := range []Person{
+		{Hobby: &Hobby{Name: "golf"}, Hungry: true},
+		{},
+		{Hobby: &Hobby{Name: "chess"}},
+		{Hobby: &Hobby{Name: "golf"}},
+	} {
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	r, err = NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+	actual = nil
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		actual = append(actual, p)
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, []Person{
+		{Hobby: &Hobby{Name: "chess"}},
+		{Hobby: &Hobby{Name: "golf"}},
+		{Hobby: &Hobby{Name: "golf"}, Hungry: true},
+		{},
+	}, actual)
+
+	// NaNs sort after every number
+	for _, col := range []string{"boldness", "lameness"} {
+		buf.Reset()
+		w, err = NewParquetWriter(&buf, SortBy(parquet.SortingColumn{Column: col}))
+		if !assert.NoError(t, err) {
+			return
+		}
+		for i, v := range []float64{math.NaN(), 3, math.NaN(), 1, 2, math.NaN(), 0} {
+			f := float32(v)
+			w.Add(Person{Happiness: int64(i), Boldness: v, Lameness: &f})
+		}
+		assert.NoError(t, w.Write())
+		assert.NoError(t, w.Close())
+
+		r, err = NewParquetReader(bytes.NewReader(buf.Bytes()))
+		if !assert.NoError(t, err) {
+			return
+		}
+		var order []int64
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+			order = append(order, p.Happiness)
+		}
+		assert.NoError(t, r.Error())
+		assert.Equal(t, []int64{6, 3, 4, 1, 0, 2, 5}, order, col)
+	}
+
+	_, err = NewParquetWriter(&buf, SortBy(parquet.SortingColumn{Column: "friends.id"}))
+	assert.EqualError(t, err, "can't sort by friends.id, it is repeated")
+
+	_, err = NewParquetWriter(&buf, SortBy(parquet.SortingColumn{Column: "grumpiness"}))
+	assert.EqualError(t, err, "can't sort by grumpiness, there is no such column")
+}
+
+func TestTimestamps(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	input := []Person{
+		{Born: time.Date(1999, 12, 31, 23, 59, 59, 999e6, time.UTC), LastSeen: ptime(time.Date(2020, 2, 29, 12, 0, 0, 1000, time.Local))},
+		{Born: time.Date(1900, 6, 1, 0, 0, 0, 1e6, time.UTC)},
+		{},
+	}
+	for _, p := range input {
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	elems := map[string]*sch.SchemaElement{}
+	for _, se := range footer.Schema {
+		elems[se.Name] = se
+	}
+
+	born := elems["born"]
+	assert.Equal(t, sch.Type_INT64, *born.Type)
+	assert.Equal(t, sch.ConvertedType_TIMESTAMP_MILLIS, *born.ConvertedType)
+	assert.Equal(t, &sch.TimestampType{IsAdjustedToUTC: true, Unit: &sch.TimeUnit{MILLIS: &sch.MilliSeconds{}}}, born.LogicalType.TIMESTAMP)
+
+	// timestamps that aren't adjusted to UTC don't have a converted type
+	lastSeen := elems["last_seen"]
+	assert.Nil(t, lastSeen.ConvertedType)
+	assert.Equal(t, &sch.TimestampType{IsAdjustedToUTC: false, Unit: &sch.TimeUnit{MICROS: &sch.MicroSeconds{}}}, lastSeen.LogicalType.TIMESTAMP)
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var actual []Person
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		actual = append(actual, p)
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, input, actual)
+}
+
+func TestTimestampUnitMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf)
+	assert.NoError(t, err)
+	w.Add(Person{Born: time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC)})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	// last_seen is written in millis but read in micros, born
+	// isn't adjusted to UTC but is read as if it were
+	data := editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+		for _, se := range footer.Schema {
+			switch se.Name {
+			case "last_seen":
+				se.LogicalType.TIMESTAMP.Unit = &sch.TimeUnit{MILLIS: &sch.MilliSeconds{}}
+			case "born":
+				se.LogicalType.TIMESTAMP.IsAdjustedToUTC = false
+				se.ConvertedType = nil
+			}
+		}
+	})
+
+	_, err = NewParquetReader(bytes.NewReader(data))
+	var se *parquet.SchemaError
+	if assert.True(t, errors.As(err, &se), err) {
+		assert.Equal(t, []parquet.ColumnMismatch{
+			{Column: "born", File: "required int64 timestamp(millis, local)", Expected: "required int64 timestamp(millis, utc)"},
+			{Column: "last_seen", File: "optional int64 timestamp(millis, local)", Expected: "optional int64 timestamp(micros, local)"},
+		}, se.Columns)
+	}
+
+	// files that only have the converted type are in UTC
+	data = editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+		for _, se := range footer.Schema {
+			if se.Name == "born" {
+				se.LogicalType = nil
+			}
+		}
+	})
+	_, err = NewParquetReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+}
+
+func TestConcurrency(t *testing.T) {
+	input := getPeople(100, 1000)
+	write := func(opts ...func(*ParquetWriter) error) []byte {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, append(opts, MaxPageSize(30))...)
+		assert.NoError(t, err)
+		for _, rowgroup := range input {
+			for _, p := range rowgroup {
+				w.Add(p)
+			}
+			assert.NoError(t, w.Write())
+		}
+		assert.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	serial := write()
+	concurrent := write(Concurrency(4))
+	assert.Equal(t, serial, concurrent)
+
+	r, err := NewParquetReader(bytes.NewReader(concurrent))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, getLen(input), i)
+
+	_, err = NewParquetWriter(&bytes.Buffer{}, Concurrency(0))
+	assert.Error(t, err)
+}
+
+func TestReadConcurrency(t *testing.T) {
+	input := getPeople(50, 1000)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20))
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()), ReadConcurrency(4))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, getLen(input), i)
+
+	_, err = NewParquetReader(struct{ io.ReadSeeker }{bytes.NewReader(buf.Bytes())}, ReadConcurrency(4))
+	assert.Error(t, err)
+
+	for _, n := range []int{0, -1} {
+		_, err = NewParquetReader(bytes.NewReader(buf.Bytes()), ReadConcurrency(n))
+		assert.Error(t, err, n)
+	}
+
+	// a corrupt page in the second row group stops the reader there
+	buf.Reset()
+	w, err = NewParquetWriter(&buf, MaxPageSize(20), WriteChecksums)
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	b := buf.Bytes()
+	footer, err := parquet.ReadMetaData(bytes.NewReader(b))
+	if !assert.NoError(t, err) {
+		return
+	}
+	md := footer.RowGroups[1].Columns[0].MetaData
+	b[md.DataPageOffset+md.TotalCompressedSize-1] ^= 0xff
+
+	r, err = NewParquetReader(bytes.NewReader(b), ReadConcurrency(4), VerifyChecksums)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	i = 0
+	for r.Next() {
+		i++
+	}
+	assert.Equal(t, int(footer.RowGroups[0].NumRows), i)
+
+	var ce *parquet.ChecksumError
+	assert.True(t, errors.As(r.Error(), &ce), r.Error())
+}
+
+func TestEncryption(t *testing.T) {
+	footerKey := []byte("0123456789012345")
+	bffKey := []byte("abcdefghijklmnopabcdefgh")
+	hobbyKey := []byte("abcdefghijklmnopabcdefghijklmnop")
+	keys := parquet.InMemoryKeys{
+		"footer": footerKey,
+		"bff":    bffKey,
+		"hobby":  hobbyKey,
+	}
+
+	columns := map[string]parquet.ColumnKey{
+		"bff":        {Key: bffKey, KeyMetadata: []byte("bff")},
+		"code":       {Key: bffKey, KeyMetadata: []byte("bff")},
+		"hobby.name": {Key: hobbyKey, KeyMetadata: []byte("hobby")},
+	}
+
+	testCases := []struct {
+		name       string
+		encryption parquet.Encryption
+		decryption parquet.Decryption
+		magic      string
+	}{
+		{
+			name:       "footer key",
+			encryption: parquet.Encryption{FooterKey: footerKey, FooterKeyMetadata: []byte("footer")},
+			magic:      "PARE",
+		},
+		{
+			name:       "gcm ctr",
+			encryption: parquet.Encryption{Algorithm: parquet.AESGCMCTR, FooterKey: footerKey, FooterKeyMetadata: []byte("footer")},
+			magic:      "PARE",
+		},
+		{
+			name:       "column keys",
+			encryption: parquet.Encryption{FooterKey: footerKey, FooterKeyMetadata: []byte("footer"), Columns: columns},
+			magic:      "PARE",
+		},
+		{
+			name:       "plaintext footer",
+			encryption: parquet.Encryption{FooterKey: footerKey, FooterKeyMetadata: []byte("footer"), Columns: columns, PlaintextFooter: true},
+			magic:      "PAR1",
+		},
+		{
+			name:       "aad prefix",
+			encryption: parquet.Encryption{FooterKey: footerKey, FooterKeyMetadata: []byte("footer"), AADPrefix: []byte("people"), SupplyAADPrefix: true},
+			decryption: parquet.Decryption{AADPrefix: []byte("people")},
+			magic:      "PARE",
+		},
+	}
+
+	input := getPeople(50, 200)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewParquetWriter(&buf, MaxPageSize(20), Encrypt(&tc.encryption))
+			assert.NoError(t, err)
+			for _, rowgroup := range input {
+				for _, p := range rowgroup {
+					w.Add(p)
+				}
+				assert.NoError(t, w.Write())
+			}
+			assert.NoError(t, w.Close())
+
+			b := buf.Bytes()
+			assert.Equal(t, tc.magic, string(b[:4]))
+			assert.Equal(t, tc.magic, string(b[len(b)-4:]))
+
+			tc.decryption.Keys = keys
+			r, err := NewParquetReader(bytes.NewReader(b), Decrypt(&tc.decryption))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var i int
+			for r.Next() {
+				var p Person
+				r.Scan(&p)
+				assert.Equal(t, *getExpected(input, i), p)
+				i++
+			}
+			assert.NoError(t, r.Error())
+			assert.Equal(t, getLen(input), i)
+
+			_, err = NewParquetReader(bytes.NewReader(b), Decrypt(&parquet.Decryption{
+				Keys:      parquet.InMemoryKeys{"footer": []byte("5432109876543210"), "bff": bffKey, "hobby": hobbyKey},
+				AADPrefix: tc.decryption.AADPrefix,
+			}))
+			assert.Error(t, err)
+
+			_, err = NewParquetReader(bytes.NewReader(b))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEncryptionPlaintextFooter(t *testing.T) {
+	footerKey := []byte("0123456789012345")
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, Encrypt(&parquet.Encryption{
+		FooterKey:       footerKey,
+		PlaintextFooter: true,
+		Columns: map[string]parquet.ColumnKey{
+			"bff": {Key: footerKey},
+		},
+	}))
+	assert.NoError(t, err)
+	for _, p := range getPeople(10, 10)[0] {
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	// readers without the keys can still read the footer
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), footer.NumRows)
+	for _, ch := range footer.RowGroups[0].Columns {
+		assert.Equal(t, ch.MetaData.PathInSchema[0] == "bff", ch.CryptoMetadata != nil)
+	}
+
+	// tampering with the footer breaks its signature
+	b := append([]byte{}, buf.Bytes()...)
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	b[len(b)-8-n+2] ^= 1
+	_, err = parquet.ReadDecryptedMetaData(bytes.NewReader(b), &parquet.Decryption{Keys: parquet.InMemoryKeys{"": footerKey}})
+	assert.Error(t, err)
+
+	_, err = parquet.ReadDecryptedMetaData(bytes.NewReader(buf.Bytes()), &parquet.Decryption{Keys: parquet.InMemoryKeys{"": footerKey}})
+	assert.NoError(t, err)
+
+	_, err = NewParquetWriter(&buf, Encrypt(&parquet.Encryption{FooterKey: []byte("short")}))
+	assert.Error(t, err)
+
+	_, err = NewParquetWriter(&buf, Encrypt(&parquet.Encryption{
+		FooterKey: footerKey,
+		Columns:   map[string]parquet.ColumnKey{"nope": {Key: footerKey}},
+	}))
+	assert.Error(t, err)
+}
+
+func TestEncryptionColumnOrder(t *testing.T) {
+	footerKey := []byte("0123456789012345")
+	bffKey := []byte("abcdefghijklmnop")
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20), Encrypt(&parquet.Encryption{
+		FooterKey: footerKey,
+		Columns: map[string]parquet.ColumnKey{
+			"bff": {Key: bffKey, KeyMetadata: []byte("bff")},
+		},
+	}))
+	assert.NoError(t, err)
+	input := getPeople(50, 100)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	// the AAD of each module has the position of its column in the
+	// schema, not the position of its chunk in the row group
+	keys := parquet.InMemoryKeys{"": footerKey, "bff": bffKey}
+	b := editEncryptedFooter(t, buf.Bytes(), keys, func(footer *sch.FileMetaData) {
+		for _, rg := range footer.RowGroups {
+			for i, j := 0, len(rg.Columns)-1; i < j; i, j = i+1, j-1 {
+				rg.Columns[i], rg.Columns[j] = rg.Columns[j], rg.Columns[i]
+			}
+		}
+	})
+
+	r, err := NewParquetReader(bytes.NewReader(b), Decrypt(&parquet.Decryption{Keys: keys}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, 100, i)
+}
+
+func TestChecksums(t *testing.T) {
+	input := getPeople(50, 200)
+	for _, comp := range []func(*ParquetWriter) error{Snappy, Zstd, Uncompressed} {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, MaxPageSize(20), comp, WriteChecksums)
+		assert.NoError(t, err)
+		for _, rowgroup := range input {
+			for _, p := range rowgroup {
+				w.Add(p)
+			}
+			assert.NoError(t, w.Write())
+		}
+		assert.NoError(t, w.Close())
+
+		b := buf.Bytes()
+		footer, err := parquet.ReadMetaData(bytes.NewReader(b))
+		assert.NoError(t, err)
+		pageHeaders, err := parquet.PageHeaders(footer, bytes.NewReader(b))
+		assert.NoError(t, err)
+		for _, ph := range pageHeaders {
+			assert.NotNil(t, ph.Crc)
+		}
+
+		r, err := NewParquetReader(bytes.NewReader(b), VerifyChecksums)
+		assert.NoError(t, err)
+		var i int
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+			assert.Equal(t, *getExpected(input, i), p)
+			i++
+		}
+		assert.NoError(t, r.Error())
+		assert.Equal(t, getLen(input), i)
+
+		// flip a bit in the data of the first page of the last column
+		ch := footer.RowGroups[0].Columns[len(footer.RowGroups[0].Columns)-1]
+		rc := &readCounter{r: bytes.NewReader(b[ch.MetaData.DataPageOffset:])}
+		_, err = parquet.PageHeader(rc)
+		assert.NoError(t, err)
+		corrupt := append([]byte{}, b...)
+		corrupt[ch.MetaData.DataPageOffset+rc.n] ^= 1
+
+		r, err = NewParquetReader(bytes.NewReader(corrupt), VerifyChecksums)
+		if err == nil {
+			for r.Next() {
+			}
+			err = r.Error()
+		}
+
+		var ce *parquet.ChecksumError
+		if assert.True(t, errors.As(err, &ce), err) {
+			assert.Equal(t, strings.Join(ch.MetaData.PathInSchema, "."), ce.Column)
+			assert.Equal(t, 0, ce.RowGroup)
+			assert.Equal(t, ch.MetaData.DataPageOffset, ce.Offset)
+		}
+	}
+}
+
+func TestAppend(t *testing.T) {
+	f, err := ioutil.TempFile("", "parquet")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	input := getPeople(50, 200)
+	w, err := NewParquetWriter(f, MaxPageSize(20))
+	assert.NoError(t, err)
+	for _, rowgroup := range input[:2] {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	for _, rowgroup := range input[2:] {
+		w, err := NewParquetAppender(f, MaxPageSize(20), Uncompressed)
+		assert.NoError(t, err)
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+		assert.NoError(t, w.Close())
+	}
+
+	footer, err := parquet.ReadMetaData(f)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(footer.RowGroups))
+
+	r, err := NewParquetReader(f)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, 200, i)
+
+	// a file with a different schema can't be appended to
+	schema := []parquet.Field{
+		{Name: "id", Path: []string{"id"}, Types: []int{0}, Type: Int64Type, RepetitionType: parquet.RepetitionRequired},
+	}
+	assert.NoError(t, f.Truncate(0))
+	_, err = f.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	f.Write(parquet.Magic(nil))
+	assert.NoError(t, parquet.New(schema...).Footer(f))
+	f.Write(parquet.Magic(nil))
+
+	_, err = NewParquetAppender(f)
+	assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), err)
+
+	// the old footer can't be removed without Truncate
+	_, err = NewParquetAppender(struct{ io.ReadWriteSeeker }{f})
+	assert.Error(t, err)
+}
+
+func TestConcat(t *testing.T) {
+	input := getPeople(50, 200)
+	var inputs []io.ReadSeeker
+	for i, comp := range []func(*ParquetWriter) error{Snappy, Uncompressed} {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, MaxPageSize(20), comp, WriteChecksums)
+		assert.NoError(t, err)
+		for _, rowgroup := range input[2*i : 2*i+2] {
+			for _, p := range rowgroup {
+				w.Add(p)
+			}
+			assert.NoError(t, w.Write())
+		}
+		assert.NoError(t, w.Close())
+		inputs = append(inputs, bytes.NewReader(buf.Bytes()))
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, parquet.Concat(&out, inputs...))
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(out.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(footer.RowGroups))
+	assert.Equal(t, int64(200), footer.NumRows)
+
+	r, err := NewParquetReader(bytes.NewReader(out.Bytes()), VerifyChecksums)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, 200, i)
+
+	// files with different schemas can't be concatenated
+	schema := []parquet.Field{
+		{Name: "id", Path: []string{"id"}, Types: []int{0}, Type: Int64Type, RepetitionType: parquet.RepetitionRequired},
+	}
+	var other bytes.Buffer
+	other.Write(parquet.Magic(nil))
+	assert.NoError(t, parquet.New(schema...).Footer(&other))
+	other.Write(parquet.Magic(nil))
+
+	err = parquet.Concat(ioutil.Discard, inputs[0], bytes.NewReader(other.Bytes()))
+	assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), err)
+
+	// or columns with different logical types, converted types or field ids
+	edits := map[string]func(se *sch.SchemaElement){
+		"logical type": func(se *sch.SchemaElement) {
+			se.LogicalType.TIMESTAMP.Unit = &sch.TimeUnit{MICROS: &sch.MicroSeconds{}}
+		},
+		"converted type": func(se *sch.SchemaElement) { se.ConvertedType = nil },
+		"field id":       func(se *sch.SchemaElement) { se.FieldID = thrift.Int32Ptr(7) },
+	}
+	for name, edit := range edits {
+		_, err := inputs[0].Seek(0, io.SeekStart)
+		assert.NoError(t, err)
+		b, err := ioutil.ReadAll(inputs[0])
+		assert.NoError(t, err)
+		b = editFooter(t, b, func(footer *sch.FileMetaData) {
+			for _, se := range footer.Schema {
+				if se.Name == "born" {
+					edit(se)
+				}
+			}
+		})
+
+		err = parquet.Concat(ioutil.Discard, inputs[0], bytes.NewReader(b))
+		assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), name)
+	}
+
+	// encrypted files can't be copied, even if their footer is plaintext
+	var enc bytes.Buffer
+	key := []byte("0123456789012345")
+	w, err := NewParquetWriter(&enc, Encrypt(&parquet.Encryption{
+		FooterKey:       key,
+		PlaintextFooter: true,
+		Columns:         map[string]parquet.ColumnKey{"bff": {Key: key}},
+	}))
+	assert.NoError(t, err)
+	for _, p := range input[0] {
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	_, err = inputs[0].Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	err = parquet.Concat(ioutil.Discard, inputs[0], bytes.NewReader(enc.Bytes()))
+	assert.True(t, errors.Is(err, parquet.ErrEncrypted), err)
+
+	_, err = parquet.Compact(ioutil.Discard, parquet.CompactOptions{}, bytes.NewReader(enc.Bytes()))
+	assert.True(t, errors.Is(err, parquet.ErrEncrypted), err)
+
+	_, err = parquet.Split(bytes.NewReader(enc.Bytes()), parquet.SplitOptions{}, func(int) (io.Writer, error) { return ioutil.Discard, nil })
+	assert.True(t, errors.Is(err, parquet.ErrEncrypted), err)
+}
+
+func TestSplit(t *testing.T) {
+	input := getPeople(50, 200)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20))
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	sizes := make([]int64, len(footer.RowGroups))
+	for i, rg := range footer.RowGroups {
+		for _, ch := range rg.Columns {
+			sizes[i] += ch.MetaData.TotalCompressedSize
+		}
+	}
+
+	// big enough for two row groups but not three
+	pair := sizes[0] + sizes[1]
+	if sizes[2]+sizes[3] > pair {
+		pair = sizes[2] + sizes[3]
+	}
+
+	testCases := []struct {
+		name      string
+		opts      parquet.SplitOptions
+		rowGroups []int
+	}{
+		{name: "row groups", opts: parquet.SplitOptions{RowGroups: 3}, rowGroups: []int{3, 1}},
+		{name: "files", opts: parquet.SplitOptions{Files: 2}, rowGroups: []int{2, 2}},
+		{name: "uneven files", opts: parquet.SplitOptions{Files: 3}, rowGroups: []int{2, 1, 1}},
+		{name: "more files than row groups", opts: parquet.SplitOptions{Files: 8}, rowGroups: []int{1, 1, 1, 1}},
+		{name: "files and row groups", opts: parquet.SplitOptions{Files: 2, RowGroups: 1}, rowGroups: []int{1, 1, 1, 1}},
+		{name: "tiny size", opts: parquet.SplitOptions{MaxSize: 1}, rowGroups: []int{1, 1, 1, 1}},
+		{name: "size", opts: parquet.SplitOptions{MaxSize: pair}, rowGroups: []int{2, 2}},
+		{name: "no limits", rowGroups: []int{4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var outs []*bytes.Buffer
+			n, err := parquet.Split(bytes.NewReader(buf.Bytes()), tc.opts, func(i int) (io.Writer, error) {
+				assert.Equal(t, len(outs), i)
+				outs = append(outs, &bytes.Buffer{})
+				return outs[i], nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.rowGroups), n)
+
+			var i int
+			for j, out := range outs {
+				footer, err := parquet.ReadMetaData(bytes.NewReader(out.Bytes()))
+				if assert.NoError(t, err) {
+					assert.Equal(t, tc.rowGroups[j], len(footer.RowGroups))
+				}
+
+				r, err := NewParquetReader(bytes.NewReader(out.Bytes()))
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				for r.Next() {
+					var p Person
+					r.Scan(&p)
+					assert.Equal(t, *getExpected(input, i), p)
+					i++
+				}
+				assert.NoError(t, r.Error())
+			}
+			assert.Equal(t, 200, i)
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	input := getPeople(50, 200)
+	var files []*bytes.Buffer
+	for i := 0; i < len(input); i += 2 {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, MaxPageSize(7), Uncompressed)
+		assert.NoError(t, err)
+		for _, rowgroup := range input[i : i+2] {
+			for _, p := range rowgroup {
+				w.Add(p)
+			}
+			assert.NoError(t, w.Write())
+		}
+		assert.NoError(t, w.Close())
+		files = append(files, &buf)
+	}
+
+	// the same rows written straight to 120 row row groups
+	var expected bytes.Buffer
+	w, err := NewParquetWriter(&expected, MaxPageSize(30), Uncompressed)
+	assert.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		w.Add(*getExpected(input, i))
+		if i == 119 {
+			assert.NoError(t, w.Write())
+		}
+	}
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	// each column keeps the codec and dictionary encoding of the inputs
+	var out bytes.Buffer
+	n, err := parquet.Compact(&out, parquet.CompactOptions{RowGroupRows: 120, PageRows: 30}, bytes.NewReader(files[0].Bytes()), bytes.NewReader(files[1].Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), n)
+	assert.Equal(t, expected.Bytes(), out.Bytes())
+
+	r, err := NewParquetReader(bytes.NewReader(out.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, 200, i)
+
+	// Codec is used for every column and everything goes in one row group
+	codec := sch.CompressionCodec_SNAPPY
+	out.Reset()
+	_, err = parquet.Compact(&out, parquet.CompactOptions{Codec: &codec}, bytes.NewReader(files[0].Bytes()), bytes.NewReader(files[1].Bytes()))
+	assert.NoError(t, err)
+	footer, err := parquet.ReadMetaData(bytes.NewReader(out.Bytes()))
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(footer.RowGroups)) {
+		assert.Equal(t, int64(200), footer.RowGroups[0].NumRows)
+		for _, ch := range footer.RowGroups[0].Columns {
+			assert.Equal(t, codec, ch.MetaData.Codec, ch.MetaData.PathInSchema)
+		}
+	}
+}
+
+func TestCompactSchemaMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf)
+	assert.NoError(t, err)
+	w.Add(Person{})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	var other bytes.Buffer
+	m := parquet.New(parquet.Field{Name: "id", Path: []string{"id"}, Type: Int64Type, RepetitionType: parquet.RepetitionRequired, Types: []int{0}})
+	other.Write(parquet.Magic(nil))
+	assert.NoError(t, m.Footer(&other))
+	other.Write(parquet.Magic(nil))
+
+	_, err = parquet.Compact(ioutil.Discard, parquet.CompactOptions{}, bytes.NewReader(buf.Bytes()), bytes.NewReader(other.Bytes()))
+	assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), err)
+}
+
+func TestRecover(t *testing.T) {
+	input := getPeople(50, 200)
+	for _, comp := range []func(*ParquetWriter) error{Snappy, Zstd, Uncompressed} {
+		var buf bytes.Buffer
+		w, err := NewParquetWriter(&buf, MaxPageSize(20), comp, WriteChecksums)
+		assert.NoError(t, err)
+		for _, rowgroup := range input {
+			for _, p := range rowgroup {
+				w.Add(p)
+			}
+			assert.NoError(t, w.Write())
+		}
+		assert.NoError(t, w.Close())
+
+		b := buf.Bytes()
+		footer, err := parquet.ReadMetaData(bytes.NewReader(b))
+		assert.NoError(t, err)
+		rg := footer.RowGroups[3]
+		ch := rg.Columns[len(rg.Columns)-1].MetaData
+		end := ch.DataPageOffset + ch.TotalCompressedSize
+
+		testCases := []struct {
+			name string
+			size int64
+			rows int
+		}{
+			{name: "no footer", size: end, rows: 200},
+			{name: "partial footer", size: end + 10, rows: 200},
+			{name: "partial row group", size: rg.Columns[4].MetaData.DataPageOffset + 7, rows: 150},
+			{name: "first page", size: footer.RowGroups[0].Columns[0].MetaData.DataPageOffset + 20, rows: 0},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				var out bytes.Buffer
+				n, err := Recover(bytes.NewReader(b), tc.size, &out)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, int64(tc.rows), n)
+
+				r, err := NewParquetReader(bytes.NewReader(out.Bytes()), VerifyChecksums)
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				var i int
+				for r.Next() {
+					var p Person
+					r.Scan(&p)
+					assert.Equal(t, *getExpected(input, i), p)
+					i++
+				}
+				assert.NoError(t, r.Error())
+				assert.Equal(t, tc.rows, i)
+			})
+		}
+
+		// the schema can also come from a file that has a footer
+		schema, err := parquet.SchemaFields(footer.Schema)
+		assert.NoError(t, err)
+		var exp, out bytes.Buffer
+		_, err = Recover(bytes.NewReader(b), end, &exp)
+		assert.NoError(t, err)
+		n, err := parquet.Recover(bytes.NewReader(b), end, &out, schema...)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), n)
+		assert.Equal(t, exp.Bytes(), out.Bytes())
+	}
+
+	_, err := Recover(bytes.NewReader([]byte("nope")), 4, ioutil.Discard)
+	assert.True(t, errors.Is(err, parquet.ErrNotParquet))
+}
+
+func TestRecoverAmbiguous(t *testing.T) {
+	schema := []parquet.Field{
+		{Name: "a", Path: []string{"a"}, Types: []int{0}, Type: Int64Type, RepetitionType: parquet.RepetitionRequired},
+		{Name: "b", Path: []string{"b"}, Types: []int{0}, Type: Int64Type, RepetitionType: parquet.RepetitionRequired},
+	}
+
+	// write writes a file without a footer that has a page
+	// for each column of each row group
+	write := func(rowGroups ...[]int64) []byte {
+		var buf bytes.Buffer
+		buf.Write(parquet.Magic(nil))
+		m := parquet.New(schema...)
+		for _, vals := range rowGroups {
+			for range vals {
+				m.NextDoc()
+			}
+			for _, f := range schema {
+				fld := parquet.NewRequiredField(f.Path, parquet.RequiredFieldUncompressed)
+				assert.NoError(t, fld.DoWrite(&buf, m, parquet.EncodeInt64s(nil, vals), len(vals), noStats{}))
+			}
+			m.StartRowGroup(schema...)
+		}
+		return buf.Bytes()
+	}
+
+	// two row groups of two rows look just like one row group
+	// of four rows with two pages in each column chunk
+	b := write([]int64{1, 2}, []int64{3, 4})
+	_, err := parquet.Recover(bytes.NewReader(b), int64(len(b)), ioutil.Discard, schema...)
+	assert.True(t, errors.Is(err, parquet.ErrAmbiguousPages), err)
+
+	// but a short page can only be the last page of a column chunk
+	b = write([]int64{1, 2}, []int64{3})
+	var out bytes.Buffer
+	n, err := parquet.Recover(bytes.NewReader(b), int64(len(b)), &out, schema...)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(out.Bytes()))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, len(footer.RowGroups))
+	}
+
+	_, err = parquet.Recover(bytes.NewReader(b), int64(len(b)), ioutil.Discard, parquet.Field{Name: "a", Path: []string{"a"}, Types: []int{0}})
+	assert.EqualError(t, err, "column a doesn't have a type")
+}
+
+type noStats struct{}
+
+func (noStats) NullCount() *int64     { return nil }
+func (noStats) DistinctCount() *int64 { return nil }
+func (noStats) Min() []byte           { return nil }
+func (noStats) Max() []byte           { return nil }
+
+func TestSchemaEvolution(t *testing.T) {
+	input := getPeople(50, 200)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20))
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
+
+	// the file has joy and gloom instead of happiness and sadness
+	renamed := map[string]string{"happiness": "joy", "sadness": "gloom"}
+	data := editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+		for _, se := range footer.Schema {
+			if name, ok := renamed[se.Name]; ok {
+				se.Name = name
+			}
+		}
+		for _, rg := range footer.RowGroups {
+			for _, ch := range rg.Columns {
+				if name, ok := renamed[ch.MetaData.PathInSchema[0]]; ok {
+					ch.MetaData.PathInSchema = []string{name}
+				}
+			}
+		}
+	})
+
+	for _, opt := range []func(*ParquetReader){nil, ReadConcurrency(3)} {
+		var opts []func(*ParquetReader)
+		if opt != nil {
+			opts = append(opts, opt)
+		}
+
+		r, err := NewParquetReader(bytes.NewReader(data), opts...)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var i int
+		for r.Next() {
+			// missing required columns are set to zero
+			p := Person{Happiness: 7}
+			r.Scan(&p)
+			expected := *getExpected(input, i)
+			expected.Happiness, expected.Sadness = 0, nil
+			assert.Equal(t, expected, p)
+			i++
+		}
+		assert.NoError(t, r.Error())
+		assert.Equal(t, 200, i)
+
+		_, err = NewParquetReader(bytes.NewReader(data), append(opts, StrictSchema)...)
+		assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), err)
+	}
+
+	_, err = NewParquetReader(bytes.NewReader(buf.Bytes()), StrictSchema)
+	assert.NoError(t, err)
+}
+
+func TestValidateSchema(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf)
+	assert.NoError(t, err)
+	w.Add(Person{})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	data := editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+		for _, se := range footer.Schema {
+			switch se.Name {
+			case "happiness":
+				se.Type = sch.TypePtr(sch.Type_INT32)
+			case "sadness":
+				se.RepetitionType = sch.FieldRepetitionTypePtr(sch.FieldRepetitionType_REQUIRED)
+			case "bff":
+				se.Name = "bestie"
+			}
+		}
+	})
+
+	_, err = NewParquetReader(bytes.NewReader(data))
+	var se *parquet.SchemaError
+	if assert.True(t, errors.As(err, &se), err) {
+		assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch))
+		assert.Equal(t, []parquet.ColumnMismatch{
+			{Column: "happiness", File: "required int32", Expected: "required int64"},
+			{Column: "sadness", File: "required int64", Expected: "optional int64"},
+		}, se.Columns)
+	}
+
+	_, err = NewParquetReader(bytes.NewReader(data), StrictSchema)
+	if assert.True(t, errors.As(err, &se), err) {
+		assert.Equal(t, []parquet.ColumnMismatch{
+			{Column: "happiness", File: "required int32", Expected: "required int64"},
+			{Column: "sadness", File: "required int64", Expected: "optional int64"},
+			{Column: "bff", Expected: "required byte_array"},
+			{Column: "bestie", File: "required byte_array"},
+		}, se.Columns)
+	}
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if assert.NoError(t, err) {
+		schema := make([]parquet.Field, 0, len(Fields(compressionSnappy)))
+		for _, f := range Fields(compressionSnappy) {
+			schema = append(schema, f.Schema())
+		}
+		assert.NoError(t, parquet.ValidateSchema(footer.Schema, schema, true))
+	}
+
+	// a schema that isn't a valid tree is an error even if it isn't strict
+	edits := map[string]func(se *sch.SchemaElement){
+		"too many children": func(se *sch.SchemaElement) { *se.NumChildren++ },
+		"too few children":  func(se *sch.SchemaElement) { *se.NumChildren-- },
+		"group with a type": func(se *sch.SchemaElement) { se.Type = sch.TypePtr(sch.Type_INT32) },
+	}
+	for name, edit := range edits {
+		data := editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+			for _, se := range footer.Schema {
+				if se.Name == "hobby" {
+					edit(se)
+				}
+			}
+		})
+
+		_, err = NewParquetReader(bytes.NewReader(data))
+		assert.True(t, errors.Is(err, parquet.ErrSchemaMismatch), "%s: %v", name, err)
+	}
+}
 
-				r, err := NewParquetReader(bytes.NewReader(buf.Bytes()))
-				if !assert.NoError(t, err) {
-					return
-				}
+func TestFieldIDs(t *testing.T) {
+	input := getPeople(20, 60)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(15))
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
+		for _, p := range rowgroup {
+			w.Add(p)
+		}
+		assert.NoError(t, w.Write())
+	}
+	assert.NoError(t, w.Close())
 
-				expected := tc.expected
-				if expected == nil {
-					expected = tc.input
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
+	if !assert.NoError(t, err) {
+		return
+	}
+	ids := map[string]int32{}
+	for _, se := range footer.Schema {
+		if se.FieldID != nil {
+			ids[se.Name] = *se.FieldID
+		}
+	}
+	assert.Equal(t, map[string]int32{"happiness": 1, "sadness": 2, "hobby": 3, "name": 4, "difficulty": 5}, ids)
+
+	// the columns were renamed but kept their ids
+	renamed := map[string]string{"happiness": "joy", "sadness": "gloom", "hobby": "pastime"}
+	data := editFooter(t, buf.Bytes(), func(footer *sch.FileMetaData) {
+		for _, se := range footer.Schema {
+			if name, ok := renamed[se.Name]; ok {
+				se.Name = name
+			}
+		}
+		for _, rg := range footer.RowGroups {
+			for _, ch := range rg.Columns {
+				if name, ok := renamed[ch.MetaData.PathInSchema[0]]; ok {
+					ch.MetaData.PathInSchema[0] = name
 				}
+			}
+		}
+	})
 
-				if !assert.Equal(t, getLen(expected), int(r.Rows()), tc.name) {
-					return
-				}
+	for _, opt := range []func(*ParquetReader){nil, ReadConcurrency(3)} {
+		opts := []func(*ParquetReader){MatchFieldIDs}
+		if opt != nil {
+			opts = append(opts, opt)
+		}
 
-				var i int
-				for r.Next() {
-					var p Person
-					r.Scan(&p)
-					exp := getExpected(expected, i)
-					assert.Equal(t, *exp, p, fmt.Sprintf("%s-%d", tc.name, i))
-					i++
-				}
+		r, err := NewParquetReader(bytes.NewReader(data), opts...)
+		if !assert.NoError(t, err) {
+			return
+		}
 
-				assert.Nil(t, r.Error(), tc.name)
-				assert.Equal(t, getLen(expected), i, tc.name)
-			})
+		var i int
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+			assert.Equal(t, *getExpected(input, i), p)
+			i++
 		}
+		assert.NoError(t, r.Error())
+		assert.Equal(t, 60, i)
+
+		_, err = NewParquetReader(bytes.NewReader(data), append(opts, StrictSchema)...)
+		assert.NoError(t, err)
 	}
-}
 
-func TestPageHeaders(t *testing.T) {
-	var buf bytes.Buffer
-	w, err := NewParquetWriter(&buf, MaxPageSize(2))
+	// without MatchFieldIDs the renamed columns are matched by name
+	r, err := NewParquetReader(bytes.NewReader(data))
 	if !assert.NoError(t, err) {
 		return
 	}
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		expected := *getExpected(input, i)
+		expected.Happiness, expected.Sadness, expected.Hobby = 0, nil, nil
+		assert.Equal(t, expected, p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, 60, i)
+}
+
+// editFooter returns a copy of the parquet file in data
+// with its footer changed by edit.
+func editFooter(t *testing.T, data []byte, edit func(*sch.FileMetaData)) []byte {
+	footer, err := parquet.ReadMetaData(bytes.NewReader(data))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	edit(footer)
 
-	docs := [][]Person{
-		{{}, {}, {}, {}},
-		{{}, {}, {}, {}},
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	buf, err := ts.Write(context.TODO(), footer)
+	if !assert.NoError(t, err) {
+		t.FailNow()
 	}
-	for _, rowgroup := range docs {
+
+	l := binary.LittleEndian.Uint32(data[len(data)-8:])
+	out := append([]byte{}, data[:len(data)-8-int(l)]...)
+	out = append(out, buf...)
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(len(buf)))
+	out = append(out, n[:]...)
+	return append(out, "PAR1"...)
+}
+
+// editEncryptedFooter is editFooter for files with an encrypted footer
+// and no AAD prefix.
+func editEncryptedFooter(t *testing.T, data []byte, keys parquet.InMemoryKeys, edit func(*sch.FileMetaData)) []byte {
+	footer, err := parquet.ReadDecryptedMetaData(bytes.NewReader(data), &parquet.Decryption{Keys: keys})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// the metadata of columns with their own keys stays encrypted
+	for _, rg := range footer.RowGroups {
+		for _, ch := range rg.Columns {
+			if ch.CryptoMetadata != nil && ch.CryptoMetadata.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+				ch.MetaData = nil
+			}
+		}
+	}
+	edit(footer)
+
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	buf, err := ts.Write(context.TODO(), footer)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// the footer is the FileCryptoMetaData followed by the encrypted
+	// FileMetaData, whose AAD is the file's AAD and the footer module (0)
+	l := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	start := len(data) - 8 - l
+	mb := thrift.NewTMemoryBuffer()
+	mb.Write(data[start : len(data)-8])
+	p := thrift.NewTCompactProtocol(mb)
+	cm := sch.NewFileCryptoMetaData()
+	if err := cm.Read(p); !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	crypto := data[start : len(data)-8-mb.Len()]
+
+	block, err := aes.NewCipher(keys[""])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	gcm, err := cipher.NewGCM(block)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	aad := append(append([]byte{}, cm.EncryptionAlgorithm.AES_GCM_V1.AadFileUnique...), 0)
+	enc := gcm.Seal(nonce, nonce, buf, aad)
+
+	out := append([]byte{}, data[:start]...)
+	out = append(out, crypto...)
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(len(enc)))
+	out = append(out, n[:]...)
+	out = append(out, enc...)
+	binary.LittleEndian.PutUint32(n[:], uint32(len(crypto)+4+len(enc)))
+	out = append(out, n[:]...)
+	return append(out, "PARE"...)
+}
+
+func TestZeroCopyStrings(t *testing.T) {
+	input := getPeople(50, 200)
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(20), Snappy)
+	assert.NoError(t, err)
+	for _, rowgroup := range input {
 		for _, p := range rowgroup {
 			w.Add(p)
 		}
 		assert.NoError(t, w.Write())
 	}
+	assert.NoError(t, w.Close())
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()), ZeroCopyStrings)
+	assert.NoError(t, err)
+	var i int
+	for r.Next() {
+		var p Person
+		r.Scan(&p)
+		assert.Equal(t, *getExpected(input, i), p)
+		i++
+	}
+	assert.NoError(t, r.Error())
+	assert.Equal(t, getLen(input), i)
+}
+
+func TestDecodeStrings(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf)
+	assert.NoError(t, err)
+	w.Add(Person{})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	// the pages of a footer that is read after ZeroCopyStrings
+	// decode strings that share the memory of the page data
+	var schema []parquet.Field
+	for _, f := range Fields(compressionUnknown) {
+		schema = append(schema, f.Schema())
+	}
+	m := parquet.New(schema...)
+	m.ZeroCopyStrings()
+	assert.NoError(t, m.ReadFooter(bytes.NewReader(buf.Bytes())))
+	pages, err := m.Pages()
+	assert.NoError(t, err)
+	zeroCopy := pages["hobby.name"][0]
+
+	vals := make([]string, 1000)
+	for i := range vals {
+		vals[i] = fmt.Sprintf("value %d", i)
+	}
+	b := parquet.EncodeStrings(nil, vals)
+
+	for _, pg := range []parquet.Page{{}, zeroCopy} {
+		out, err := pg.DecodeStrings([]string{"x"}, bytes.NewBuffer(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, append([]string{"x"}, vals...), out)
+	}
+
+	out := make([]string, 0, len(vals))
+	allocs := testing.AllocsPerRun(10, func() {
+		parquet.Page{}.DecodeStrings(out, bytes.NewBuffer(b), len(vals))
+	})
+	assert.True(t, allocs >= float64(len(vals)), allocs)
+
+	allocs = testing.AllocsPerRun(10, func() {
+		zeroCopy.DecodeStrings(out, bytes.NewBuffer(b), len(vals))
+	})
+	assert.True(t, allocs < 10, allocs)
+
+	data := append([]byte{}, b...)
+	out, err = zeroCopy.DecodeStrings(nil, bytes.NewBuffer(data), len(vals))
+	assert.NoError(t, err)
+	data[4] = 'V'
+	assert.Equal(t, "Value 0", out[0])
+}
 
+func TestMalformed(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, MaxPageSize(4))
+	assert.NoError(t, err)
+	for _, p := range getPeople(10, 10)[0] {
+		w.Add(p)
+	}
+	assert.NoError(t, w.Write())
 	assert.NoError(t, w.Close())
+	b := buf.Bytes()
 
-	rd := bytes.NewReader(buf.Bytes())
-	footer, err := parquet.ReadMetaData(rd)
-	if !assert.NoError(t, err) {
-		return
+	read := func(b []byte) error {
+		r, err := NewParquetReader(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		for r.Next() {
+			var p Person
+			r.Scan(&p)
+		}
+		return r.Error()
 	}
 
-	pageHeaders, err := parquet.PageHeaders(footer, rd)
+	_, err = parquet.ReadMetaData(bytes.NewReader([]byte("PAR1")))
+	assert.True(t, errors.Is(err, parquet.ErrNotParquet), err)
+
+	notParquet := append([]byte("PAR2"), b[4:]...)
+	_, err = parquet.ReadMetaData(bytes.NewReader(notParquet))
+	assert.True(t, errors.Is(err, parquet.ErrNotParquet), err)
+	_, err = parquet.ReadMetaDataAt(bytes.NewReader(notParquet), int64(len(notParquet)))
+	assert.True(t, errors.Is(err, parquet.ErrNotParquet), err)
+
+	bigFooter := append([]byte{}, b...)
+	binary.LittleEndian.PutUint32(bigFooter[len(b)-8:], uint32(len(b)))
+	_, err = parquet.ReadMetaData(bytes.NewReader(bigFooter))
+	assert.True(t, errors.Is(err, parquet.ErrCorruptFooter), err)
+
+	n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	badFooter := append([]byte{}, b...)
+	for i := len(b) - 8 - n; i < len(b)-8; i++ {
+		badFooter[i] = 0xff
+	}
+	_, err = parquet.ReadMetaData(bytes.NewReader(badFooter))
+	assert.True(t, errors.Is(err, parquet.ErrCorruptFooter), err)
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(b))
+	assert.NoError(t, err)
+	ch := footer.RowGroups[0].Columns[0]
+	badPage := append([]byte{}, b...)
+	badPage[ch.MetaData.DataPageOffset] = 0xff
+	err = read(badPage)
+	var pe *parquet.PageError
+	if assert.True(t, errors.As(err, &pe), err) {
+		assert.Equal(t, strings.Join(ch.MetaData.PathInSchema, "."), pe.Column)
+		assert.Equal(t, ch.MetaData.DataPageOffset, pe.Offset)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage), err)
+	}
+
+	// none of these should panic
+	for i := range b {
+		corrupt := append([]byte{}, b...)
+		corrupt[i] ^= 0xff
+		read(corrupt)
+		read(b[:i])
+	}
+}
+
+func TestMalformedPages(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, Uncompressed)
+	assert.NoError(t, err)
+	w.Add(Person{Sadness: pint64(1)})
+	assert.NoError(t, w.Write())
+	assert.NoError(t, w.Close())
+
+	footer, err := parquet.ReadMetaData(bytes.NewReader(buf.Bytes()))
 	if !assert.NoError(t, err) {
 		return
 	}
 
-	assert.Equal(t, 72, len(pageHeaders))
+	offsets := map[string]int64{}
+	for _, ch := range footer.RowGroups[0].Columns {
+		offsets[ch.MetaData.PathInSchema[0]] = ch.MetaData.DataPageOffset
+	}
+
+	// edit changes the header and data of the page of a column
+	// in a copy of the file.  The header has to keep its size.
+	edit := func(t *testing.T, col string, f func(ph *sch.PageHeader, data []byte)) []byte {
+		offset := offsets[col]
+		b := append([]byte{}, buf.Bytes()...)
+		rc := &readCounter{r: bytes.NewReader(b[offset:])}
+		ph, err := parquet.PageHeader(rc)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		f(ph, b[offset+rc.n:])
+		ts := thrift.NewTSerializer()
+		ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+		h, err := ts.Write(context.TODO(), ph)
+		if !assert.NoError(t, err) || !assert.Equal(t, int(rc.n), len(h)) {
+			t.FailNow()
+		}
+		copy(b[offset:], h)
+		return b
+	}
+
+	testCases := []struct {
+		name   string
+		column string
+		edit   func(ph *sch.PageHeader, data []byte)
+		err    string
+	}{
+		{
+			// the definition levels of the page are a bit-packed run of
+			// a single 1 (padded to 8 levels), make it an RLE run of 63 1s
+			name:   "too many levels",
+			column: "sadness",
+			edit: func(ph *sch.PageHeader, data []byte) {
+				assert.Equal(t, []byte{2, 0, 0, 0, 3, 1}, data[:6])
+				data[4] = 63 << 1
+			},
+			err: "RLE run of 63 values is too long",
+		},
+		{
+			name:   "uncompressed size",
+			column: "sadness",
+			edit: func(ph *sch.PageHeader, data []byte) {
+				ph.UncompressedPageSize++
+			},
+			err: "uncompressed page of 14 bytes has an uncompressed size of 15",
+		},
+		{
+			// bff has compression=zstd in its tag
+			name:   "zstd size",
+			column: "bff",
+			edit: func(ph *sch.PageHeader, data []byte) {
+				ph.UncompressedPageSize++
+			},
+			err: "zstd data doesn't decode to 5 bytes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewParquetReader(bytes.NewReader(edit(t, tc.column, tc.edit)))
+			if assert.True(t, errors.Is(err, parquet.ErrCorruptPage), err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
+
+type readCounter struct {
+	n int64
+	r io.Reader
+}
+
+func (r *readCounter) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
 }
 
 func TestStats(t *testing.T) {
@@ -522,12 +2527,15 @@ func TestStats(t *testing.T) {
 		min      []byte
 		max      []byte
 		nilCount *int64
+		minExact *bool
+		maxExact *bool
 	}
 
 	type testCase struct {
 		name     string
 		input    [][]Person
 		pageSize int
+		truncate int
 		stats    []stats
 		col      string
 	}
@@ -690,6 +2698,161 @@ func TestStats(t *testing.T) {
 				{min: []byte("Fred"), max: []byte("Miranda"), nilCount: pint64(1)},
 			},
 		},
+		{
+			name: "negative int64 stats",
+			col:  "happiness",
+			input: [][]Person{
+				{
+					{Happiness: -5},
+					{Happiness: -3},
+					{Happiness: -10},
+				},
+			},
+			stats: []stats{
+				{min: writeInt64(-10), max: writeInt64(-3)},
+			},
+		},
+		{
+			name: "negative optional int64 stats",
+			col:  "sadness",
+			input: [][]Person{
+				{
+					{Sadness: pint64(-7)},
+					{Sadness: nil},
+					{Sadness: pint64(-70)},
+				},
+			},
+			stats: []stats{
+				{min: writeInt64(-70), max: writeInt64(-7), nilCount: pint64(1)},
+			},
+		},
+		{
+			name: "float64 stats skip NaN",
+			col:  "boldness",
+			input: [][]Person{
+				{
+					{Boldness: math.NaN()},
+					{Boldness: -2},
+					{Boldness: 1.5},
+					{Boldness: math.NaN()},
+				},
+			},
+			stats: []stats{
+				{min: writeFloat64(-2), max: writeFloat64(1.5)},
+			},
+		},
+		{
+			name: "float32 zero stats are signed",
+			col:  "funkiness",
+			input: [][]Person{
+				{
+					{Funkiness: 0},
+					{Funkiness: float32(math.Copysign(0, -1))},
+				},
+			},
+			stats: []stats{
+				{min: writeFloat32(float32(math.Copysign(0, -1))), max: writeFloat32(0)},
+			},
+		},
+		{
+			name: "optional float32 stats with only NaN",
+			col:  "lameness",
+			input: [][]Person{
+				{
+					{Lameness: pfloat32(float32(math.NaN()))},
+					{Lameness: nil},
+				},
+			},
+			stats: []stats{
+				{nilCount: pint64(1)},
+			},
+		},
+		{
+			name:     "truncated string stats",
+			col:      "bff",
+			truncate: 4,
+			input: [][]Person{
+				{
+					{BFF: "Frederick"},
+					{BFF: "Valentina"},
+					{BFF: "Miranda"},
+				},
+			},
+			stats: []stats{
+				{min: []byte("Fred"), max: []byte("Valf"), minExact: pbool(false), maxExact: pbool(false)},
+			},
+		},
+		{
+			name:     "truncated max ending in 0xff",
+			col:      "code",
+			truncate: 3,
+			input: [][]Person{
+				{
+					{Code: pstring("ab")},
+					{Code: pstring("ab\xff\xffz")},
+					{Code: nil},
+				},
+			},
+			stats: []stats{
+				{min: []byte("ab"), max: []byte("ac"), nilCount: pint64(1), minExact: pbool(true), maxExact: pbool(false)},
+			},
+		},
+		{
+			name:     "short string stats aren't truncated",
+			col:      "bff",
+			truncate: 10,
+			input: [][]Person{
+				{
+					{BFF: "Fred"},
+					{BFF: "Val"},
+				},
+			},
+			stats: []stats{
+				{min: []byte("Fred"), max: []byte("Val"), minExact: pbool(true), maxExact: pbool(true)},
+			},
+		},
+		{
+			name: "timestamp stats",
+			col:  "born",
+			input: [][]Person{
+				{
+					{Born: time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{Born: time.Date(1960, 1, 1, 0, 0, 0, 5e6, time.UTC)},
+					{Born: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			stats: []stats{
+				{min: writeInt64(-315619199995), max: writeInt64(1609459200000)},
+			},
+		},
+		{
+			name: "optional timestamp stats",
+			col:  "last_seen",
+			input: [][]Person{
+				{
+					{LastSeen: ptime(time.Date(2001, 1, 1, 0, 0, 0, 0, time.Local))},
+					{LastSeen: nil},
+					{LastSeen: ptime(time.Date(1970, 1, 1, 0, 0, 0, 7000, time.Local))},
+				},
+			},
+			stats: []stats{
+				{min: writeInt64(7), max: writeInt64(978307200000000), nilCount: pint64(1)},
+			},
+		},
+		{
+			name:     "numeric stats aren't truncated",
+			col:      "happiness",
+			truncate: 2,
+			input: [][]Person{
+				{
+					{Happiness: 1},
+					{Happiness: 22},
+				},
+			},
+			stats: []stats{
+				{min: writeInt64(1), max: writeInt64(22)},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -699,7 +2862,7 @@ func TestStats(t *testing.T) {
 					tc.pageSize = 100
 				}
 				var buf bytes.Buffer
-				w, err := NewParquetWriter(&buf, MaxPageSize(tc.pageSize), compressionTest[comp])
+				w, err := NewParquetWriter(&buf, MaxPageSize(tc.pageSize), compressionTest[comp], TruncateStats(tc.truncate))
 				assert.Nil(t, err, tc.name)
 				for _, rowgroup := range tc.input {
 					for _, p := range rowgroup {
@@ -729,6 +2892,8 @@ func TestStats(t *testing.T) {
 					ph := pages[i]
 					assert.Equal(t, st.min, ph.DataPageHeader.Statistics.MinValue)
 					assert.Equal(t, st.max, ph.DataPageHeader.Statistics.MaxValue)
+					assert.Equal(t, st.minExact, ph.DataPageHeader.Statistics.IsMinValueExact)
+					assert.Equal(t, st.maxExact, ph.DataPageHeader.Statistics.IsMaxValueExact)
 					if st.nilCount == nil {
 						assert.Equal(t, st.nilCount, ph.DataPageHeader.Statistics.NullCount)
 					} else {
@@ -760,6 +2925,7 @@ func getPageHeaders(r io.ReadSeeker, name string, footer *sch.FileMetaData) ([]s
 var compressionTest = map[string]func(*ParquetWriter) error{
 	"uncompressed": Uncompressed,
 	"snappy":       Snappy,
+	"zstd":         Zstd,
 }
 
 func getLen(peeps [][]Person) int {
@@ -883,6 +3049,12 @@ func newPerson(i int) Person {
 		anv = &x
 	}
 
+	var seen *time.Time
+	if i%4 == 0 {
+		t := time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local).Add(time.Duration(i) * 1001 * time.Microsecond)
+		seen = &t
+	}
+
 	return Person{
 		Being: Being{
 			ID:  int32(i),
@@ -897,6 +3069,8 @@ func newPerson(i int) Person {
 		Keen:        keen,
 		Birthday:    uint32(i * 1000),
 		Anniversary: anv,
+		Born:        time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Hour),
+		LastSeen:    seen,
 	}
 }
 
@@ -973,26 +3147,28 @@ type Being struct {
 }
 
 type Hobby struct {
-	Name       string `parquet:"name"`
-	Difficulty *int32 `parquet:"difficulty"`
+	Name       string `parquet:"name,id=4"`
+	Difficulty *int32 `parquet:"difficulty,id=5"`
 }
 
 type Person struct {
 	Being
-	Happiness   int64    `parquet:"happiness"`
-	Sadness     *int64   `parquet:"sadness"`
-	Code        *string  `parquet:"code"`
-	Funkiness   float32  `parquet:"funkiness"`
-	Boldness    float64  `parquet:"boldness"`
-	Lameness    *float32 `parquet:"lameness"`
-	Keen        *bool    `parquet:"keen"`
-	Birthday    uint32   `parquet:"birthday"`
-	Anniversary *uint64  `parquet:"anniversary"`
-	BFF         string   `parquet:"bff"`
-	Hungry      bool     `parquet:"hungry"`
-	Secret      string   `parquet:"-"`
-	Hobby       *Hobby   `parquet:"hobby"`
-	Friends     []Being  `parquet:"friends"`
+	Happiness   int64      `parquet:"happiness,id=1"`
+	Sadness     *int64     `parquet:"sadness,id=2"`
+	Code        *string    `parquet:"code,encoding=dict,bloom=true"`
+	Funkiness   float32    `parquet:"funkiness"`
+	Boldness    float64    `parquet:"boldness"`
+	Lameness    *float32   `parquet:"lameness"`
+	Keen        *bool      `parquet:"keen"`
+	Birthday    uint32     `parquet:"birthday,encoding=dict"`
+	Anniversary *uint64    `parquet:"anniversary,compression=uncompressed"`
+	BFF         string     `parquet:"bff,compression=zstd,bloom=true"`
+	Hungry      bool       `parquet:"hungry"`
+	Born        time.Time  `parquet:"born,unit=millis"`
+	LastSeen    *time.Time `parquet:"last_seen,utc=false"`
+	Secret      string     `parquet:"-"`
+	Hobby       *Hobby     `parquet:"hobby,id=3"`
+	Friends     []Being    `parquet:"friends"`
 	Sleepy      bool
 }
 
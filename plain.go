@@ -0,0 +1,300 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"unsafe"
+)
+
+// The Encode functions append the PLAIN encoding of vals to dst and
+// the Decode functions append n PLAIN encoded values that are read
+// from r to dst.  They are used by the generated code so that a page
+// is encoded and decoded in one pass instead of with a binary.Write
+// or binary.Read call per value.
+
+// EncodeInt32s appends the PLAIN encoding of vals to dst.
+func EncodeInt32s(dst []byte, vals []int32) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 4*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint32(dst[i:], uint32(v))
+		i += 4
+	}
+	return dst
+}
+
+// DecodeInt32s appends n PLAIN encoded values from r to dst.
+func DecodeInt32s(dst []int32, r io.Reader, n int) ([]int32, error) {
+	b, err := plainBytes(r, n, 4)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 4 {
+		dst = append(dst, int32(binary.LittleEndian.Uint32(b[i:])))
+	}
+	return dst, nil
+}
+
+// EncodeUint32s appends the PLAIN encoding of vals to dst.
+func EncodeUint32s(dst []byte, vals []uint32) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 4*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint32(dst[i:], v)
+		i += 4
+	}
+	return dst
+}
+
+// DecodeUint32s appends n PLAIN encoded values from r to dst.
+func DecodeUint32s(dst []uint32, r io.Reader, n int) ([]uint32, error) {
+	b, err := plainBytes(r, n, 4)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 4 {
+		dst = append(dst, binary.LittleEndian.Uint32(b[i:]))
+	}
+	return dst, nil
+}
+
+// EncodeInt64s appends the PLAIN encoding of vals to dst.
+func EncodeInt64s(dst []byte, vals []int64) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 8*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint64(dst[i:], uint64(v))
+		i += 8
+	}
+	return dst
+}
+
+// DecodeInt64s appends n PLAIN encoded values from r to dst.
+func DecodeInt64s(dst []int64, r io.Reader, n int) ([]int64, error) {
+	b, err := plainBytes(r, n, 8)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 8 {
+		dst = append(dst, int64(binary.LittleEndian.Uint64(b[i:])))
+	}
+	return dst, nil
+}
+
+// EncodeUint64s appends the PLAIN encoding of vals to dst.
+func EncodeUint64s(dst []byte, vals []uint64) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 8*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint64(dst[i:], v)
+		i += 8
+	}
+	return dst
+}
+
+// DecodeUint64s appends n PLAIN encoded values from r to dst.
+func DecodeUint64s(dst []uint64, r io.Reader, n int) ([]uint64, error) {
+	b, err := plainBytes(r, n, 8)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 8 {
+		dst = append(dst, binary.LittleEndian.Uint64(b[i:]))
+	}
+	return dst, nil
+}
+
+// EncodeFloat32s appends the PLAIN encoding of vals to dst.
+func EncodeFloat32s(dst []byte, vals []float32) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 4*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint32(dst[i:], math.Float32bits(v))
+		i += 4
+	}
+	return dst
+}
+
+// DecodeFloat32s appends n PLAIN encoded values from r to dst.
+func DecodeFloat32s(dst []float32, r io.Reader, n int) ([]float32, error) {
+	b, err := plainBytes(r, n, 4)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 4 {
+		dst = append(dst, math.Float32frombits(binary.LittleEndian.Uint32(b[i:])))
+	}
+	return dst, nil
+}
+
+// EncodeFloat64s appends the PLAIN encoding of vals to dst.
+func EncodeFloat64s(dst []byte, vals []float64) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 8*len(vals))
+	for _, v := range vals {
+		binary.LittleEndian.PutUint64(dst[i:], math.Float64bits(v))
+		i += 8
+	}
+	return dst
+}
+
+// DecodeFloat64s appends n PLAIN encoded values from r to dst.
+func DecodeFloat64s(dst []float64, r io.Reader, n int) ([]float64, error) {
+	b, err := plainBytes(r, n, 8)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 8 {
+		dst = append(dst, math.Float64frombits(binary.LittleEndian.Uint64(b[i:])))
+	}
+	return dst, nil
+}
+
+// EncodeStrings appends the PLAIN encoding (a 4 byte length
+// followed by the bytes) of each of vals to dst.
+func EncodeStrings(dst []byte, vals []string) []byte {
+	n := 4 * len(vals)
+	for _, s := range vals {
+		n += len(s)
+	}
+
+	i := len(dst)
+	dst = growBytes(dst, n)
+	for _, s := range vals {
+		binary.LittleEndian.PutUint32(dst[i:], uint32(len(s)))
+		i += 4
+		i += copy(dst[i:], s)
+	}
+	return dst
+}
+
+// DecodeByteArrays reads n PLAIN encoded byte arrays from r.  The
+// byte arrays are slices of the data that r holds (or, if r doesn't
+// hold its data in memory, of one buffer) so they must be copied
+// if they are kept after r is reused.
+func DecodeByteArrays(r io.Reader, n int) ([][]byte, error) {
+	if n < 0 {
+		return nil, corruptPage("negative number of values %d", n)
+	}
+
+	b, ok := r.(interface{ Bytes() []byte })
+	if !ok {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return decodeByteArrays(data, n)
+	}
+
+	data := b.Bytes()
+	out, err := decodeByteArrays(data, n)
+	if err != nil {
+		return nil, err
+	}
+
+	if nx, ok := r.(interface{ Next(int) []byte }); ok {
+		var l int
+		for _, v := range out {
+			l += 4 + len(v)
+		}
+		nx.Next(l)
+	}
+	return out, nil
+}
+
+// DecodeStrings appends n PLAIN encoded byte arrays from r to dst.
+// Normally each string is a copy of its bytes.  If the Metadata was
+// set to ZeroCopyStrings the strings aren't copied: when r has a Bytes
+// method (like the bytes.Buffer that DoRead returns) they share the
+// memory of that buffer, which must not be modified or reused while
+// any of them is in use.  The buffer stays in memory as long as any
+// of its strings does.
+func (pg Page) DecodeStrings(dst []string, r io.Reader, n int) ([]string, error) {
+	vals, err := DecodeByteArrays(r, n)
+	if err != nil {
+		return dst, err
+	}
+
+	if !pg.zeroCopy {
+		for _, v := range vals {
+			dst = append(dst, string(v))
+		}
+		return dst, nil
+	}
+
+	for _, v := range vals {
+		dst = append(dst, unsafeString(v))
+	}
+	return dst, nil
+}
+
+// unsafeString returns a string that shares the memory of b.
+func unsafeString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+func decodeByteArrays(data []byte, n int) ([][]byte, error) {
+	// every value needs at least 4 bytes for its length
+	if n > len(data)/4 {
+		return nil, corruptPage("%d byte arrays don't fit in %d bytes", n, len(data))
+	}
+
+	out := make([][]byte, n)
+	for i := range out {
+		if len(data) < 4 {
+			return nil, corruptPage("missing the length of byte array %d", i)
+		}
+
+		l := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+		if l > uint32(len(data)) {
+			return nil, corruptPage("byte array length %d is longer than the page", int32(l))
+		}
+
+		out[i], data = data[:l:l], data[l:]
+	}
+	return out, nil
+}
+
+// plainBytes returns the n*size bytes that hold n values that
+// are size bytes wide.  If r is a *bytes.Buffer (which is what
+// DoRead returns) the bytes aren't copied.
+func plainBytes(r io.Reader, n, size int) ([]byte, error) {
+	if n < 0 {
+		return nil, corruptPage("negative number of values %d", n)
+	}
+
+	type buffer interface {
+		Len() int
+		Next(int) []byte
+	}
+
+	if b, ok := r.(buffer); ok {
+		if n > b.Len()/size {
+			return nil, corruptPage("%d values don't fit in %d bytes", n, b.Len())
+		}
+		return b.Next(n * size), nil
+	}
+
+	out := make([]byte, n*size)
+	if _, err := io.ReadFull(r, out); err != nil {
+		return nil, corruptPage("unable to read %d values: %s", n, err)
+	}
+	return out, nil
+}
+
+// growBytes extends dst by n bytes.
+func growBytes(dst []byte, n int) []byte {
+	l := len(dst)
+	if cap(dst)-l < n {
+		nd := make([]byte, l, 2*cap(dst)+n)
+		copy(nd, dst)
+		dst = nd
+	}
+	return dst[:l+n]
+}
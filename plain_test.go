@@ -0,0 +1,172 @@
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/parsyl/parquet"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlain(t *testing.T) {
+	t.Run("int32", func(t *testing.T) {
+		vals := []int32{0, 1, -1, math.MaxInt32, math.MinInt32}
+		b := parquet.EncodeInt32s([]byte{9}, vals)
+		assert.Equal(t, plainBytes(vals), b[1:])
+		out, err := parquet.DecodeInt32s([]int32{7}, bytes.NewBuffer(b[1:]), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, append([]int32{7}, vals...), out)
+	})
+
+	t.Run("uint32", func(t *testing.T) {
+		vals := []uint32{0, 1, math.MaxUint32}
+		b := parquet.EncodeUint32s(nil, vals)
+		assert.Equal(t, plainBytes(vals), b)
+		out, err := parquet.DecodeUint32s(nil, bytes.NewBuffer(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		vals := []int64{0, 1, -1, math.MaxInt64, math.MinInt64}
+		b := parquet.EncodeInt64s(nil, vals)
+		assert.Equal(t, plainBytes(vals), b)
+		out, err := parquet.DecodeInt64s(nil, bytes.NewBuffer(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		vals := []uint64{0, 1, math.MaxUint64}
+		b := parquet.EncodeUint64s(nil, vals)
+		assert.Equal(t, plainBytes(vals), b)
+		out, err := parquet.DecodeUint64s(nil, bytes.NewBuffer(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		vals := []float32{0, 1.5, -2.25, math.MaxFloat32}
+		b := parquet.EncodeFloat32s(nil, vals)
+		assert.Equal(t, plainBytes(vals), b)
+		out, err := parquet.DecodeFloat32s(nil, bytes.NewBuffer(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		vals := []float64{0, 1.5, -2.25, math.MaxFloat64}
+		b := parquet.EncodeFloat64s(nil, vals)
+		assert.Equal(t, plainBytes(vals), b)
+
+		// readers that aren't a *bytes.Buffer are copied from
+		out, err := parquet.DecodeFloat64s(nil, bytes.NewReader(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		vals := []string{"", "a", "hello", ""}
+		var exp bytes.Buffer
+		for _, s := range vals {
+			binary.Write(&exp, binary.LittleEndian, int32(len(s)))
+			exp.WriteString(s)
+		}
+
+		b := parquet.EncodeStrings(nil, vals)
+		assert.Equal(t, exp.Bytes(), b)
+
+		buf := bytes.NewBuffer(append(b, 1, 2, 3))
+		out, err := parquet.DecodeByteArrays(buf, len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, buf.Bytes())
+		for i, s := range vals {
+			assert.Equal(t, s, string(out[i]))
+		}
+
+		out, err = parquet.DecodeByteArrays(bytes.NewReader(b), len(vals))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(out[2]))
+	})
+
+	t.Run("int96 timestamps", func(t *testing.T) {
+		// the nanoseconds within the day followed by the Julian day
+		exp := []byte{1, 0, 0, 0, 0, 0, 0, 0, 0x59, 0x68, 0x25, 0}
+		exp = append(exp, plainBytes(int64(12*time.Hour))...)
+		exp = append(exp, plainBytes(uint32(2440587))...)
+		vals := []time.Time{
+			time.Date(2000, 1, 1, 0, 0, 0, 1, time.UTC),
+			time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC),
+		}
+
+		b := parquet.EncodeTimes(nil, vals, parquet.Int96, true)
+		assert.Equal(t, exp, b)
+		out, err := parquet.DecodeTimes(nil, bytes.NewBuffer(b), len(vals), parquet.Int96, true)
+		assert.NoError(t, err)
+		assert.Equal(t, vals, out)
+
+		// timestamps that aren't adjusted to UTC keep their wall clock time
+		loc := time.FixedZone("", -5*60*60)
+		b = parquet.EncodeTimes(nil, []time.Time{time.Date(2000, 1, 1, 0, 0, 0, 1, loc)}, parquet.Int96, false)
+		assert.Equal(t, exp[:12], b)
+		out, err = parquet.DecodeTimes(nil, bytes.NewReader(b), 1, parquet.Int96, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{time.Date(2000, 1, 1, 0, 0, 0, 1, time.Local)}, out)
+
+		_, err = parquet.DecodeTimes(nil, bytes.NewBuffer(b), 2, parquet.Int96, true)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := parquet.DecodeInt64s(nil, bytes.NewBuffer(make([]byte, 15)), 2)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+
+		_, err = parquet.DecodeInt32s(nil, bytes.NewReader(make([]byte, 7)), 2)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+
+		_, err = parquet.DecodeInt32s(nil, bytes.NewBuffer(nil), -1)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+
+		_, err = parquet.DecodeByteArrays(bytes.NewBuffer([]byte{5, 0, 0, 0, 'a'}), 1)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+
+		_, err = parquet.DecodeByteArrays(bytes.NewBuffer([]byte{0, 0, 0, 0}), 2)
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage))
+
+		_, err = parquet.GetBools(io.MultiReader(bytes.NewReader([]byte{1}), errReader{}), 8, []int{8})
+		assert.True(t, errors.Is(err, errRead), err)
+
+		_, err = parquet.GetBools(bytes.NewReader([]byte{1}), 8, []int{int(parquet.DefaultLimits.MaxPageValues) + 1})
+		assert.True(t, errors.Is(err, parquet.ErrCorruptPage), err)
+	})
+}
+
+var errRead = errors.New("read failed")
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errRead }
+
+func plainBytes(vals interface{}) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, vals)
+	return buf.Bytes()
+}
+
+func BenchmarkEncodeInt64s(b *testing.B) {
+	vals := make([]int64, 10000)
+	for i := range vals {
+		vals[i] = int64(i)
+	}
+
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = parquet.EncodeInt64s(buf[:0], vals)
+	}
+}
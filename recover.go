@@ -0,0 +1,534 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math/bits"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/parsyl/parquet/internal/rle"
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// ErrAmbiguousPages is returned by Recover when the pages of a file
+// can be split into column chunks in more than one way (which can
+// happen when neighboring columns have the same type and repetition).
+var ErrAmbiguousPages = errors.New("the pages can be split into row groups in more than one way")
+
+// recoveredPage is a page that was found by Recover along with
+// the number of rows it would hold in each of the columns.
+type recoveredPage struct {
+	offset    int64
+	headerLen int
+	header    *sch.PageHeader
+	codec     sch.CompressionCodec
+	// dict is the dictionary page right in front of the page, which
+	// means that the page starts a dictionary encoded column chunk.
+	dict *recoveredDictionary
+	// rows is -1 for columns that the page can't belong to.
+	rows []int
+}
+
+// recoveredDictionary is a dictionary page that was found by Recover.
+type recoveredDictionary struct {
+	offset    int64
+	headerLen int
+	header    *sch.PageHeader
+	codec     sch.CompressionCodec
+	data      []byte
+}
+
+// recoveredRowGroup is the pages [start, end) of the file, each
+// column chunk of which has pages pages.
+type recoveredRowGroup struct {
+	start, end int
+	pages      int
+	rows       int64
+}
+
+// recoveredColumn is what Recover needs to know about a column
+// in order to check that a page could belong to it.
+type recoveredColumn struct {
+	typ    sch.Type
+	maxDef uint8
+	maxRep uint8
+}
+
+// Recover rebuilds the footer of a parquet file that was written by
+// this package but was never closed (or whose footer was cut off).
+// fields is the schema of the file, in the order the writer wrote
+// the columns.  The page headers are read from offset 4 and each
+// page is checked against the columns it could belong to in order
+// to split the pages into column chunks and row groups.  The
+// complete row groups are copied to w followed by a new footer, and
+// the number of rows that were recovered is returned.
+//
+// Pages are grouped the way a ParquetWriter writes them: every
+// page of a column chunk but the last has the same number of rows
+// and every column chunk of a row group has the same number of
+// pages.  If more than one grouping fits, ErrAmbiguousPages is
+// returned rather than guessing.  A dictionary page must be followed
+// by the first page of its column chunk.  Bloom filters are written
+// just before the footer, so the recovered file doesn't have any.
+// Encrypted files can't be recovered.
+func Recover(r io.ReaderAt, size int64, w io.Writer, fields ...Field) (int64, error) {
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unable to recover a file without a schema")
+	}
+
+	if size < 4 {
+		return 0, fmt.Errorf("%w: size %d is too small", ErrNotParquet, size)
+	}
+
+	var head [4]byte
+	if _, err := r.ReadAt(head[:], 0); err != nil {
+		return 0, err
+	}
+
+	switch string(head[:]) {
+	case magic:
+	case magicEncrypted:
+		return 0, fmt.Errorf("unable to recover an encrypted file")
+	default:
+		return 0, fmt.Errorf("%w: invalid magic bytes %q", ErrNotParquet, head[:])
+	}
+
+	cols := make([]recoveredColumn, len(fields))
+	for i, f := range fields {
+		var se sch.SchemaElement
+		if f.Type != nil {
+			f.Type(&se)
+		}
+		if se.Type == nil {
+			return 0, fmt.Errorf("column %s doesn't have a type", f.Name)
+		}
+		rts := getRepetitionTypes(f.Types)
+		cols[i] = recoveredColumn{typ: *se.Type, maxDef: rts.MaxDef(), maxRep: rts.MaxRep()}
+	}
+
+	pages := scanPages(r, size, cols)
+	rgs, err := splitRowGroups(pages, len(cols))
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := w.Write(Magic(nil)); err != nil {
+		return 0, err
+	}
+
+	m := New(fields...)
+	for _, rg := range rgs {
+		// the writer leaves empty row groups out of the footer
+		if rg.rows == 0 {
+			continue
+		}
+
+		m.docs += rg.rows
+		m.rowGroupDocs = rg.rows
+		for i, f := range fields {
+			start := rg.start + i*rg.pages
+			if d := pages[start].dict; d != nil {
+				if err := m.recoverDictionary(f.Path, d, pages[start:start+rg.pages]); err != nil {
+					return 0, err
+				}
+			}
+
+			for _, pg := range pages[start : start+rg.pages] {
+				ph := pg.header
+				if err := m.updateRowGroup(f.Path, int(ph.UncompressedPageSize), int(ph.CompressedPageSize), pg.headerLen, int(ph.DataPageHeader.NumValues), pg.codec); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		start := pages[rg.start].offset
+		if d := pages[rg.start].dict; d != nil {
+			start = d.offset
+		}
+		last := pages[rg.end-1]
+		end := last.offset + int64(last.headerLen) + int64(last.header.CompressedPageSize)
+		if _, err := io.Copy(w, io.NewSectionReader(r, start, end-start)); err != nil {
+			return 0, err
+		}
+		m.StartRowGroup(fields...)
+	}
+
+	if err := m.Footer(w); err != nil {
+		return 0, err
+	}
+
+	_, err = w.Write(Magic(nil))
+	return m.docs, err
+}
+
+// recoverDictionary adds the dictionary page d of the column at pth,
+// whose pages are pages, to the current row group.
+func (m *Metadata) recoverDictionary(pth []string, d *recoveredDictionary, pages []recoveredPage) error {
+	ph := d.header
+	if err := m.updateRowGroup(pth, int(ph.UncompressedPageSize), int(ph.CompressedPageSize), d.headerLen, 0, d.codec); err != nil {
+		return err
+	}
+
+	col := strings.Join(pth, ".")
+	rg := m.rowGroups[len(m.rowGroups)-1]
+	md := rg.columns[col].MetaData
+	md.Encodings = []sch.Encoding{sch.Encoding_PLAIN_DICTIONARY}
+	for _, pg := range pages {
+		if pg.header.DataPageHeader.Encoding == sch.Encoding_PLAIN {
+			md.Encodings = append(md.Encodings, sch.Encoding_PLAIN)
+			break
+		}
+	}
+
+	rg.dictionaries[col] = &dictionary{pageLen: int64(d.headerLen) + int64(ph.CompressedPageSize)}
+	return nil
+}
+
+// scanPages reads page headers from offset 4 until it finds
+// something that isn't a page that could belong to one of cols.
+func scanPages(r io.ReaderAt, size int64, cols []recoveredColumn) []recoveredPage {
+	var out []recoveredPage
+	var levels []uint32
+	// dict is a dictionary page that hasn't been followed by a data
+	// page yet and last is the dictionary of the latest column chunk
+	// that had one, which dictionary encoded pages must belong to.
+	var dict, last *recoveredDictionary
+	pos := int64(4)
+	for pos < size {
+		rc := &readCounter{r: io.NewSectionReader(r, pos, size-pos)}
+		ph, err := readPageHeader(rc, DefaultLimits)
+		if err != nil || int64(ph.CompressedPageSize) > size-pos-rc.n {
+			break
+		}
+
+		isDict := recoverableDictionary(ph)
+		if !isDict && !recoverablePage(ph) {
+			break
+		}
+
+		buf := make([]byte, ph.CompressedPageSize)
+		if _, err := r.ReadAt(buf, pos+rc.n); err != nil {
+			break
+		}
+
+		if ph.IsSetCrc() && crc32.ChecksumIEEE(buf) != uint32(*ph.Crc) {
+			break
+		}
+
+		data, codec, ok := recoverPageData(buf, ph)
+		if !ok {
+			break
+		}
+
+		if isDict {
+			// two dictionary pages in a row can't both be right
+			if dict != nil {
+				break
+			}
+
+			dict = &recoveredDictionary{offset: pos, headerLen: int(rc.n), header: ph, codec: codec, data: data}
+			pos += rc.n + int64(ph.CompressedPageSize)
+			continue
+		}
+
+		if dict != nil {
+			if dict.codec != codec {
+				break
+			}
+			last = dict
+		}
+
+		pg := recoveredPage{
+			offset:    pos,
+			headerLen: int(rc.n),
+			header:    ph,
+			codec:     codec,
+			dict:      dict,
+			rows:      make([]int, len(cols)),
+		}
+
+		var found bool
+		for i, col := range cols {
+			pg.rows[i], levels = col.rows(data, pg, last, levels)
+			found = found || pg.rows[i] >= 0
+		}
+
+		if !found {
+			break
+		}
+
+		out = append(out, pg)
+		dict = nil
+		pos += rc.n + int64(ph.CompressedPageSize)
+	}
+	return out
+}
+
+// recoverablePage checks that ph describes a page
+// that could have been written by this package.
+func recoverablePage(ph *sch.PageHeader) bool {
+	dph := ph.DataPageHeader
+	return ph.Type == sch.PageType_DATA_PAGE && dph != nil &&
+		(dph.Encoding == sch.Encoding_PLAIN || dph.Encoding == sch.Encoding_PLAIN_DICTIONARY) &&
+		dph.DefinitionLevelEncoding == sch.Encoding_RLE &&
+		dph.RepetitionLevelEncoding == sch.Encoding_RLE &&
+		ph.CompressedPageSize >= 0 && ph.UncompressedPageSize >= 0 && dph.NumValues >= 0
+}
+
+// recoverableDictionary checks that ph describes a
+// dictionary page that could have been written by this package.
+func recoverableDictionary(ph *sch.PageHeader) bool {
+	dph := ph.DictionaryPageHeader
+	return ph.Type == sch.PageType_DICTIONARY_PAGE && dph != nil &&
+		dph.Encoding == sch.Encoding_PLAIN_DICTIONARY &&
+		ph.CompressedPageSize >= 0 && ph.UncompressedPageSize >= 0 && dph.NumValues >= 0
+}
+
+// recoverPageData works out the codec of a page (the page
+// header doesn't have it) and returns the uncompressed data.
+func recoverPageData(buf []byte, ph *sch.PageHeader) ([]byte, sch.CompressionCodec, bool) {
+	if n, err := snappy.DecodedLen(buf); err == nil && n == int(ph.UncompressedPageSize) {
+		if data, err := snappy.Decode(nil, buf); err == nil {
+			return data, sch.CompressionCodec_SNAPPY, true
+		}
+	}
+
+	if data, err := unzstd(buf, ph.UncompressedPageSize); err == nil {
+		return data, sch.CompressionCodec_ZSTD, true
+	}
+
+	if ph.CompressedPageSize == ph.UncompressedPageSize {
+		return buf, sch.CompressionCodec_UNCOMPRESSED, true
+	}
+	return nil, 0, false
+}
+
+// rows returns the number of rows in a page if the page could
+// belong to the column, otherwise -1.  dict is the dictionary that
+// the page's indices refer to if it is dictionary encoded.  levels
+// is scratch space that is returned so it can be reused.
+func (c recoveredColumn) rows(data []byte, pg recoveredPage, dict *recoveredDictionary, levels []uint32) (int, []uint32) {
+	ph := pg.header
+	if pg.dict != nil {
+		if _, err := splitValues(pg.dict.data, int(pg.dict.header.DictionaryPageHeader.NumValues), c.typ); err != nil {
+			return -1, levels
+		}
+	}
+
+	count := int(ph.DataPageHeader.NumValues)
+	rows, vals := count, count
+	if c.maxDef > 0 || c.maxRep > 0 {
+		var l int
+		var ok bool
+		levels, l, ok = recoverLevels(levels, data, count, c.maxDef)
+		if !ok {
+			return -1, levels
+		}
+		data = data[l:]
+
+		vals = 0
+		for _, lvl := range levels[:count] {
+			if lvl == uint32(c.maxDef) {
+				vals++
+			}
+		}
+
+		if c.maxRep > 0 {
+			levels, l, ok = recoverLevels(levels, data, count, c.maxRep)
+			if !ok || (count > 0 && levels[0] != 0) {
+				return -1, levels
+			}
+			data = data[l:]
+
+			rows = 0
+			for _, lvl := range levels[:count] {
+				if lvl == 0 {
+					rows++
+				}
+			}
+		}
+	}
+
+	if ph.DataPageHeader.Encoding == sch.Encoding_PLAIN_DICTIONARY {
+		if dict == nil {
+			return -1, levels
+		}
+
+		var ok bool
+		levels, ok = recoverIndices(levels, data, vals, int(dict.header.DictionaryPageHeader.NumValues))
+		if !ok {
+			return -1, levels
+		}
+		return rows, levels
+	}
+
+	var ok bool
+	switch c.typ {
+	case sch.Type_BOOLEAN:
+		ok = len(data) == (vals+7)/8
+	case sch.Type_INT32, sch.Type_FLOAT:
+		ok = len(data) == 4*vals
+	case sch.Type_INT64, sch.Type_DOUBLE:
+		ok = len(data) == 8*vals
+	case sch.Type_INT96:
+		ok = len(data) == 12*vals
+	case sch.Type_BYTE_ARRAY:
+		out, err := decodeByteArrays(data, vals)
+		ok = err == nil
+		for _, v := range out {
+			data = data[4+len(v):]
+		}
+		ok = ok && len(data) == 0
+	}
+
+	if !ok {
+		return -1, levels
+	}
+	return rows, levels
+}
+
+// recoverLevels decodes the levels at the start of data and checks
+// that there are at least count of them and that none are above max.
+func recoverLevels(dst []uint32, data []byte, count int, max uint8) ([]uint32, int, bool) {
+	dec, err := rle.NewDecoder(int32(bits.Len(uint(max))))
+	if err != nil {
+		return dst, 0, false
+	}
+
+	// the last bit-packed run can be padded with up to 7 values
+	dec.Limit(int(DefaultLimits.MaxPageSize), count+7)
+	dst, l, err := dec.Read(dst[:0], data)
+	if err != nil || len(dst) < count {
+		return dst, 0, false
+	}
+
+	for _, lvl := range dst[:count] {
+		if lvl > uint32(max) {
+			return dst, 0, false
+		}
+	}
+	return dst, l, true
+}
+
+// recoverIndices decodes the dictionary indices in data (a bit
+// width followed by RLE/bit-packed runs) and checks that there
+// are at least count of them and that they are all less than n.
+func recoverIndices(dst []uint32, data []byte, count, n int) ([]uint32, bool) {
+	if len(data) == 0 {
+		return dst, false
+	}
+
+	dec, err := rle.NewDecoder(int32(data[0]))
+	if err != nil {
+		return dst, false
+	}
+
+	dec.Limit(int(DefaultLimits.MaxPageSize), count+7)
+	dst, err = dec.Decode(dst[:0], data[1:])
+	if err != nil || len(dst) < count {
+		return dst, false
+	}
+
+	for _, id := range dst[:count] {
+		if int(id) >= n {
+			return dst, false
+		}
+	}
+	return dst, true
+}
+
+// splitRowGroups finds the row groups that cover as many of the pages
+// as possible.  ways[i] counts (up to 2) the ways that pages[:i] can be
+// split into row groups and last[i] is the final row group of one of them.
+func splitRowGroups(pages []recoveredPage, n int) ([]recoveredRowGroup, error) {
+	ways := make([]int, len(pages)+1)
+	last := make([]recoveredRowGroup, len(pages)+1)
+	ways[0] = 1
+	for i := range pages {
+		if ways[i] == 0 {
+			continue
+		}
+
+		for _, rg := range rowGroupsAt(pages, i, n) {
+			if ways[rg.end] == 0 {
+				last[rg.end] = rg
+			}
+			ways[rg.end] += ways[i]
+			if ways[rg.end] > 2 {
+				ways[rg.end] = 2
+			}
+		}
+	}
+
+	end := len(pages)
+	for ways[end] == 0 {
+		end--
+	}
+
+	if ways[end] > 1 {
+		return nil, ErrAmbiguousPages
+	}
+
+	var out []recoveredRowGroup
+	for end > 0 {
+		rg := last[end]
+		out = append([]recoveredRowGroup{rg}, out...)
+		end = rg.start
+	}
+	return out, nil
+}
+
+// rowGroupsAt returns every complete row group that could start
+// at pages[i].  The first column chunk determines the number of
+// pages, and the number of rows in each of them, that all of the
+// other column chunks must have.
+func rowGroupsAt(pages []recoveredPage, i, n int) []recoveredRowGroup {
+	var out []recoveredRowGroup
+	full := pages[i].rows[0]
+	var rows int64
+	for k := 1; i+k*n <= len(pages); k++ {
+		pg := pages[i+k-1]
+		if pg.rows[0] < 0 || pg.codec != pages[i].codec || !inChunk(pages[i], pg, k-1) {
+			break
+		}
+
+		// only the last page of a column chunk can be short
+		if k > 1 && (full == 0 || pages[i+k-2].rows[0] != full || pg.rows[0] > full) {
+			break
+		}
+		rows += int64(pg.rows[0])
+
+		if chunksMatch(pages, i, k, n) {
+			out = append(out, recoveredRowGroup{start: i, end: i + k*n, pages: k, rows: rows})
+		}
+	}
+	return out
+}
+
+// chunksMatch checks that the k pages of each of the columns after the
+// first have the same number of rows as the first column's pages.
+func chunksMatch(pages []recoveredPage, i, k, n int) bool {
+	for c := 1; c < n; c++ {
+		first := pages[i+c*k]
+		for j := 0; j < k; j++ {
+			pg := pages[i+c*k+j]
+			if pg.rows[c] < 0 || pg.rows[c] != pages[i+j].rows[0] || pg.codec != first.codec || !inChunk(first, pg, j) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// inChunk checks that pg can be page j of the column chunk that starts
+// with first.  Only the first page can have a dictionary page in front
+// of it and dictionary encoded pages need one at the start of the chunk.
+func inChunk(first, pg recoveredPage, j int) bool {
+	if j > 0 && pg.dict != nil {
+		return false
+	}
+	return first.dict != nil || pg.header.DataPageHeader.Encoding != sch.Encoding_PLAIN_DICTIONARY
+}
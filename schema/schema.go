@@ -520,11 +520,10 @@ func (p *CompressionCodec) Value() (driver.Value, error) {
 type PageType int64
 
 const (
-	PageType_DATA_PAGE         PageType = 0
-	PageType_INDEX_PAGE        PageType = 1
-	PageType_DICTIONARY_PAGE   PageType = 2
-	PageType_DATA_PAGE_V2      PageType = 3
-	PageType_BLOOM_FILTER_PAGE PageType = 4
+	PageType_DATA_PAGE       PageType = 0
+	PageType_INDEX_PAGE      PageType = 1
+	PageType_DICTIONARY_PAGE PageType = 2
+	PageType_DATA_PAGE_V2    PageType = 3
 )
 
 func (p PageType) String() string {
@@ -537,8 +536,6 @@ func (p PageType) String() string {
 		return "DICTIONARY_PAGE"
 	case PageType_DATA_PAGE_V2:
 		return "DATA_PAGE_V2"
-	case PageType_BLOOM_FILTER_PAGE:
-		return "BLOOM_FILTER_PAGE"
 	}
 	return "<UNSET>"
 }
@@ -553,8 +550,6 @@ func PageTypeFromString(s string) (PageType, error) {
 		return PageType_DICTIONARY_PAGE, nil
 	case "DATA_PAGE_V2":
 		return PageType_DATA_PAGE_V2, nil
-	case "BLOOM_FILTER_PAGE":
-		return PageType_BLOOM_FILTER_PAGE, nil
 	}
 	return PageType(0), fmt.Errorf("not a valid PageType string")
 }
@@ -678,13 +673,17 @@ func (p *BoundaryOrder) Value() (driver.Value, error) {
 // Values are encoded using PLAIN encoding, except that variable-length byte
 // arrays do not include a length prefix.
 //  - MinValue
+//  - IsMaxValueExact: If true, max_value is the actual maximum value for a column
+//  - IsMinValueExact: If true, min_value is the actual minimum value for a column
 type Statistics struct {
-	Max           []byte `thrift:"max,1" db:"max" json:"max,omitempty"`
-	Min           []byte `thrift:"min,2" db:"min" json:"min,omitempty"`
-	NullCount     *int64 `thrift:"null_count,3" db:"null_count" json:"null_count,omitempty"`
-	DistinctCount *int64 `thrift:"distinct_count,4" db:"distinct_count" json:"distinct_count,omitempty"`
-	MaxValue      []byte `thrift:"max_value,5" db:"max_value" json:"max_value,omitempty"`
-	MinValue      []byte `thrift:"min_value,6" db:"min_value" json:"min_value,omitempty"`
+	Max             []byte `thrift:"max,1" db:"max" json:"max,omitempty"`
+	Min             []byte `thrift:"min,2" db:"min" json:"min,omitempty"`
+	NullCount       *int64 `thrift:"null_count,3" db:"null_count" json:"null_count,omitempty"`
+	DistinctCount   *int64 `thrift:"distinct_count,4" db:"distinct_count" json:"distinct_count,omitempty"`
+	MaxValue        []byte `thrift:"max_value,5" db:"max_value" json:"max_value,omitempty"`
+	MinValue        []byte `thrift:"min_value,6" db:"min_value" json:"min_value,omitempty"`
+	IsMaxValueExact *bool  `thrift:"is_max_value_exact,7" db:"is_max_value_exact" json:"is_max_value_exact,omitempty"`
+	IsMinValueExact *bool  `thrift:"is_min_value_exact,8" db:"is_min_value_exact" json:"is_min_value_exact,omitempty"`
 }
 
 func NewStatistics() *Statistics {
@@ -732,6 +731,24 @@ var Statistics_MinValue_DEFAULT []byte
 func (p *Statistics) GetMinValue() []byte {
 	return p.MinValue
 }
+
+var Statistics_IsMaxValueExact_DEFAULT bool
+
+func (p *Statistics) GetIsMaxValueExact() bool {
+	if !p.IsSetIsMaxValueExact() {
+		return Statistics_IsMaxValueExact_DEFAULT
+	}
+	return *p.IsMaxValueExact
+}
+
+var Statistics_IsMinValueExact_DEFAULT bool
+
+func (p *Statistics) GetIsMinValueExact() bool {
+	if !p.IsSetIsMinValueExact() {
+		return Statistics_IsMinValueExact_DEFAULT
+	}
+	return *p.IsMinValueExact
+}
 func (p *Statistics) IsSetMax() bool {
 	return p.Max != nil
 }
@@ -756,6 +773,14 @@ func (p *Statistics) IsSetMinValue() bool {
 	return p.MinValue != nil
 }
 
+func (p *Statistics) IsSetIsMaxValueExact() bool {
+	return p.IsMaxValueExact != nil
+}
+
+func (p *Statistics) IsSetIsMinValueExact() bool {
+	return p.IsMinValueExact != nil
+}
+
 func (p *Statistics) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -830,6 +855,26 @@ func (p *Statistics) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField7(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField8(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -899,6 +944,24 @@ func (p *Statistics) ReadField6(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *Statistics) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.IsMaxValueExact = &v
+	}
+	return nil
+}
+
+func (p *Statistics) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.IsMinValueExact = &v
+	}
+	return nil
+}
+
 func (p *Statistics) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("Statistics"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -922,6 +985,12 @@ func (p *Statistics) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField6(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1022,6 +1091,36 @@ func (p *Statistics) writeField6(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *Statistics) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsMaxValueExact() {
+		if err := oprot.WriteFieldBegin("is_max_value_exact", thrift.BOOL, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:is_max_value_exact: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IsMaxValueExact)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.is_max_value_exact (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:is_max_value_exact: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Statistics) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsMinValueExact() {
+		if err := oprot.WriteFieldBegin("is_min_value_exact", thrift.BOOL, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:is_min_value_exact: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IsMinValueExact)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.is_min_value_exact (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:is_min_value_exact: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *Statistics) String() string {
 	if p == nil {
 		return "<nil>"
@@ -5130,17 +5229,17 @@ func (p *BloomFilterAlgorithm) String() string {
 	return fmt.Sprintf("BloomFilterAlgorithm(%+v)", *p)
 }
 
-// Hash strategy type annotation. It uses Murmur3Hash_x64_128 from the original SMHasher
-// repo by Austin Appleby.
+// Hash strategy type annotation. xxHash is an extremely fast non-cryptographic hash
+// algorithm. It uses 64 bits version of xxHash.
 //
-type Murmur3 struct {
+type XxHash struct {
 }
 
-func NewMurmur3() *Murmur3 {
-	return &Murmur3{}
+func NewXxHash() *XxHash {
+	return &XxHash{}
 }
 
-func (p *Murmur3) Read(iprot thrift.TProtocol) error {
+func (p *XxHash) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5166,8 +5265,8 @@ func (p *Murmur3) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Murmur3) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("Murmur3"); err != nil {
+func (p *XxHash) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("XxHash"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5181,11 +5280,11 @@ func (p *Murmur3) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Murmur3) String() string {
+func (p *XxHash) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Murmur3(%+v)", *p)
+	return fmt.Sprintf("XxHash(%+v)", *p)
 }
 
 // The hash function used in Bloom filter. This function takes the hash of a column value
@@ -5193,34 +5292,34 @@ func (p *Murmur3) String() string {
 //
 //
 // Attributes:
-//  - MURMUR3: Murmur3 Hash Strategy. *
+//  - XXHASH: xxHash Strategy. *
 type BloomFilterHash struct {
-	MURMUR3 *Murmur3 `thrift:"MURMUR3,1" db:"MURMUR3" json:"MURMUR3,omitempty"`
+	XXHASH *XxHash `thrift:"XXHASH,1" db:"XXHASH" json:"XXHASH,omitempty"`
 }
 
 func NewBloomFilterHash() *BloomFilterHash {
 	return &BloomFilterHash{}
 }
 
-var BloomFilterHash_MURMUR3_DEFAULT *Murmur3
+var BloomFilterHash_XXHASH_DEFAULT *XxHash
 
-func (p *BloomFilterHash) GetMURMUR3() *Murmur3 {
-	if !p.IsSetMURMUR3() {
-		return BloomFilterHash_MURMUR3_DEFAULT
+func (p *BloomFilterHash) GetXXHASH() *XxHash {
+	if !p.IsSetXXHASH() {
+		return BloomFilterHash_XXHASH_DEFAULT
 	}
-	return p.MURMUR3
+	return p.XXHASH
 }
 func (p *BloomFilterHash) CountSetFieldsBloomFilterHash() int {
 	count := 0
-	if p.IsSetMURMUR3() {
+	if p.IsSetXXHASH() {
 		count++
 	}
 	return count
 
 }
 
-func (p *BloomFilterHash) IsSetMURMUR3() bool {
-	return p.MURMUR3 != nil
+func (p *BloomFilterHash) IsSetXXHASH() bool {
+	return p.XXHASH != nil
 }
 
 func (p *BloomFilterHash) Read(iprot thrift.TProtocol) error {
@@ -5263,9 +5362,9 @@ func (p *BloomFilterHash) Read(iprot thrift.TProtocol) error {
 }
 
 func (p *BloomFilterHash) ReadField1(iprot thrift.TProtocol) error {
-	p.MURMUR3 = &Murmur3{}
-	if err := p.MURMUR3.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MURMUR3), err)
+	p.XXHASH = &XxHash{}
+	if err := p.XXHASH.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.XXHASH), err)
 	}
 	return nil
 }
@@ -5292,15 +5391,15 @@ func (p *BloomFilterHash) Write(oprot thrift.TProtocol) error {
 }
 
 func (p *BloomFilterHash) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMURMUR3() {
-		if err := oprot.WriteFieldBegin("MURMUR3", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:MURMUR3: ", p), err)
+	if p.IsSetXXHASH() {
+		if err := oprot.WriteFieldBegin("XXHASH", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:XXHASH: ", p), err)
 		}
-		if err := p.MURMUR3.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MURMUR3), err)
+		if err := p.XXHASH.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.XXHASH), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:MURMUR3: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:XXHASH: ", p), err)
 		}
 	}
 	return err
@@ -5313,6 +5412,184 @@ func (p *BloomFilterHash) String() string {
 	return fmt.Sprintf("BloomFilterHash(%+v)", *p)
 }
 
+// The compression used in the Bloom filter.
+//
+type Uncompressed struct {
+}
+
+func NewUncompressed() *Uncompressed {
+	return &Uncompressed{}
+}
+
+func (p *Uncompressed) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Uncompressed) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Uncompressed"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Uncompressed) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Uncompressed(%+v)", *p)
+}
+
+// Attributes:
+//  - UNCOMPRESSED
+type BloomFilterCompression struct {
+	UNCOMPRESSED *Uncompressed `thrift:"UNCOMPRESSED,1" db:"UNCOMPRESSED" json:"UNCOMPRESSED,omitempty"`
+}
+
+func NewBloomFilterCompression() *BloomFilterCompression {
+	return &BloomFilterCompression{}
+}
+
+var BloomFilterCompression_UNCOMPRESSED_DEFAULT *Uncompressed
+
+func (p *BloomFilterCompression) GetUNCOMPRESSED() *Uncompressed {
+	if !p.IsSetUNCOMPRESSED() {
+		return BloomFilterCompression_UNCOMPRESSED_DEFAULT
+	}
+	return p.UNCOMPRESSED
+}
+func (p *BloomFilterCompression) CountSetFieldsBloomFilterCompression() int {
+	count := 0
+	if p.IsSetUNCOMPRESSED() {
+		count++
+	}
+	return count
+
+}
+
+func (p *BloomFilterCompression) IsSetUNCOMPRESSED() bool {
+	return p.UNCOMPRESSED != nil
+}
+
+func (p *BloomFilterCompression) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) ReadField1(iprot thrift.TProtocol) error {
+	p.UNCOMPRESSED = &Uncompressed{}
+	if err := p.UNCOMPRESSED.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UNCOMPRESSED), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) Write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterCompression(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("BloomFilterCompression"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUNCOMPRESSED() {
+		if err := oprot.WriteFieldBegin("UNCOMPRESSED", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:UNCOMPRESSED: ", p), err)
+		}
+		if err := p.UNCOMPRESSED.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UNCOMPRESSED), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:UNCOMPRESSED: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BloomFilterCompression) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BloomFilterCompression(%+v)", *p)
+}
+
 // Bloom filter header is stored at beginning of Bloom filter data of each column
 // and followed by its bitset.
 //
@@ -5321,46 +5598,61 @@ func (p *BloomFilterHash) String() string {
 //  - NumBytes: The size of bitset in bytes *
 //  - Algorithm: The algorithm for setting bits. *
 //  - Hash: The hash function used for Bloom filter. *
-type BloomFilterPageHeader struct {
-	NumBytes  int32                 `thrift:"numBytes,1,required" db:"numBytes" json:"numBytes"`
-	Algorithm *BloomFilterAlgorithm `thrift:"algorithm,2,required" db:"algorithm" json:"algorithm"`
-	Hash      *BloomFilterHash      `thrift:"hash,3,required" db:"hash" json:"hash"`
+//  - Compression: The compression used in the Bloom filter *
+type BloomFilterHeader struct {
+	NumBytes    int32                   `thrift:"numBytes,1,required" db:"numBytes" json:"numBytes"`
+	Algorithm   *BloomFilterAlgorithm   `thrift:"algorithm,2,required" db:"algorithm" json:"algorithm"`
+	Hash        *BloomFilterHash        `thrift:"hash,3,required" db:"hash" json:"hash"`
+	Compression *BloomFilterCompression `thrift:"compression,4,required" db:"compression" json:"compression"`
 }
 
-func NewBloomFilterPageHeader() *BloomFilterPageHeader {
-	return &BloomFilterPageHeader{}
+func NewBloomFilterHeader() *BloomFilterHeader {
+	return &BloomFilterHeader{}
 }
 
-func (p *BloomFilterPageHeader) GetNumBytes() int32 {
+func (p *BloomFilterHeader) GetNumBytes() int32 {
 	return p.NumBytes
 }
 
-var BloomFilterPageHeader_Algorithm_DEFAULT *BloomFilterAlgorithm
+var BloomFilterHeader_Algorithm_DEFAULT *BloomFilterAlgorithm
 
-func (p *BloomFilterPageHeader) GetAlgorithm() *BloomFilterAlgorithm {
+func (p *BloomFilterHeader) GetAlgorithm() *BloomFilterAlgorithm {
 	if !p.IsSetAlgorithm() {
-		return BloomFilterPageHeader_Algorithm_DEFAULT
+		return BloomFilterHeader_Algorithm_DEFAULT
 	}
 	return p.Algorithm
 }
 
-var BloomFilterPageHeader_Hash_DEFAULT *BloomFilterHash
+var BloomFilterHeader_Hash_DEFAULT *BloomFilterHash
 
-func (p *BloomFilterPageHeader) GetHash() *BloomFilterHash {
+func (p *BloomFilterHeader) GetHash() *BloomFilterHash {
 	if !p.IsSetHash() {
-		return BloomFilterPageHeader_Hash_DEFAULT
+		return BloomFilterHeader_Hash_DEFAULT
 	}
 	return p.Hash
 }
-func (p *BloomFilterPageHeader) IsSetAlgorithm() bool {
+
+var BloomFilterHeader_Compression_DEFAULT *BloomFilterCompression
+
+func (p *BloomFilterHeader) GetCompression() *BloomFilterCompression {
+	if !p.IsSetCompression() {
+		return BloomFilterHeader_Compression_DEFAULT
+	}
+	return p.Compression
+}
+func (p *BloomFilterHeader) IsSetAlgorithm() bool {
 	return p.Algorithm != nil
 }
 
-func (p *BloomFilterPageHeader) IsSetHash() bool {
+func (p *BloomFilterHeader) IsSetHash() bool {
 	return p.Hash != nil
 }
 
-func (p *BloomFilterPageHeader) Read(iprot thrift.TProtocol) error {
+func (p *BloomFilterHeader) IsSetCompression() bool {
+	return p.Compression != nil
+}
+
+func (p *BloomFilterHeader) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5368,6 +5660,7 @@ func (p *BloomFilterPageHeader) Read(iprot thrift.TProtocol) error {
 	var issetNumBytes bool = false
 	var issetAlgorithm bool = false
 	var issetHash bool = false
+	var issetCompression bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -5411,6 +5704,17 @@ func (p *BloomFilterPageHeader) Read(iprot thrift.TProtocol) error {
 				}
 			}
 			issetHash = true
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetCompression = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5432,10 +5736,13 @@ func (p *BloomFilterPageHeader) Read(iprot thrift.TProtocol) error {
 	if !issetHash {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Hash is not set"))
 	}
+	if !issetCompression {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Compression is not set"))
+	}
 	return nil
 }
 
-func (p *BloomFilterPageHeader) ReadField1(iprot thrift.TProtocol) error {
+func (p *BloomFilterHeader) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -5444,7 +5751,7 @@ func (p *BloomFilterPageHeader) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *BloomFilterPageHeader) ReadField2(iprot thrift.TProtocol) error {
+func (p *BloomFilterHeader) ReadField2(iprot thrift.TProtocol) error {
 	p.Algorithm = &BloomFilterAlgorithm{}
 	if err := p.Algorithm.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Algorithm), err)
@@ -5452,7 +5759,7 @@ func (p *BloomFilterPageHeader) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *BloomFilterPageHeader) ReadField3(iprot thrift.TProtocol) error {
+func (p *BloomFilterHeader) ReadField3(iprot thrift.TProtocol) error {
 	p.Hash = &BloomFilterHash{}
 	if err := p.Hash.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hash), err)
@@ -5460,8 +5767,16 @@ func (p *BloomFilterPageHeader) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *BloomFilterPageHeader) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("BloomFilterPageHeader"); err != nil {
+func (p *BloomFilterHeader) ReadField4(iprot thrift.TProtocol) error {
+	p.Compression = &BloomFilterCompression{}
+	if err := p.Compression.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Compression), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("BloomFilterHeader"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5474,6 +5789,9 @@ func (p *BloomFilterPageHeader) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5484,7 +5802,7 @@ func (p *BloomFilterPageHeader) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *BloomFilterPageHeader) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BloomFilterHeader) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("numBytes", thrift.I32, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numBytes: ", p), err)
 	}
@@ -5497,7 +5815,7 @@ func (p *BloomFilterPageHeader) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *BloomFilterPageHeader) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *BloomFilterHeader) writeField2(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("algorithm", thrift.STRUCT, 2); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:algorithm: ", p), err)
 	}
@@ -5510,7 +5828,7 @@ func (p *BloomFilterPageHeader) writeField2(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *BloomFilterPageHeader) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *BloomFilterHeader) writeField3(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("hash", thrift.STRUCT, 3); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:hash: ", p), err)
 	}
@@ -5523,11 +5841,24 @@ func (p *BloomFilterPageHeader) writeField3(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *BloomFilterPageHeader) String() string {
+func (p *BloomFilterHeader) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("compression", thrift.STRUCT, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:compression: ", p), err)
+	}
+	if err := p.Compression.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Compression), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:compression: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BloomFilterPageHeader(%+v)", *p)
+	return fmt.Sprintf("BloomFilterHeader(%+v)", *p)
 }
 
 // Attributes:
@@ -5541,17 +5872,15 @@ func (p *BloomFilterPageHeader) String() string {
 //  - IndexPageHeader
 //  - DictionaryPageHeader
 //  - DataPageHeaderV2
-//  - BloomFilterPageHeader
 type PageHeader struct {
-	Type                  PageType               `thrift:"type,1,required" db:"type" json:"type"`
-	UncompressedPageSize  int32                  `thrift:"uncompressed_page_size,2,required" db:"uncompressed_page_size" json:"uncompressed_page_size"`
-	CompressedPageSize    int32                  `thrift:"compressed_page_size,3,required" db:"compressed_page_size" json:"compressed_page_size"`
-	Crc                   *int32                 `thrift:"crc,4" db:"crc" json:"crc,omitempty"`
-	DataPageHeader        *DataPageHeader        `thrift:"data_page_header,5" db:"data_page_header" json:"data_page_header,omitempty"`
-	IndexPageHeader       *IndexPageHeader       `thrift:"index_page_header,6" db:"index_page_header" json:"index_page_header,omitempty"`
-	DictionaryPageHeader  *DictionaryPageHeader  `thrift:"dictionary_page_header,7" db:"dictionary_page_header" json:"dictionary_page_header,omitempty"`
-	DataPageHeaderV2      *DataPageHeaderV2      `thrift:"data_page_header_v2,8" db:"data_page_header_v2" json:"data_page_header_v2,omitempty"`
-	BloomFilterPageHeader *BloomFilterPageHeader `thrift:"bloom_filter_page_header,9" db:"bloom_filter_page_header" json:"bloom_filter_page_header,omitempty"`
+	Type                 PageType              `thrift:"type,1,required" db:"type" json:"type"`
+	UncompressedPageSize int32                 `thrift:"uncompressed_page_size,2,required" db:"uncompressed_page_size" json:"uncompressed_page_size"`
+	CompressedPageSize   int32                 `thrift:"compressed_page_size,3,required" db:"compressed_page_size" json:"compressed_page_size"`
+	Crc                  *int32                `thrift:"crc,4" db:"crc" json:"crc,omitempty"`
+	DataPageHeader       *DataPageHeader       `thrift:"data_page_header,5" db:"data_page_header" json:"data_page_header,omitempty"`
+	IndexPageHeader      *IndexPageHeader      `thrift:"index_page_header,6" db:"index_page_header" json:"index_page_header,omitempty"`
+	DictionaryPageHeader *DictionaryPageHeader `thrift:"dictionary_page_header,7" db:"dictionary_page_header" json:"dictionary_page_header,omitempty"`
+	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"data_page_header_v2,8" db:"data_page_header_v2" json:"data_page_header_v2,omitempty"`
 }
 
 func NewPageHeader() *PageHeader {
@@ -5615,14 +5944,6 @@ func (p *PageHeader) GetDataPageHeaderV2() *DataPageHeaderV2 {
 	return p.DataPageHeaderV2
 }
 
-var PageHeader_BloomFilterPageHeader_DEFAULT *BloomFilterPageHeader
-
-func (p *PageHeader) GetBloomFilterPageHeader() *BloomFilterPageHeader {
-	if !p.IsSetBloomFilterPageHeader() {
-		return PageHeader_BloomFilterPageHeader_DEFAULT
-	}
-	return p.BloomFilterPageHeader
-}
 func (p *PageHeader) IsSetCrc() bool {
 	return p.Crc != nil
 }
@@ -5643,10 +5964,6 @@ func (p *PageHeader) IsSetDataPageHeaderV2() bool {
 	return p.DataPageHeaderV2 != nil
 }
 
-func (p *PageHeader) IsSetBloomFilterPageHeader() bool {
-	return p.BloomFilterPageHeader != nil
-}
-
 func (p *PageHeader) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -5748,16 +6065,6 @@ func (p *PageHeader) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-		case 9:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField9(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5853,14 +6160,6 @@ func (p *PageHeader) ReadField8(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PageHeader) ReadField9(iprot thrift.TProtocol) error {
-	p.BloomFilterPageHeader = &BloomFilterPageHeader{}
-	if err := p.BloomFilterPageHeader.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BloomFilterPageHeader), err)
-	}
-	return nil
-}
-
 func (p *PageHeader) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("PageHeader"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -5890,9 +6189,6 @@ func (p *PageHeader) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField8(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField9(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6017,21 +6313,6 @@ func (p *PageHeader) writeField8(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *PageHeader) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBloomFilterPageHeader() {
-		if err := oprot.WriteFieldBegin("bloom_filter_page_header", thrift.STRUCT, 9); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:bloom_filter_page_header: ", p), err)
-		}
-		if err := p.BloomFilterPageHeader.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BloomFilterPageHeader), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:bloom_filter_page_header: ", p), err)
-		}
-	}
-	return err
-}
-
 func (p *PageHeader) String() string {
 	if p == nil {
 		return "<nil>"
@@ -7419,120 +7700,92 @@ func (p *ColumnMetaData) String() string {
 	return fmt.Sprintf("ColumnMetaData(%+v)", *p)
 }
 
-// Attributes:
-//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
-// metadata.  This path is relative to the current file.
-//
-//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
-//  - MetaData: Column metadata for this chunk. This is the same content as what is at
-// file_path/file_offset.  Having it here has it replicated in the file
-// metadata.
-//
-//  - OffsetIndexOffset: File offset of ColumnChunk's OffsetIndex *
-//  - OffsetIndexLength: Size of ColumnChunk's OffsetIndex, in bytes *
-//  - ColumnIndexOffset: File offset of ColumnChunk's ColumnIndex *
-//  - ColumnIndexLength: Size of ColumnChunk's ColumnIndex, in bytes *
-type ColumnChunk struct {
-	FilePath          *string         `thrift:"file_path,1" db:"file_path" json:"file_path,omitempty"`
-	FileOffset        int64           `thrift:"file_offset,2,required" db:"file_offset" json:"file_offset"`
-	MetaData          *ColumnMetaData `thrift:"meta_data,3" db:"meta_data" json:"meta_data,omitempty"`
-	OffsetIndexOffset *int64          `thrift:"offset_index_offset,4" db:"offset_index_offset" json:"offset_index_offset,omitempty"`
-	OffsetIndexLength *int32          `thrift:"offset_index_length,5" db:"offset_index_length" json:"offset_index_length,omitempty"`
-	ColumnIndexOffset *int64          `thrift:"column_index_offset,6" db:"column_index_offset" json:"column_index_offset,omitempty"`
-	ColumnIndexLength *int32          `thrift:"column_index_length,7" db:"column_index_length" json:"column_index_length,omitempty"`
+type EncryptionWithFooterKey struct {
 }
 
-func NewColumnChunk() *ColumnChunk {
-	return &ColumnChunk{}
+func NewEncryptionWithFooterKey() *EncryptionWithFooterKey {
+	return &EncryptionWithFooterKey{}
 }
 
-var ColumnChunk_FilePath_DEFAULT string
-
-func (p *ColumnChunk) GetFilePath() string {
-	if !p.IsSetFilePath() {
-		return ColumnChunk_FilePath_DEFAULT
+func (p *EncryptionWithFooterKey) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return *p.FilePath
-}
-
-func (p *ColumnChunk) GetFileOffset() int64 {
-	return p.FileOffset
-}
 
-var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
-
-func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
-	if !p.IsSetMetaData() {
-		return ColumnChunk_MetaData_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return p.MetaData
-}
-
-var ColumnChunk_OffsetIndexOffset_DEFAULT int64
-
-func (p *ColumnChunk) GetOffsetIndexOffset() int64 {
-	if !p.IsSetOffsetIndexOffset() {
-		return ColumnChunk_OffsetIndexOffset_DEFAULT
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return *p.OffsetIndexOffset
+	return nil
 }
 
-var ColumnChunk_OffsetIndexLength_DEFAULT int32
-
-func (p *ColumnChunk) GetOffsetIndexLength() int32 {
-	if !p.IsSetOffsetIndexLength() {
-		return ColumnChunk_OffsetIndexLength_DEFAULT
+func (p *EncryptionWithFooterKey) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("EncryptionWithFooterKey"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.OffsetIndexLength
-}
-
-var ColumnChunk_ColumnIndexOffset_DEFAULT int64
-
-func (p *ColumnChunk) GetColumnIndexOffset() int64 {
-	if !p.IsSetColumnIndexOffset() {
-		return ColumnChunk_ColumnIndexOffset_DEFAULT
+	if p != nil {
 	}
-	return *p.ColumnIndexOffset
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var ColumnChunk_ColumnIndexLength_DEFAULT int32
-
-func (p *ColumnChunk) GetColumnIndexLength() int32 {
-	if !p.IsSetColumnIndexLength() {
-		return ColumnChunk_ColumnIndexLength_DEFAULT
+func (p *EncryptionWithFooterKey) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.ColumnIndexLength
-}
-func (p *ColumnChunk) IsSetFilePath() bool {
-	return p.FilePath != nil
+	return fmt.Sprintf("EncryptionWithFooterKey(%+v)", *p)
 }
 
-func (p *ColumnChunk) IsSetMetaData() bool {
-	return p.MetaData != nil
+// Attributes:
+//  - PathInSchema: Column path in schema *
+//  - KeyMetadata: Retrieval metadata of column encryption key *
+type EncryptionWithColumnKey struct {
+	PathInSchema []string `thrift:"path_in_schema,1,required" db:"path_in_schema" json:"path_in_schema"`
+	KeyMetadata  []byte   `thrift:"key_metadata,2" db:"key_metadata" json:"key_metadata,omitempty"`
 }
 
-func (p *ColumnChunk) IsSetOffsetIndexOffset() bool {
-	return p.OffsetIndexOffset != nil
+func NewEncryptionWithColumnKey() *EncryptionWithColumnKey {
+	return &EncryptionWithColumnKey{}
 }
 
-func (p *ColumnChunk) IsSetOffsetIndexLength() bool {
-	return p.OffsetIndexLength != nil
+func (p *EncryptionWithColumnKey) GetPathInSchema() []string {
+	return p.PathInSchema
 }
 
-func (p *ColumnChunk) IsSetColumnIndexOffset() bool {
-	return p.ColumnIndexOffset != nil
-}
+var EncryptionWithColumnKey_KeyMetadata_DEFAULT []byte
 
-func (p *ColumnChunk) IsSetColumnIndexLength() bool {
-	return p.ColumnIndexLength != nil
+func (p *EncryptionWithColumnKey) GetKeyMetadata() []byte {
+	return p.KeyMetadata
+}
+func (p *EncryptionWithColumnKey) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
 }
 
-func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
+func (p *EncryptionWithColumnKey) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetFileOffset bool = false
+	var issetPathInSchema bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7544,7 +7797,7 @@ func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(iprot); err != nil {
 					return err
 				}
@@ -7553,8 +7806,9 @@ func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+			issetPathInSchema = true
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(iprot); err != nil {
 					return err
 				}
@@ -7563,57 +7817,6 @@ func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetFileOffset = true
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField3(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField4(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField5(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField6(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField7(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7626,76 +7829,45 @@ func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetFileOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.FilePath = &v
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.FileOffset = v
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField3(iprot thrift.TProtocol) error {
-	p.MetaData = &ColumnMetaData{}
-	if err := p.MetaData.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
+	if !issetPathInSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
 	}
 	return nil
 }
 
-func (p *ColumnChunk) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.OffsetIndexOffset = &v
+func (p *EncryptionWithColumnKey) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.OffsetIndexLength = &v
+	tSlice := make([]string, 0, size)
+	p.PathInSchema = tSlice
+	for i := 0; i < size; i++ {
+		var _elem15 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem15 = v
+		}
+		p.PathInSchema = append(p.PathInSchema, _elem15)
 	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.ColumnIndexOffset = &v
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *ColumnChunk) ReadField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
+func (p *EncryptionWithColumnKey) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.ColumnIndexLength = &v
+		p.KeyMetadata = v
 	}
 	return nil
 }
 
-func (p *ColumnChunk) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ColumnChunk"); err != nil {
+func (p *EncryptionWithColumnKey) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("EncryptionWithColumnKey"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7705,21 +7877,6 @@ func (p *ColumnChunk) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField7(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7730,165 +7887,102 @@ func (p *ColumnChunk) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnChunk) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilePath() {
-		if err := oprot.WriteFieldBegin("file_path", thrift.STRING, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.FilePath)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("file_offset", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.FileOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
+func (p *EncryptionWithColumnKey) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("path_in_schema", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path_in_schema: ", p), err)
 	}
-	return err
-}
-
-func (p *ColumnChunk) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMetaData() {
-		if err := oprot.WriteFieldBegin("meta_data", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
-		}
-		if err := p.MetaData.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
-		}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.PathInSchema)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *ColumnChunk) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffsetIndexOffset() {
-		if err := oprot.WriteFieldBegin("offset_index_offset", thrift.I64, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:offset_index_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.OffsetIndexOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.offset_index_offset (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:offset_index_offset: ", p), err)
+	for _, v := range p.PathInSchema {
+		if err := oprot.WriteString(string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
-	return err
-}
-
-func (p *ColumnChunk) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffsetIndexLength() {
-		if err := oprot.WriteFieldBegin("offset_index_length", thrift.I32, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:offset_index_length: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.OffsetIndexLength)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.offset_index_length (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:offset_index_length: ", p), err)
-		}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
-	return err
-}
-
-func (p *ColumnChunk) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnIndexOffset() {
-		if err := oprot.WriteFieldBegin("column_index_offset", thrift.I64, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:column_index_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.ColumnIndexOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.column_index_offset (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:column_index_offset: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path_in_schema: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnChunk) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnIndexLength() {
-		if err := oprot.WriteFieldBegin("column_index_length", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_index_length: ", p), err)
+func (p *EncryptionWithColumnKey) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin("key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.ColumnIndexLength)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.column_index_length (7) field write error: ", p), err)
+		if err := oprot.WriteBinary(p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_index_length: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnChunk) String() string {
+func (p *EncryptionWithColumnKey) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+	return fmt.Sprintf("EncryptionWithColumnKey(%+v)", *p)
 }
 
 // Attributes:
-//  - Columns: Metadata for each column chunk in this row group.
-// This list must have the same order as the SchemaElement list in FileMetaData.
-//
-//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
-//  - NumRows: Number of rows in this row group *
-//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
-// The sorting columns can be a subset of all the columns.
-type RowGroup struct {
-	Columns        []*ColumnChunk   `thrift:"columns,1,required" db:"columns" json:"columns"`
-	TotalByteSize  int64            `thrift:"total_byte_size,2,required" db:"total_byte_size" json:"total_byte_size"`
-	NumRows        int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
-	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" db:"sorting_columns" json:"sorting_columns,omitempty"`
+//  - ENCRYPTION_WITH_FOOTER_KEY
+//  - ENCRYPTION_WITH_COLUMN_KEY
+type ColumnCryptoMetaData struct {
+	ENCRYPTION_WITH_FOOTER_KEY *EncryptionWithFooterKey `thrift:"ENCRYPTION_WITH_FOOTER_KEY,1" db:"ENCRYPTION_WITH_FOOTER_KEY" json:"ENCRYPTION_WITH_FOOTER_KEY,omitempty"`
+	ENCRYPTION_WITH_COLUMN_KEY *EncryptionWithColumnKey `thrift:"ENCRYPTION_WITH_COLUMN_KEY,2" db:"ENCRYPTION_WITH_COLUMN_KEY" json:"ENCRYPTION_WITH_COLUMN_KEY,omitempty"`
 }
 
-func NewRowGroup() *RowGroup {
-	return &RowGroup{}
+func NewColumnCryptoMetaData() *ColumnCryptoMetaData {
+	return &ColumnCryptoMetaData{}
 }
 
-func (p *RowGroup) GetColumns() []*ColumnChunk {
-	return p.Columns
-}
+var ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT *EncryptionWithFooterKey
 
-func (p *RowGroup) GetTotalByteSize() int64 {
-	return p.TotalByteSize
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_FOOTER_KEY() *EncryptionWithFooterKey {
+	if !p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT
+	}
+	return p.ENCRYPTION_WITH_FOOTER_KEY
 }
 
-func (p *RowGroup) GetNumRows() int64 {
-	return p.NumRows
-}
+var ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT *EncryptionWithColumnKey
 
-var RowGroup_SortingColumns_DEFAULT []*SortingColumn
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_COLUMN_KEY() *EncryptionWithColumnKey {
+	if !p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT
+	}
+	return p.ENCRYPTION_WITH_COLUMN_KEY
+}
+func (p *ColumnCryptoMetaData) CountSetFieldsColumnCryptoMetaData() int {
+	count := 0
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		count++
+	}
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		count++
+	}
+	return count
 
-func (p *RowGroup) GetSortingColumns() []*SortingColumn {
-	return p.SortingColumns
 }
-func (p *RowGroup) IsSetSortingColumns() bool {
-	return p.SortingColumns != nil
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_FOOTER_KEY() bool {
+	return p.ENCRYPTION_WITH_FOOTER_KEY != nil
 }
 
-func (p *RowGroup) Read(iprot thrift.TProtocol) error {
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_COLUMN_KEY() bool {
+	return p.ENCRYPTION_WITH_COLUMN_KEY != nil
+}
+
+func (p *ColumnCryptoMetaData) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetColumns bool = false
-	var issetTotalByteSize bool = false
-	var issetNumRows bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -7899,7 +7993,7 @@ func (p *RowGroup) Read(iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(iprot); err != nil {
 					return err
 				}
@@ -7908,9 +8002,8 @@ func (p *RowGroup) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetColumns = true
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField2(iprot); err != nil {
 					return err
 				}
@@ -7919,28 +8012,6 @@ func (p *RowGroup) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetTotalByteSize = true
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField3(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-			issetNumRows = true
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField4(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7953,78 +8024,30 @@ func (p *RowGroup) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetColumns {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
-	}
-	if !issetTotalByteSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
-	}
-	if !issetNumRows {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
-	}
-	return nil
-}
-
-func (p *RowGroup) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*ColumnChunk, 0, size)
-	p.Columns = tSlice
-	for i := 0; i < size; i++ {
-		_elem4 := &ColumnChunk{}
-		if err := _elem4.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
-		}
-		p.Columns = append(p.Columns, _elem4)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
 	return nil
 }
 
-func (p *RowGroup) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.TotalByteSize = v
+func (p *ColumnCryptoMetaData) ReadField1(iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_FOOTER_KEY = &EncryptionWithFooterKey{}
+	if err := p.ENCRYPTION_WITH_FOOTER_KEY.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
 	}
 	return nil
 }
 
-func (p *RowGroup) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NumRows = v
+func (p *ColumnCryptoMetaData) ReadField2(iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_COLUMN_KEY = &EncryptionWithColumnKey{}
+	if err := p.ENCRYPTION_WITH_COLUMN_KEY.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
 	}
 	return nil
 }
 
-func (p *RowGroup) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*SortingColumn, 0, size)
-	p.SortingColumns = tSlice
-	for i := 0; i < size; i++ {
-		_elem5 := &SortingColumn{}
-		if err := _elem5.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
-		}
-		p.SortingColumns = append(p.SortingColumns, _elem5)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *ColumnCryptoMetaData) Write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnCryptoMetaData(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
 	}
-	return nil
-}
-
-func (p *RowGroup) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("RowGroup"); err != nil {
+	if err := oprot.WriteStructBegin("ColumnCryptoMetaData"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8034,12 +8057,6 @@ func (p *RowGroup) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8050,226 +8067,185 @@ func (p *RowGroup) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RowGroup) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("columns", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Columns)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Columns {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+func (p *ColumnCryptoMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		if err := oprot.WriteFieldBegin("ENCRYPTION_WITH_FOOTER_KEY", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
+		}
+		if err := p.ENCRYPTION_WITH_FOOTER_KEY.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
-	}
-	return err
-}
-
-func (p *RowGroup) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("total_byte_size", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.TotalByteSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
-	}
-	return err
-}
-
-func (p *RowGroup) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
 	}
 	return err
 }
 
-func (p *RowGroup) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSortingColumns() {
-		if err := oprot.WriteFieldBegin("sorting_columns", thrift.LIST, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SortingColumns)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.SortingColumns {
-			if err := v.Write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
+func (p *ColumnCryptoMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		if err := oprot.WriteFieldBegin("ENCRYPTION_WITH_COLUMN_KEY", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := p.ENCRYPTION_WITH_COLUMN_KEY.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *RowGroup) String() string {
+func (p *ColumnCryptoMetaData) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RowGroup(%+v)", *p)
+	return fmt.Sprintf("ColumnCryptoMetaData(%+v)", *p)
 }
 
-// Empty struct to signal the order defined by the physical or logical type
-type TypeDefinedOrder struct {
+// Attributes:
+//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
+// metadata.  This path is relative to the current file.
+//
+//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
+//  - MetaData: Column metadata for this chunk. This is the same content as what is at
+// file_path/file_offset.  Having it here has it replicated in the file
+// metadata.
+//
+//  - OffsetIndexOffset: File offset of ColumnChunk's OffsetIndex *
+//  - OffsetIndexLength: Size of ColumnChunk's OffsetIndex, in bytes *
+//  - ColumnIndexOffset: File offset of ColumnChunk's ColumnIndex *
+//  - ColumnIndexLength: Size of ColumnChunk's ColumnIndex, in bytes *
+//  - CryptoMetadata: Crypto metadata of encrypted columns *
+//  - EncryptedColumnMetadata: Encrypted column metadata for this chunk *
+type ColumnChunk struct {
+	FilePath                *string               `thrift:"file_path,1" db:"file_path" json:"file_path,omitempty"`
+	FileOffset              int64                 `thrift:"file_offset,2,required" db:"file_offset" json:"file_offset"`
+	MetaData                *ColumnMetaData       `thrift:"meta_data,3" db:"meta_data" json:"meta_data,omitempty"`
+	OffsetIndexOffset       *int64                `thrift:"offset_index_offset,4" db:"offset_index_offset" json:"offset_index_offset,omitempty"`
+	OffsetIndexLength       *int32                `thrift:"offset_index_length,5" db:"offset_index_length" json:"offset_index_length,omitempty"`
+	ColumnIndexOffset       *int64                `thrift:"column_index_offset,6" db:"column_index_offset" json:"column_index_offset,omitempty"`
+	ColumnIndexLength       *int32                `thrift:"column_index_length,7" db:"column_index_length" json:"column_index_length,omitempty"`
+	CryptoMetadata          *ColumnCryptoMetaData `thrift:"crypto_metadata,8" db:"crypto_metadata" json:"crypto_metadata,omitempty"`
+	EncryptedColumnMetadata []byte                `thrift:"encrypted_column_metadata,9" db:"encrypted_column_metadata" json:"encrypted_column_metadata,omitempty"`
 }
 
-func NewTypeDefinedOrder() *TypeDefinedOrder {
-	return &TypeDefinedOrder{}
+func NewColumnChunk() *ColumnChunk {
+	return &ColumnChunk{}
 }
 
-func (p *TypeDefinedOrder) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
+var ColumnChunk_FilePath_DEFAULT string
 
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
-			return err
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
+func (p *ColumnChunk) GetFilePath() string {
+	if !p.IsSetFilePath() {
+		return ColumnChunk_FilePath_DEFAULT
 	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	return *p.FilePath
+}
+
+func (p *ColumnChunk) GetFileOffset() int64 {
+	return p.FileOffset
+}
+
+var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
+
+func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
+	if !p.IsSetMetaData() {
+		return ColumnChunk_MetaData_DEFAULT
 	}
-	return nil
+	return p.MetaData
 }
 
-func (p *TypeDefinedOrder) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("TypeDefinedOrder"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+var ColumnChunk_OffsetIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetOffsetIndexOffset() int64 {
+	if !p.IsSetOffsetIndexOffset() {
+		return ColumnChunk_OffsetIndexOffset_DEFAULT
 	}
-	if p != nil {
+	return *p.OffsetIndexOffset
+}
+
+var ColumnChunk_OffsetIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetOffsetIndexLength() int32 {
+	if !p.IsSetOffsetIndexLength() {
+		return ColumnChunk_OffsetIndexLength_DEFAULT
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	return *p.OffsetIndexLength
+}
+
+var ColumnChunk_ColumnIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetColumnIndexOffset() int64 {
+	if !p.IsSetColumnIndexOffset() {
+		return ColumnChunk_ColumnIndexOffset_DEFAULT
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	return *p.ColumnIndexOffset
+}
+
+var ColumnChunk_ColumnIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetColumnIndexLength() int32 {
+	if !p.IsSetColumnIndexLength() {
+		return ColumnChunk_ColumnIndexLength_DEFAULT
 	}
-	return nil
+	return *p.ColumnIndexLength
 }
 
-func (p *TypeDefinedOrder) String() string {
-	if p == nil {
-		return "<nil>"
+var ColumnChunk_CryptoMetadata_DEFAULT *ColumnCryptoMetaData
+
+func (p *ColumnChunk) GetCryptoMetadata() *ColumnCryptoMetaData {
+	if !p.IsSetCryptoMetadata() {
+		return ColumnChunk_CryptoMetadata_DEFAULT
 	}
-	return fmt.Sprintf("TypeDefinedOrder(%+v)", *p)
+	return p.CryptoMetadata
 }
 
-// Union to specify the order used for the min_value and max_value fields for a
-// column. This union takes the role of an enhanced enum that allows rich
-// elements (which will be needed for a collation-based ordering in the future).
-//
-// Possible values are:
-// * TypeDefinedOrder - the column uses the order defined by its logical or
-//                      physical type (if there is no logical type).
-//
-// If the reader does not support the value of this union, min and max stats
-// for this column should be ignored.
-//
-// Attributes:
-//  - TYPE_ORDER: The sort orders for logical types are:
-//   UTF8 - unsigned byte-wise comparison
-//   INT8 - signed comparison
-//   INT16 - signed comparison
-//   INT32 - signed comparison
-//   INT64 - signed comparison
-//   UINT8 - unsigned comparison
-//   UINT16 - unsigned comparison
-//   UINT32 - unsigned comparison
-//   UINT64 - unsigned comparison
-//   DECIMAL - signed comparison of the represented value
-//   DATE - signed comparison
-//   TIME_MILLIS - signed comparison
-//   TIME_MICROS - signed comparison
-//   TIMESTAMP_MILLIS - signed comparison
-//   TIMESTAMP_MICROS - signed comparison
-//   INTERVAL - unsigned comparison
-//   JSON - unsigned byte-wise comparison
-//   BSON - unsigned byte-wise comparison
-//   ENUM - unsigned byte-wise comparison
-//   LIST - undefined
-//   MAP - undefined
-//
-// In the absence of logical types, the sort order is determined by the physical type:
-//   BOOLEAN - false, true
-//   INT32 - signed comparison
-//   INT64 - signed comparison
-//   INT96 (only used for legacy timestamps) - undefined
-//   FLOAT - signed comparison of the represented value (*)
-//   DOUBLE - signed comparison of the represented value (*)
-//   BYTE_ARRAY - unsigned byte-wise comparison
-//   FIXED_LEN_BYTE_ARRAY - unsigned byte-wise comparison
-//
-// (*) Because the sorting order is not specified properly for floating
-//     point values (relations vs. total ordering) the following
-//     compatibility rules should be applied when reading statistics:
-//     - If the min is a NaN, it should be ignored.
-//     - If the max is a NaN, it should be ignored.
-//     - If the min is +0, the row group may contain -0 values as well.
-//     - If the max is -0, the row group may contain +0 values as well.
-//     - When looking for NaN values, min and max should be ignored.
-type ColumnOrder struct {
-	TYPE_ORDER *TypeDefinedOrder `thrift:"TYPE_ORDER,1" db:"TYPE_ORDER" json:"TYPE_ORDER,omitempty"`
+var ColumnChunk_EncryptedColumnMetadata_DEFAULT []byte
+
+func (p *ColumnChunk) GetEncryptedColumnMetadata() []byte {
+	return p.EncryptedColumnMetadata
+}
+func (p *ColumnChunk) IsSetFilePath() bool {
+	return p.FilePath != nil
 }
 
-func NewColumnOrder() *ColumnOrder {
-	return &ColumnOrder{}
+func (p *ColumnChunk) IsSetMetaData() bool {
+	return p.MetaData != nil
 }
 
-var ColumnOrder_TYPE_ORDER_DEFAULT *TypeDefinedOrder
+func (p *ColumnChunk) IsSetOffsetIndexOffset() bool {
+	return p.OffsetIndexOffset != nil
+}
 
-func (p *ColumnOrder) GetTYPE_ORDER() *TypeDefinedOrder {
-	if !p.IsSetTYPE_ORDER() {
-		return ColumnOrder_TYPE_ORDER_DEFAULT
-	}
-	return p.TYPE_ORDER
+func (p *ColumnChunk) IsSetOffsetIndexLength() bool {
+	return p.OffsetIndexLength != nil
 }
-func (p *ColumnOrder) CountSetFieldsColumnOrder() int {
-	count := 0
-	if p.IsSetTYPE_ORDER() {
-		count++
-	}
-	return count
 
+func (p *ColumnChunk) IsSetColumnIndexOffset() bool {
+	return p.ColumnIndexOffset != nil
 }
 
-func (p *ColumnOrder) IsSetTYPE_ORDER() bool {
-	return p.TYPE_ORDER != nil
+func (p *ColumnChunk) IsSetColumnIndexLength() bool {
+	return p.ColumnIndexLength != nil
 }
 
-func (p *ColumnOrder) Read(iprot thrift.TProtocol) error {
+func (p *ColumnChunk) IsSetCryptoMetadata() bool {
+	return p.CryptoMetadata != nil
+}
+
+func (p *ColumnChunk) IsSetEncryptedColumnMetadata() bool {
+	return p.EncryptedColumnMetadata != nil
+}
+
+func (p *ColumnChunk) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetFileOffset bool = false
+
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -8280,7 +8256,7 @@ func (p *ColumnOrder) Read(iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(iprot); err != nil {
 					return err
 				}
@@ -8289,40 +8265,1482 @@ func (p *ColumnOrder) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetFileOffset = true
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField9(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetFileOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.FilePath = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FileOffset = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField3(iprot thrift.TProtocol) error {
+	p.MetaData = &ColumnMetaData{}
+	if err := p.MetaData.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.OffsetIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.OffsetIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ColumnIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ColumnIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField8(iprot thrift.TProtocol) error {
+	p.CryptoMetadata = &ColumnCryptoMetaData{}
+	if err := p.CryptoMetadata.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CryptoMetadata), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.EncryptedColumnMetadata = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ColumnChunk"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilePath() {
+		if err := oprot.WriteFieldBegin("file_path", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.FilePath)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("file_offset", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.FileOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetaData() {
+		if err := oprot.WriteFieldBegin("meta_data", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
+		}
+		if err := p.MetaData.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexOffset() {
+		if err := oprot.WriteFieldBegin("offset_index_offset", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:offset_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.OffsetIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_offset (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:offset_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexLength() {
+		if err := oprot.WriteFieldBegin("offset_index_length", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:offset_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.OffsetIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_length (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:offset_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexOffset() {
+		if err := oprot.WriteFieldBegin("column_index_offset", thrift.I64, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:column_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.ColumnIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_offset (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:column_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexLength() {
+		if err := oprot.WriteFieldBegin("column_index_length", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.ColumnIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_length (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCryptoMetadata() {
+		if err := oprot.WriteFieldBegin("crypto_metadata", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:crypto_metadata: ", p), err)
+		}
+		if err := p.CryptoMetadata.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CryptoMetadata), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:crypto_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptedColumnMetadata() {
+		if err := oprot.WriteFieldBegin("encrypted_column_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:encrypted_column_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.EncryptedColumnMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.encrypted_column_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:encrypted_column_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+}
+
+// Attributes:
+//  - Columns: Metadata for each column chunk in this row group.
+// This list must have the same order as the SchemaElement list in FileMetaData.
+//
+//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
+//  - NumRows: Number of rows in this row group *
+//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
+// The sorting columns can be a subset of all the columns.
+type RowGroup struct {
+	Columns        []*ColumnChunk   `thrift:"columns,1,required" db:"columns" json:"columns"`
+	TotalByteSize  int64            `thrift:"total_byte_size,2,required" db:"total_byte_size" json:"total_byte_size"`
+	NumRows        int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
+	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" db:"sorting_columns" json:"sorting_columns,omitempty"`
+}
+
+func NewRowGroup() *RowGroup {
+	return &RowGroup{}
+}
+
+func (p *RowGroup) GetColumns() []*ColumnChunk {
+	return p.Columns
+}
+
+func (p *RowGroup) GetTotalByteSize() int64 {
+	return p.TotalByteSize
+}
+
+func (p *RowGroup) GetNumRows() int64 {
+	return p.NumRows
+}
+
+var RowGroup_SortingColumns_DEFAULT []*SortingColumn
+
+func (p *RowGroup) GetSortingColumns() []*SortingColumn {
+	return p.SortingColumns
+}
+func (p *RowGroup) IsSetSortingColumns() bool {
+	return p.SortingColumns != nil
+}
+
+func (p *RowGroup) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetColumns bool = false
+	var issetTotalByteSize bool = false
+	var issetNumRows bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetColumns = true
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetTotalByteSize = true
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetNumRows = true
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetColumns {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
+	}
+	if !issetTotalByteSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
+	}
+	if !issetNumRows {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ColumnChunk, 0, size)
+	p.Columns = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &ColumnChunk{}
+		if err := _elem4.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Columns = append(p.Columns, _elem4)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TotalByteSize = v
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NumRows = v
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SortingColumn, 0, size)
+	p.SortingColumns = tSlice
+	for i := 0; i < size; i++ {
+		_elem5 := &SortingColumn{}
+		if err := _elem5.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
+		}
+		p.SortingColumns = append(p.SortingColumns, _elem5)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RowGroup"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("columns", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Columns)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Columns {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("total_byte_size", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.TotalByteSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSortingColumns() {
+		if err := oprot.WriteFieldBegin("sorting_columns", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SortingColumns)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.SortingColumns {
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RowGroup) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RowGroup(%+v)", *p)
+}
+
+// Empty struct to signal the order defined by the physical or logical type
+type TypeDefinedOrder struct {
+}
+
+func NewTypeDefinedOrder() *TypeDefinedOrder {
+	return &TypeDefinedOrder{}
+}
+
+func (p *TypeDefinedOrder) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *TypeDefinedOrder) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TypeDefinedOrder"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TypeDefinedOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TypeDefinedOrder(%+v)", *p)
+}
+
+// Union to specify the order used for the min_value and max_value fields for a
+// column. This union takes the role of an enhanced enum that allows rich
+// elements (which will be needed for a collation-based ordering in the future).
+//
+// Possible values are:
+// * TypeDefinedOrder - the column uses the order defined by its logical or
+//                      physical type (if there is no logical type).
+//
+// If the reader does not support the value of this union, min and max stats
+// for this column should be ignored.
+//
+// Attributes:
+//  - TYPE_ORDER: The sort orders for logical types are:
+//   UTF8 - unsigned byte-wise comparison
+//   INT8 - signed comparison
+//   INT16 - signed comparison
+//   INT32 - signed comparison
+//   INT64 - signed comparison
+//   UINT8 - unsigned comparison
+//   UINT16 - unsigned comparison
+//   UINT32 - unsigned comparison
+//   UINT64 - unsigned comparison
+//   DECIMAL - signed comparison of the represented value
+//   DATE - signed comparison
+//   TIME_MILLIS - signed comparison
+//   TIME_MICROS - signed comparison
+//   TIMESTAMP_MILLIS - signed comparison
+//   TIMESTAMP_MICROS - signed comparison
+//   INTERVAL - unsigned comparison
+//   JSON - unsigned byte-wise comparison
+//   BSON - unsigned byte-wise comparison
+//   ENUM - unsigned byte-wise comparison
+//   LIST - undefined
+//   MAP - undefined
+//
+// In the absence of logical types, the sort order is determined by the physical type:
+//   BOOLEAN - false, true
+//   INT32 - signed comparison
+//   INT64 - signed comparison
+//   INT96 (only used for legacy timestamps) - undefined
+//   FLOAT - signed comparison of the represented value (*)
+//   DOUBLE - signed comparison of the represented value (*)
+//   BYTE_ARRAY - unsigned byte-wise comparison
+//   FIXED_LEN_BYTE_ARRAY - unsigned byte-wise comparison
+//
+// (*) Because the sorting order is not specified properly for floating
+//     point values (relations vs. total ordering) the following
+//     compatibility rules should be applied when reading statistics:
+//     - If the min is a NaN, it should be ignored.
+//     - If the max is a NaN, it should be ignored.
+//     - If the min is +0, the row group may contain -0 values as well.
+//     - If the max is -0, the row group may contain +0 values as well.
+//     - When looking for NaN values, min and max should be ignored.
+type ColumnOrder struct {
+	TYPE_ORDER *TypeDefinedOrder `thrift:"TYPE_ORDER,1" db:"TYPE_ORDER" json:"TYPE_ORDER,omitempty"`
+}
+
+func NewColumnOrder() *ColumnOrder {
+	return &ColumnOrder{}
+}
+
+var ColumnOrder_TYPE_ORDER_DEFAULT *TypeDefinedOrder
+
+func (p *ColumnOrder) GetTYPE_ORDER() *TypeDefinedOrder {
+	if !p.IsSetTYPE_ORDER() {
+		return ColumnOrder_TYPE_ORDER_DEFAULT
+	}
+	return p.TYPE_ORDER
+}
+func (p *ColumnOrder) CountSetFieldsColumnOrder() int {
+	count := 0
+	if p.IsSetTYPE_ORDER() {
+		count++
+	}
+	return count
+
+}
+
+func (p *ColumnOrder) IsSetTYPE_ORDER() bool {
+	return p.TYPE_ORDER != nil
+}
+
+func (p *ColumnOrder) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) ReadField1(iprot thrift.TProtocol) error {
+	p.TYPE_ORDER = &TypeDefinedOrder{}
+	if err := p.TYPE_ORDER.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TYPE_ORDER), err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) Write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnOrder(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("ColumnOrder"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTYPE_ORDER() {
+		if err := oprot.WriteFieldBegin("TYPE_ORDER", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TYPE_ORDER: ", p), err)
+		}
+		if err := p.TYPE_ORDER.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TYPE_ORDER), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TYPE_ORDER: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnOrder(%+v)", *p)
+}
+
+// Attributes:
+//  - Offset: Offset of the page in the file *
+//  - CompressedPageSize: Size of the page, including header. Sum of compressed_page_size and header
+// length
+//  - FirstRowIndex: Index within the RowGroup of the first row of the page; this means pages
+// change on record boundaries (r = 0).
+type PageLocation struct {
+	Offset             int64 `thrift:"offset,1,required" db:"offset" json:"offset"`
+	CompressedPageSize int32 `thrift:"compressed_page_size,2,required" db:"compressed_page_size" json:"compressed_page_size"`
+	FirstRowIndex      int64 `thrift:"first_row_index,3,required" db:"first_row_index" json:"first_row_index"`
+}
+
+func NewPageLocation() *PageLocation {
+	return &PageLocation{}
+}
+
+func (p *PageLocation) GetOffset() int64 {
+	return p.Offset
+}
+
+func (p *PageLocation) GetCompressedPageSize() int32 {
+	return p.CompressedPageSize
+}
+
+func (p *PageLocation) GetFirstRowIndex() int64 {
+	return p.FirstRowIndex
+}
+func (p *PageLocation) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetOffset bool = false
+	var issetCompressedPageSize bool = false
+	var issetFirstRowIndex bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetOffset = true
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetCompressedPageSize = true
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetFirstRowIndex = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Offset is not set"))
+	}
+	if !issetCompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
+	}
+	if !issetFirstRowIndex {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FirstRowIndex is not set"))
+	}
+	return nil
+}
+
+func (p *PageLocation) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *PageLocation) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.CompressedPageSize = v
+	}
+	return nil
+}
+
+func (p *PageLocation) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FirstRowIndex = v
+	}
+	return nil
+}
+
+func (p *PageLocation) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("PageLocation"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PageLocation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("offset", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:offset: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.offset (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:offset: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("compressed_page_size", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compressed_page_size: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.CompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compressed_page_size: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("first_row_index", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first_row_index: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.FirstRowIndex)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.first_row_index (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first_row_index: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PageLocation(%+v)", *p)
+}
+
+// Attributes:
+//  - PageLocations: PageLocations, ordered by increasing PageLocation.offset. It is required
+// that page_locations[i].first_row_index < page_locations[i+1].first_row_index.
+type OffsetIndex struct {
+	PageLocations []*PageLocation `thrift:"page_locations,1,required" db:"page_locations" json:"page_locations"`
+}
+
+func NewOffsetIndex() *OffsetIndex {
+	return &OffsetIndex{}
+}
+
+func (p *OffsetIndex) GetPageLocations() []*PageLocation {
+	return p.PageLocations
+}
+func (p *OffsetIndex) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetPageLocations bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetPageLocations = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetPageLocations {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageLocations is not set"))
+	}
+	return nil
+}
+
+func (p *OffsetIndex) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PageLocation, 0, size)
+	p.PageLocations = tSlice
+	for i := 0; i < size; i++ {
+		_elem6 := &PageLocation{}
+		if err := _elem6.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+		}
+		p.PageLocations = append(p.PageLocations, _elem6)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *OffsetIndex) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("OffsetIndex"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OffsetIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("page_locations", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_locations: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PageLocations)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PageLocations {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_locations: ", p), err)
+	}
+	return err
+}
+
+func (p *OffsetIndex) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OffsetIndex(%+v)", *p)
+}
+
+// Description for ColumnIndex.
+// Each <array-field>[i] refers to the page at OffsetIndex.page_locations[i]
+//
+// Attributes:
+//  - NullPages: A list of Boolean values to determine the validity of the corresponding
+// min and max values. If true, a page contains only null values, and writers
+// have to set the corresponding entries in min_values and max_values to
+// byte[0], so that all lists have the same length. If false, the
+// corresponding entries in min_values and max_values must be valid.
+//  - MinValues: Two lists containing lower and upper bounds for the values of each page.
+// These may be the actual minimum and maximum values found on a page, but
+// can also be (more compact) values that do not exist on a page. For
+// example, instead of storing ""Blart Versenwald III", a writer may set
+// min_values[i]="B", max_values[i]="C". Such more compact values must still
+// be valid values within the column's logical type. Readers must make sure
+// that list entries are populated before using them by inspecting null_pages.
+//  - MaxValues
+//  - BoundaryOrder: Stores whether both min_values and max_values are orderd and if so, in
+// which direction. This allows readers to perform binary searches in both
+// lists. Readers cannot assume that max_values[i] <= min_values[i+1], even
+// if the lists are ordered.
+//  - NullCounts: A list containing the number of null values for each page *
+type ColumnIndex struct {
+	NullPages     []bool        `thrift:"null_pages,1,required" db:"null_pages" json:"null_pages"`
+	MinValues     [][]byte      `thrift:"min_values,2,required" db:"min_values" json:"min_values"`
+	MaxValues     [][]byte      `thrift:"max_values,3,required" db:"max_values" json:"max_values"`
+	BoundaryOrder BoundaryOrder `thrift:"boundary_order,4,required" db:"boundary_order" json:"boundary_order"`
+	NullCounts    []int64       `thrift:"null_counts,5" db:"null_counts" json:"null_counts,omitempty"`
+}
+
+func NewColumnIndex() *ColumnIndex {
+	return &ColumnIndex{}
+}
+
+func (p *ColumnIndex) GetNullPages() []bool {
+	return p.NullPages
+}
+
+func (p *ColumnIndex) GetMinValues() [][]byte {
+	return p.MinValues
+}
+
+func (p *ColumnIndex) GetMaxValues() [][]byte {
+	return p.MaxValues
+}
+
+func (p *ColumnIndex) GetBoundaryOrder() BoundaryOrder {
+	return p.BoundaryOrder
+}
+
+var ColumnIndex_NullCounts_DEFAULT []int64
+
+func (p *ColumnIndex) GetNullCounts() []int64 {
+	return p.NullCounts
+}
+func (p *ColumnIndex) IsSetNullCounts() bool {
+	return p.NullCounts != nil
+}
+
+func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNullPages bool = false
+	var issetMinValues bool = false
+	var issetMaxValues bool = false
+	var issetBoundaryOrder bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetNullPages = true
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetMinValues = true
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetMaxValues = true
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetBoundaryOrder = true
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNullPages {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullPages is not set"))
+	}
+	if !issetMinValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MinValues is not set"))
+	}
+	if !issetMaxValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxValues is not set"))
+	}
+	if !issetBoundaryOrder {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BoundaryOrder is not set"))
 	}
 	return nil
 }
 
-func (p *ColumnOrder) ReadField1(iprot thrift.TProtocol) error {
-	p.TYPE_ORDER = &TypeDefinedOrder{}
-	if err := p.TYPE_ORDER.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TYPE_ORDER), err)
+func (p *ColumnIndex) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]bool, 0, size)
+	p.NullPages = tSlice
+	for i := 0; i < size; i++ {
+		var _elem7 bool
+		if v, err := iprot.ReadBool(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem7 = v
+		}
+		p.NullPages = append(p.NullPages, _elem7)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *ColumnOrder) Write(oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsColumnOrder(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+func (p *ColumnIndex) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteStructBegin("ColumnOrder"); err != nil {
+	tSlice := make([][]byte, 0, size)
+	p.MinValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem8 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem8 = v
+		}
+		p.MinValues = append(p.MinValues, _elem8)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.MaxValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem9 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem9 = v
+		}
+		p.MaxValues = append(p.MaxValues, _elem9)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := BoundaryOrder(v)
+		p.BoundaryOrder = temp
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int64, 0, size)
+	p.NullCounts = tSlice
+	for i := 0; i < size; i++ {
+		var _elem10 int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem10 = v
+		}
+		p.NullCounts = append(p.NullCounts, _elem10)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ColumnIndex"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8333,64 +9751,164 @@ func (p *ColumnOrder) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnOrder) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTYPE_ORDER() {
-		if err := oprot.WriteFieldBegin("TYPE_ORDER", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TYPE_ORDER: ", p), err)
+func (p *ColumnIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("null_pages", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:null_pages: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.BOOL, len(p.NullPages)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.NullPages {
+		if err := oprot.WriteBool(bool(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
-		if err := p.TYPE_ORDER.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TYPE_ORDER), err)
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:null_pages: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("min_values", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:min_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MinValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MinValues {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:min_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("max_values", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:max_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MaxValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MaxValues {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:max_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("boundary_order", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:boundary_order: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.BoundaryOrder)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.boundary_order (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:boundary_order: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNullCounts() {
+		if err := oprot.WriteFieldBegin("null_counts", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:null_counts: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.NullCounts)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.NullCounts {
+			if err := oprot.WriteI64(int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TYPE_ORDER: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:null_counts: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnOrder) String() string {
+func (p *ColumnIndex) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnOrder(%+v)", *p)
-}
-
-// Attributes:
-//  - Offset: Offset of the page in the file *
-//  - CompressedPageSize: Size of the page, including header. Sum of compressed_page_size and header
-// length
-//  - FirstRowIndex: Index within the RowGroup of the first row of the page; this means pages
-// change on record boundaries (r = 0).
-type PageLocation struct {
-	Offset             int64 `thrift:"offset,1,required" db:"offset" json:"offset"`
-	CompressedPageSize int32 `thrift:"compressed_page_size,2,required" db:"compressed_page_size" json:"compressed_page_size"`
-	FirstRowIndex      int64 `thrift:"first_row_index,3,required" db:"first_row_index" json:"first_row_index"`
+	return fmt.Sprintf("ColumnIndex(%+v)", *p)
 }
 
-func NewPageLocation() *PageLocation {
-	return &PageLocation{}
+// Attributes:
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" db:"aad_prefix" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" db:"aad_file_unique" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" db:"supply_aad_prefix" json:"supply_aad_prefix,omitempty"`
 }
 
-func (p *PageLocation) GetOffset() int64 {
-	return p.Offset
+func NewAesGcmV1() *AesGcmV1 {
+	return &AesGcmV1{}
 }
 
-func (p *PageLocation) GetCompressedPageSize() int32 {
-	return p.CompressedPageSize
+var AesGcmV1_AadPrefix_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
 
-func (p *PageLocation) GetFirstRowIndex() int64 {
-	return p.FirstRowIndex
+var AesGcmV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
 }
-func (p *PageLocation) Read(iprot thrift.TProtocol) error {
+
+var AesGcmV1_SupplyAadPrefix_DEFAULT bool
+
+func (p *AesGcmV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmV1_SupplyAadPrefix_DEFAULT
+	}
+	return *p.SupplyAadPrefix
+}
+func (p *AesGcmV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
+}
+
+func (p *AesGcmV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
+}
+
+func (p *AesGcmV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
+}
+
+func (p *AesGcmV1) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetOffset bool = false
-	var issetCompressedPageSize bool = false
-	var issetFirstRowIndex bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -8401,7 +9919,7 @@ func (p *PageLocation) Read(iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(iprot); err != nil {
 					return err
 				}
@@ -8410,9 +9928,8 @@ func (p *PageLocation) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetOffset = true
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(iprot); err != nil {
 					return err
 				}
@@ -8421,9 +9938,8 @@ func (p *PageLocation) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetCompressedPageSize = true
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField3(iprot); err != nil {
 					return err
 				}
@@ -8432,7 +9948,6 @@ func (p *PageLocation) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetFirstRowIndex = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -8445,47 +9960,38 @@ func (p *PageLocation) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Offset is not set"))
-	}
-	if !issetCompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
-	}
-	if !issetFirstRowIndex {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FirstRowIndex is not set"))
-	}
 	return nil
 }
 
-func (p *PageLocation) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *AesGcmV1) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Offset = v
+		p.AadPrefix = v
 	}
 	return nil
 }
 
-func (p *PageLocation) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *AesGcmV1) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.CompressedPageSize = v
+		p.AadFileUnique = v
 	}
 	return nil
 }
 
-func (p *PageLocation) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *AesGcmV1) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.FirstRowIndex = v
+		p.SupplyAadPrefix = &v
 	}
 	return nil
 }
 
-func (p *PageLocation) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("PageLocation"); err != nil {
+func (p *AesGcmV1) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AesGcmV1"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8508,246 +10014,110 @@ func (p *PageLocation) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PageLocation) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("offset", thrift.I64, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:offset: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.Offset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.offset (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:offset: ", p), err)
-	}
-	return err
-}
-
-func (p *PageLocation) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("compressed_page_size", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compressed_page_size: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.CompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compressed_page_size: ", p), err)
-	}
-	return err
-}
-
-func (p *PageLocation) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("first_row_index", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first_row_index: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.FirstRowIndex)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.first_row_index (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first_row_index: ", p), err)
+func (p *AesGcmV1) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin("aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageLocation) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("PageLocation(%+v)", *p)
-}
-
-// Attributes:
-//  - PageLocations: PageLocations, ordered by increasing PageLocation.offset. It is required
-// that page_locations[i].first_row_index < page_locations[i+1].first_row_index.
-type OffsetIndex struct {
-	PageLocations []*PageLocation `thrift:"page_locations,1,required" db:"page_locations" json:"page_locations"`
-}
-
-func NewOffsetIndex() *OffsetIndex {
-	return &OffsetIndex{}
-}
-
-func (p *OffsetIndex) GetPageLocations() []*PageLocation {
-	return p.PageLocations
-}
-func (p *OffsetIndex) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	var issetPageLocations bool = false
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+func (p *AesGcmV1) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin("aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
 		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField1(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-			issetPageLocations = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := oprot.WriteBinary(p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
 		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
 		}
 	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetPageLocations {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageLocations is not set"))
-	}
-	return nil
+	return err
 }
 
-func (p *OffsetIndex) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*PageLocation, 0, size)
-	p.PageLocations = tSlice
-	for i := 0; i < size; i++ {
-		_elem6 := &PageLocation{}
-		if err := _elem6.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+func (p *AesGcmV1) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin("supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
 		}
-		p.PageLocations = append(p.PageLocations, _elem6)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *OffsetIndex) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("OffsetIndex"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
+		if err := oprot.WriteBool(bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
 		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *OffsetIndex) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("page_locations", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_locations: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PageLocations)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.PageLocations {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_locations: ", p), err)
-	}
 	return err
 }
 
-func (p *OffsetIndex) String() string {
+func (p *AesGcmV1) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OffsetIndex(%+v)", *p)
+	return fmt.Sprintf("AesGcmV1(%+v)", *p)
 }
 
-// Description for ColumnIndex.
-// Each <array-field>[i] refers to the page at OffsetIndex.page_locations[i]
-//
 // Attributes:
-//  - NullPages: A list of Boolean values to determine the validity of the corresponding
-// min and max values. If true, a page contains only null values, and writers
-// have to set the corresponding entries in min_values and max_values to
-// byte[0], so that all lists have the same length. If false, the
-// corresponding entries in min_values and max_values must be valid.
-//  - MinValues: Two lists containing lower and upper bounds for the values of each page.
-// These may be the actual minimum and maximum values found on a page, but
-// can also be (more compact) values that do not exist on a page. For
-// example, instead of storing ""Blart Versenwald III", a writer may set
-// min_values[i]="B", max_values[i]="C". Such more compact values must still
-// be valid values within the column's logical type. Readers must make sure
-// that list entries are populated before using them by inspecting null_pages.
-//  - MaxValues
-//  - BoundaryOrder: Stores whether both min_values and max_values are orderd and if so, in
-// which direction. This allows readers to perform binary searches in both
-// lists. Readers cannot assume that max_values[i] <= min_values[i+1], even
-// if the lists are ordered.
-//  - NullCounts: A list containing the number of null values for each page *
-type ColumnIndex struct {
-	NullPages     []bool        `thrift:"null_pages,1,required" db:"null_pages" json:"null_pages"`
-	MinValues     [][]byte      `thrift:"min_values,2,required" db:"min_values" json:"min_values"`
-	MaxValues     [][]byte      `thrift:"max_values,3,required" db:"max_values" json:"max_values"`
-	BoundaryOrder BoundaryOrder `thrift:"boundary_order,4,required" db:"boundary_order" json:"boundary_order"`
-	NullCounts    []int64       `thrift:"null_counts,5" db:"null_counts" json:"null_counts,omitempty"`
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmCtrV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" db:"aad_prefix" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" db:"aad_file_unique" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" db:"supply_aad_prefix" json:"supply_aad_prefix,omitempty"`
 }
 
-func NewColumnIndex() *ColumnIndex {
-	return &ColumnIndex{}
+func NewAesGcmCtrV1() *AesGcmCtrV1 {
+	return &AesGcmCtrV1{}
 }
 
-func (p *ColumnIndex) GetNullPages() []bool {
-	return p.NullPages
-}
+var AesGcmCtrV1_AadPrefix_DEFAULT []byte
 
-func (p *ColumnIndex) GetMinValues() [][]byte {
-	return p.MinValues
+func (p *AesGcmCtrV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
 
-func (p *ColumnIndex) GetMaxValues() [][]byte {
-	return p.MaxValues
+var AesGcmCtrV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmCtrV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
 }
 
-func (p *ColumnIndex) GetBoundaryOrder() BoundaryOrder {
-	return p.BoundaryOrder
+var AesGcmCtrV1_SupplyAadPrefix_DEFAULT bool
+
+func (p *AesGcmCtrV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmCtrV1_SupplyAadPrefix_DEFAULT
+	}
+	return *p.SupplyAadPrefix
+}
+func (p *AesGcmCtrV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
 }
 
-var ColumnIndex_NullCounts_DEFAULT []int64
-
-func (p *ColumnIndex) GetNullCounts() []int64 {
-	return p.NullCounts
+func (p *AesGcmCtrV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
 }
-func (p *ColumnIndex) IsSetNullCounts() bool {
-	return p.NullCounts != nil
+
+func (p *AesGcmCtrV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
 }
 
-func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
+func (p *AesGcmCtrV1) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNullPages bool = false
-	var issetMinValues bool = false
-	var issetMaxValues bool = false
-	var issetBoundaryOrder bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -8758,7 +10128,7 @@ func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(iprot); err != nil {
 					return err
 				}
@@ -8767,9 +10137,8 @@ func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetNullPages = true
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(iprot); err != nil {
 					return err
 				}
@@ -8778,9 +10147,8 @@ func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetMinValues = true
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField3(iprot); err != nil {
 					return err
 				}
@@ -8789,28 +10157,6 @@ func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-			issetMaxValues = true
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField4(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
-			issetBoundaryOrder = true
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField5(iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -8823,121 +10169,38 @@ func (p *ColumnIndex) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNullPages {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullPages is not set"))
-	}
-	if !issetMinValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MinValues is not set"))
-	}
-	if !issetMaxValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxValues is not set"))
-	}
-	if !issetBoundaryOrder {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BoundaryOrder is not set"))
-	}
-	return nil
-}
-
-func (p *ColumnIndex) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]bool, 0, size)
-	p.NullPages = tSlice
-	for i := 0; i < size; i++ {
-		var _elem7 bool
-		if v, err := iprot.ReadBool(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem7 = v
-		}
-		p.NullPages = append(p.NullPages, _elem7)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *ColumnIndex) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([][]byte, 0, size)
-	p.MinValues = tSlice
-	for i := 0; i < size; i++ {
-		var _elem8 []byte
-		if v, err := iprot.ReadBinary(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem8 = v
-		}
-		p.MinValues = append(p.MinValues, _elem8)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([][]byte, 0, size)
-	p.MaxValues = tSlice
-	for i := 0; i < size; i++ {
-		var _elem9 []byte
-		if v, err := iprot.ReadBinary(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem9 = v
-		}
-		p.MaxValues = append(p.MaxValues, _elem9)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *AesGcmCtrV1) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AadPrefix = v
 	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
+func (p *AesGcmCtrV1) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := BoundaryOrder(v)
-		p.BoundaryOrder = temp
+		p.AadFileUnique = v
 	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]int64, 0, size)
-	p.NullCounts = tSlice
-	for i := 0; i < size; i++ {
-		var _elem10 int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem10 = v
-		}
-		p.NullCounts = append(p.NullCounts, _elem10)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *AesGcmCtrV1) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SupplyAadPrefix = &v
 	}
 	return nil
 }
 
-func (p *ColumnIndex) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ColumnIndex"); err != nil {
+func (p *AesGcmCtrV1) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AesGcmCtrV1"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8950,12 +10213,6 @@ func (p *ColumnIndex) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8966,110 +10223,230 @@ func (p *ColumnIndex) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnIndex) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("null_pages", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:null_pages: ", p), err)
+func (p *AesGcmCtrV1) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin("aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
+		}
 	}
-	if err := oprot.WriteListBegin(thrift.BOOL, len(p.NullPages)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	return err
+}
+
+func (p *AesGcmCtrV1) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin("aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
+		}
 	}
-	for _, v := range p.NullPages {
-		if err := oprot.WriteBool(bool(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+	return err
+}
+
+func (p *AesGcmCtrV1) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin("supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *AesGcmCtrV1) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:null_pages: ", p), err)
+	return fmt.Sprintf("AesGcmCtrV1(%+v)", *p)
+}
+
+// Attributes:
+//  - AES_GCM_V1
+//  - AES_GCM_CTR_V1
+type EncryptionAlgorithm struct {
+	AES_GCM_V1     *AesGcmV1    `thrift:"AES_GCM_V1,1" db:"AES_GCM_V1" json:"AES_GCM_V1,omitempty"`
+	AES_GCM_CTR_V1 *AesGcmCtrV1 `thrift:"AES_GCM_CTR_V1,2" db:"AES_GCM_CTR_V1" json:"AES_GCM_CTR_V1,omitempty"`
+}
+
+func NewEncryptionAlgorithm() *EncryptionAlgorithm {
+	return &EncryptionAlgorithm{}
+}
+
+var EncryptionAlgorithm_AES_GCM_V1_DEFAULT *AesGcmV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_V1() *AesGcmV1 {
+	if !p.IsSetAES_GCM_V1() {
+		return EncryptionAlgorithm_AES_GCM_V1_DEFAULT
 	}
-	return err
+	return p.AES_GCM_V1
 }
 
-func (p *ColumnIndex) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("min_values", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:min_values: ", p), err)
+var EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT *AesGcmCtrV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_CTR_V1() *AesGcmCtrV1 {
+	if !p.IsSetAES_GCM_CTR_V1() {
+		return EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT
 	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.MinValues)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	return p.AES_GCM_CTR_V1
+}
+func (p *EncryptionAlgorithm) CountSetFieldsEncryptionAlgorithm() int {
+	count := 0
+	if p.IsSetAES_GCM_V1() {
+		count++
 	}
-	for _, v := range p.MinValues {
-		if err := oprot.WriteBinary(v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+	if p.IsSetAES_GCM_CTR_V1() {
+		count++
+	}
+	return count
+
+}
+func (p *EncryptionAlgorithm) IsSetAES_GCM_V1() bool {
+	return p.AES_GCM_V1 != nil
+}
+
+func (p *EncryptionAlgorithm) IsSetAES_GCM_CTR_V1() bool {
+	return p.AES_GCM_CTR_V1 != nil
+}
+
+func (p *EncryptionAlgorithm) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:min_values: ", p), err)
+	return nil
+}
+
+func (p *EncryptionAlgorithm) ReadField1(iprot thrift.TProtocol) error {
+	p.AES_GCM_V1 = &AesGcmV1{}
+	if err := p.AES_GCM_V1.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_V1), err)
 	}
-	return err
+	return nil
 }
 
-func (p *ColumnIndex) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("max_values", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:max_values: ", p), err)
+func (p *EncryptionAlgorithm) ReadField2(iprot thrift.TProtocol) error {
+	p.AES_GCM_CTR_V1 = &AesGcmCtrV1{}
+	if err := p.AES_GCM_CTR_V1.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_CTR_V1), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.MaxValues)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	return nil
+}
+
+func (p *EncryptionAlgorithm) Write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsEncryptionAlgorithm(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
 	}
-	for _, v := range p.MaxValues {
-		if err := oprot.WriteBinary(v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+	if err := oprot.WriteStructBegin("EncryptionAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:max_values: ", p), err)
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *ColumnIndex) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("boundary_order", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:boundary_order: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.BoundaryOrder)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.boundary_order (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:boundary_order: ", p), err)
+func (p *EncryptionAlgorithm) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_V1() {
+		if err := oprot.WriteFieldBegin("AES_GCM_V1", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AES_GCM_V1: ", p), err)
+		}
+		if err := p.AES_GCM_V1.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_V1), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AES_GCM_V1: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *ColumnIndex) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNullCounts() {
-		if err := oprot.WriteFieldBegin("null_counts", thrift.LIST, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:null_counts: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.I64, len(p.NullCounts)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.NullCounts {
-			if err := oprot.WriteI64(int64(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
+func (p *EncryptionAlgorithm) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_CTR_V1() {
+		if err := oprot.WriteFieldBegin("AES_GCM_CTR_V1", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AES_GCM_CTR_V1: ", p), err)
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := p.AES_GCM_CTR_V1.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_CTR_V1), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:null_counts: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AES_GCM_CTR_V1: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnIndex) String() string {
+func (p *EncryptionAlgorithm) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnIndex(%+v)", *p)
+	return fmt.Sprintf("EncryptionAlgorithm(%+v)", *p)
 }
 
 // Description for file metadata
@@ -9100,14 +10477,21 @@ func (p *ColumnIndex) String() string {
 //
 // The obsolete min and max fields are always sorted by signed comparison
 // regardless of column_orders.
+//  - EncryptionAlgorithm: Encryption algorithm. This field is set only in encrypted files
+// with plaintext footer. Files with encrypted footer store algorithm id
+// in FileCryptoMetaData structure.
+//  - FooterSigningKeyMetadata: Retrieval metadata of key used for signing the footer.
+// Used only in encrypted files with plaintext footer.
 type FileMetaData struct {
-	Version          int32            `thrift:"version,1,required" db:"version" json:"version"`
-	Schema           []*SchemaElement `thrift:"schema,2,required" db:"schema" json:"schema"`
-	NumRows          int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
-	RowGroups        []*RowGroup      `thrift:"row_groups,4,required" db:"row_groups" json:"row_groups"`
-	KeyValueMetadata []*KeyValue      `thrift:"key_value_metadata,5" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
-	CreatedBy        *string          `thrift:"created_by,6" db:"created_by" json:"created_by,omitempty"`
-	ColumnOrders     []*ColumnOrder   `thrift:"column_orders,7" db:"column_orders" json:"column_orders,omitempty"`
+	Version                  int32                `thrift:"version,1,required" db:"version" json:"version"`
+	Schema                   []*SchemaElement     `thrift:"schema,2,required" db:"schema" json:"schema"`
+	NumRows                  int64                `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
+	RowGroups                []*RowGroup          `thrift:"row_groups,4,required" db:"row_groups" json:"row_groups"`
+	KeyValueMetadata         []*KeyValue          `thrift:"key_value_metadata,5" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
+	CreatedBy                *string              `thrift:"created_by,6" db:"created_by" json:"created_by,omitempty"`
+	ColumnOrders             []*ColumnOrder       `thrift:"column_orders,7" db:"column_orders" json:"column_orders,omitempty"`
+	EncryptionAlgorithm      *EncryptionAlgorithm `thrift:"encryption_algorithm,8" db:"encryption_algorithm" json:"encryption_algorithm,omitempty"`
+	FooterSigningKeyMetadata []byte               `thrift:"footer_signing_key_metadata,9" db:"footer_signing_key_metadata" json:"footer_signing_key_metadata,omitempty"`
 }
 
 func NewFileMetaData() *FileMetaData {
@@ -9150,6 +10534,21 @@ var FileMetaData_ColumnOrders_DEFAULT []*ColumnOrder
 func (p *FileMetaData) GetColumnOrders() []*ColumnOrder {
 	return p.ColumnOrders
 }
+
+var FileMetaData_EncryptionAlgorithm_DEFAULT *EncryptionAlgorithm
+
+func (p *FileMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	if !p.IsSetEncryptionAlgorithm() {
+		return FileMetaData_EncryptionAlgorithm_DEFAULT
+	}
+	return p.EncryptionAlgorithm
+}
+
+var FileMetaData_FooterSigningKeyMetadata_DEFAULT []byte
+
+func (p *FileMetaData) GetFooterSigningKeyMetadata() []byte {
+	return p.FooterSigningKeyMetadata
+}
 func (p *FileMetaData) IsSetKeyValueMetadata() bool {
 	return p.KeyValueMetadata != nil
 }
@@ -9162,6 +10561,14 @@ func (p *FileMetaData) IsSetColumnOrders() bool {
 	return p.ColumnOrders != nil
 }
 
+func (p *FileMetaData) IsSetEncryptionAlgorithm() bool {
+	return p.EncryptionAlgorithm != nil
+}
+
+func (p *FileMetaData) IsSetFooterSigningKeyMetadata() bool {
+	return p.FooterSigningKeyMetadata != nil
+}
+
 func (p *FileMetaData) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -9255,6 +10662,26 @@ func (p *FileMetaData) Read(iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField9(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -9389,6 +10816,23 @@ func (p *FileMetaData) ReadField7(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *FileMetaData) ReadField8(iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.FooterSigningKeyMetadata = v
+	}
+	return nil
+}
+
 func (p *FileMetaData) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("FileMetaData"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -9415,6 +10859,12 @@ func (p *FileMetaData) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField7(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9554,9 +11004,206 @@ func (p *FileMetaData) writeField7(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *FileMetaData) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptionAlgorithm() {
+		if err := oprot.WriteFieldBegin("encryption_algorithm", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:encryption_algorithm: ", p), err)
+		}
+		if err := p.EncryptionAlgorithm.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:encryption_algorithm: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFooterSigningKeyMetadata() {
+		if err := oprot.WriteFieldBegin("footer_signing_key_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:footer_signing_key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.FooterSigningKeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.footer_signing_key_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:footer_signing_key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *FileMetaData) String() string {
 	if p == nil {
 		return "<nil>"
 	}
 	return fmt.Sprintf("FileMetaData(%+v)", *p)
 }
+
+// Crypto metadata for files with encrypted footer *
+//
+// Attributes:
+//  - EncryptionAlgorithm: Encryption algorithm. This field is only used for files
+// with encrypted footer. Files with plaintext footer store algorithm id
+// inside footer (FileMetaData structure).
+//  - KeyMetadata: Retrieval metadata of key used for encryption of footer,
+// and (possibly) columns *
+type FileCryptoMetaData struct {
+	EncryptionAlgorithm *EncryptionAlgorithm `thrift:"encryption_algorithm,1,required" db:"encryption_algorithm" json:"encryption_algorithm"`
+	KeyMetadata         []byte               `thrift:"key_metadata,2" db:"key_metadata" json:"key_metadata,omitempty"`
+}
+
+func NewFileCryptoMetaData() *FileCryptoMetaData {
+	return &FileCryptoMetaData{}
+}
+
+var FileCryptoMetaData_EncryptionAlgorithm_DEFAULT *EncryptionAlgorithm
+
+func (p *FileCryptoMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	if !p.IsSetEncryptionAlgorithm() {
+		return FileCryptoMetaData_EncryptionAlgorithm_DEFAULT
+	}
+	return p.EncryptionAlgorithm
+}
+
+var FileCryptoMetaData_KeyMetadata_DEFAULT []byte
+
+func (p *FileCryptoMetaData) GetKeyMetadata() []byte {
+	return p.KeyMetadata
+}
+func (p *FileCryptoMetaData) IsSetEncryptionAlgorithm() bool {
+	return p.EncryptionAlgorithm != nil
+}
+
+func (p *FileCryptoMetaData) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
+}
+
+func (p *FileCryptoMetaData) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetEncryptionAlgorithm bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+			issetEncryptionAlgorithm = true
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetEncryptionAlgorithm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncryptionAlgorithm is not set"))
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) ReadField1(iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.KeyMetadata = v
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FileCryptoMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encryption_algorithm", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:encryption_algorithm: ", p), err)
+	}
+	if err := p.EncryptionAlgorithm.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:encryption_algorithm: ", p), err)
+	}
+	return err
+}
+
+func (p *FileCryptoMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin("key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileCryptoMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FileCryptoMetaData(%+v)", *p)
+}
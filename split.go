@@ -0,0 +1,78 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+)
+
+// SplitOptions say when Split starts a new file.  Every file gets at
+// least one row group, even if that row group is larger than MaxSize.
+// A zero field means that there is no limit.
+type SplitOptions struct {
+	// Files is the number of files that the row groups are spread
+	// over (as evenly as possible by number of row groups).
+	Files int
+	// RowGroups is the maximum number of row groups in each file.
+	RowGroups int
+	// MaxSize is the maximum size, in bytes, of the column
+	// chunks in each file.
+	MaxSize int64
+}
+
+// Split copies the row groups of r, in order, to several new parquet files.
+// create is called for each new file (i counts from 0) and the number of
+// files that were written is returned.  Like Concat, the column chunks are
+// copied without being decoded, so encrypted files can't be split.
+func Split(r io.ReadSeeker, opts SplitOptions, create func(i int) (io.Writer, error)) (int, error) {
+	footer, err := ReadMetaData(r)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := checkNotEncrypted(footer); err != nil {
+		return 0, err
+	}
+
+	var files [][]rowGroupSource
+	var size int64
+	part := -1
+	for i, rg := range footer.RowGroups {
+		var rgSize int64
+		for _, ch := range rg.Columns {
+			if ch.MetaData != nil {
+				rgSize += ch.MetaData.TotalCompressedSize
+			}
+		}
+
+		// with opts.Files, row group i belongs to the
+		// i*Files/len(RowGroups)'th part of the file
+		var newPart bool
+		if opts.Files > 0 {
+			p := i * opts.Files / len(footer.RowGroups)
+			newPart = p != part
+			part = p
+		}
+
+		l := len(files)
+		if l == 0 || newPart || (opts.RowGroups > 0 && len(files[l-1]) >= opts.RowGroups) || (opts.MaxSize > 0 && size+rgSize > opts.MaxSize) {
+			files = append(files, nil)
+			size = 0
+			l++
+		}
+
+		files[l-1] = append(files[l-1], rowGroupSource{r: r, rg: rg, name: fmt.Sprintf("row group %d", i)})
+		size += rgSize
+	}
+
+	for i, rgs := range files {
+		w, err := create(i)
+		if err != nil {
+			return i, err
+		}
+
+		if err := copyRowGroups(w, footer, rgs); err != nil {
+			return i, err
+		}
+	}
+	return len(files), nil
+}
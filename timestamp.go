@@ -0,0 +1,175 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	sch "github.com/parsyl/parquet/schema"
+)
+
+// TimeUnit is the unit of a TIMESTAMP column.
+type TimeUnit int
+
+const (
+	// Millis is milliseconds since the unix epoch.
+	Millis TimeUnit = iota
+	// Micros is microseconds since the unix epoch.
+	Micros
+	// Nanos is nanoseconds since the unix epoch, which can only
+	// hold times between the years 1677 and 2262.
+	Nanos
+	// Int96 is the legacy INT96 timestamp written by older Hive,
+	// Impala and Spark pipelines: the nanoseconds within the day
+	// followed by the Julian day.
+	Int96
+)
+
+// julianUnixEpoch is the Julian day of 1970-01-01.
+const julianUnixEpoch = 2440588
+
+// TimestampType returns a FieldFunc that makes a column an INT64
+// with the TIMESTAMP logical type.  Columns that are adjusted to UTC
+// also get the TIMESTAMP_MILLIS or TIMESTAMP_MICROS converted type
+// for readers that don't understand logical types.  Int96 columns
+// are plain INT96s since there is no logical type for them.
+func TimestampType(unit TimeUnit, utc bool) FieldFunc {
+	return func(se *sch.SchemaElement) {
+		if unit == Int96 {
+			t := sch.Type_INT96
+			se.Type = &t
+			return
+		}
+
+		t := sch.Type_INT64
+		se.Type = &t
+
+		var ct *sch.ConvertedType
+		tu := &sch.TimeUnit{}
+		switch unit {
+		case Millis:
+			tu.MILLIS = &sch.MilliSeconds{}
+			ct = sch.ConvertedTypePtr(sch.ConvertedType_TIMESTAMP_MILLIS)
+		case Micros:
+			tu.MICROS = &sch.MicroSeconds{}
+			ct = sch.ConvertedTypePtr(sch.ConvertedType_TIMESTAMP_MICROS)
+		default:
+			tu.NANOS = &sch.NanoSeconds{}
+		}
+
+		se.LogicalType = &sch.LogicalType{
+			TIMESTAMP: &sch.TimestampType{IsAdjustedToUTC: utc, Unit: tu},
+		}
+		if utc {
+			se.ConvertedType = ct
+		}
+	}
+}
+
+// Timestamp returns t as a number of units since the unix epoch.  If
+// utc is false the wall clock time of t (in its own location) is
+// stored as if it were in UTC.  Int96 timestamps are returned as
+// nanoseconds.
+func Timestamp(t time.Time, unit TimeUnit, utc bool) int64 {
+	if !utc {
+		y, mo, d := t.Date()
+		h, mi, s := t.Clock()
+		t = time.Date(y, mo, d, h, mi, s, t.Nanosecond(), time.UTC)
+	}
+
+	switch unit {
+	case Millis:
+		return t.Unix()*1e3 + int64(t.Nanosecond())/1e6
+	case Micros:
+		return t.Unix()*1e6 + int64(t.Nanosecond())/1e3
+	default:
+		return t.UnixNano()
+	}
+}
+
+// TimestampTime is the opposite of Timestamp.  Times that are
+// adjusted to UTC are returned in UTC, the others are returned
+// in the local time zone with the wall clock time that was stored.
+func TimestampTime(v int64, unit TimeUnit, utc bool) time.Time {
+	var t time.Time
+	switch unit {
+	case Millis:
+		t = time.Unix(v/1e3, (v%1e3)*1e6).UTC()
+	case Micros:
+		t = time.Unix(v/1e6, (v%1e6)*1e3).UTC()
+	default:
+		t = time.Unix(0, v).UTC()
+	}
+	return location(t, utc)
+}
+
+// location moves t, which is in UTC, to the local time zone (keeping
+// its wall clock time) if the column isn't adjusted to UTC.
+func location(t time.Time, utc bool) time.Time {
+	if utc {
+		return t
+	}
+
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, mo, d, h, mi, s, t.Nanosecond(), time.Local)
+}
+
+// EncodeTimes appends the PLAIN encoding of vals (as INT64
+// timestamps, see Timestamp, or as INT96s) to dst.
+func EncodeTimes(dst []byte, vals []time.Time, unit TimeUnit, utc bool) []byte {
+	if unit == Int96 {
+		return encodeInt96s(dst, vals, utc)
+	}
+
+	ints := make([]int64, len(vals))
+	for i, v := range vals {
+		ints[i] = Timestamp(v, unit, utc)
+	}
+	return EncodeInt64s(dst, ints)
+}
+
+// DecodeTimes appends n PLAIN encoded INT64 (see TimestampTime)
+// or INT96 timestamps from r to dst.
+func DecodeTimes(dst []time.Time, r io.Reader, n int, unit TimeUnit, utc bool) ([]time.Time, error) {
+	if unit == Int96 {
+		return decodeInt96s(dst, r, n, utc)
+	}
+
+	ints, err := DecodeInt64s(nil, r, n)
+	for _, v := range ints {
+		dst = append(dst, TimestampTime(v, unit, utc))
+	}
+	return dst, err
+}
+
+func encodeInt96s(dst []byte, vals []time.Time, utc bool) []byte {
+	i := len(dst)
+	dst = growBytes(dst, 12*len(vals))
+	for _, v := range vals {
+		if utc {
+			v = v.UTC()
+		}
+		y, mo, d := v.Date()
+		day := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+		h, mi, s := v.Clock()
+		nanos := int64(h)*int64(time.Hour) + int64(mi)*int64(time.Minute) + int64(s)*int64(time.Second) + int64(v.Nanosecond())
+		binary.LittleEndian.PutUint64(dst[i:], uint64(nanos))
+		binary.LittleEndian.PutUint32(dst[i+8:], uint32(day.Unix()/86400+julianUnixEpoch))
+		i += 12
+	}
+	return dst
+}
+
+func decodeInt96s(dst []time.Time, r io.Reader, n int, utc bool) ([]time.Time, error) {
+	b, err := plainBytes(r, n, 12)
+	if err != nil {
+		return dst, err
+	}
+	for i := 0; i < len(b); i += 12 {
+		nanos := int64(binary.LittleEndian.Uint64(b[i:]))
+		day := int64(binary.LittleEndian.Uint32(b[i+8:])) - julianUnixEpoch
+		dst = append(dst, location(time.Unix(day*86400, nanos).UTC(), utc))
+	}
+	return dst, nil
+}